@@ -0,0 +1,61 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+)
+
+// FilterData checks if the JSON data satisfies all the data filters
+// returns (true, nil) when the data passes the filters, false otherwise
+func FilterData(dataFilters []*v1alpha1.DataFilter, data []byte) (bool, error) {
+	for _, f := range dataFilters {
+		res := gjson.GetBytes(data, f.Path)
+		if !res.Exists() {
+			return false, nil
+		}
+		switch f.Type {
+		case v1alpha1.JSONTypeBool:
+			val, err := strconv.ParseBool(f.Value)
+			if err != nil {
+				return false, err
+			}
+			if val != res.Bool() {
+				return false, nil
+			}
+		case v1alpha1.JSONTypeNumber:
+			val, err := strconv.ParseFloat(f.Value, 64)
+			if err != nil {
+				return false, err
+			}
+			if val != res.Float() {
+				return false, nil
+			}
+		case v1alpha1.JSONTypeString:
+			if f.Value != res.Str {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("unsupported JSON type %s", f.Type)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterData(t *testing.T) {
+	data := []byte(`{"status": {"ready": true, "replicas": 3, "phase": "Running"}}`)
+
+	ok, err := FilterData(nil, data)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = FilterData([]*v1alpha1.DataFilter{
+		{Path: "status.ready", Type: v1alpha1.JSONTypeBool, Value: "true"},
+		{Path: "status.replicas", Type: v1alpha1.JSONTypeNumber, Value: "3"},
+		{Path: "status.phase", Type: v1alpha1.JSONTypeString, Value: "Running"},
+	}, data)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = FilterData([]*v1alpha1.DataFilter{{Path: "status.phase", Type: v1alpha1.JSONTypeString, Value: "Pending"}}, data)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = FilterData([]*v1alpha1.DataFilter{{Path: "status.missing", Type: v1alpha1.JSONTypeString, Value: "Running"}}, data)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	_, err = FilterData([]*v1alpha1.DataFilter{{Path: "status.replicas", Type: v1alpha1.JSONTypeNumber, Value: "three"}}, data)
+	assert.NotNil(t, err)
+
+	_, err = FilterData([]*v1alpha1.DataFilter{{Path: "status", Type: "object", Value: "{}"}}, data)
+	assert.NotNil(t, err)
+}
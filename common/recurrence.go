@@ -1,15 +1,103 @@
 package common
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	exceptionDateTimePrefix = "EXDATE:"
 	dateTimeFormat          = "20060102T150405Z"
+	localDateTimeFormat     = "20060102T150405"
+	dateFormat              = "20060102"
+
+	recurrenceStart = "DTSTART"
+	recurrenceRule  = "RRULE"
+	recurrenceDate  = "RDATE"
+	exceptionDate   = "EXDATE"
+
+	// maxSearchYears is the number of years after which a recurrence rule without
+	// an instance is considered exhausted
+	maxSearchYears = 100
 )
 
+// Frequency identifies the type of recurrence rule
+type Frequency int
+
+// possible recurrence rule frequencies
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"SECONDLY": Secondly,
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+	"YEARLY":   Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum is a BYDAY value of a recurrence rule, e.g. MO, +1MO or -1FR
+// an N of 0 matches every occurrence of the weekday in the period
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// RecurrenceRule is a repeating pattern for recurring events as specified by the RRULE property in RFC 5545
+// Supported rule parts are FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY and BYSETPOS.
+type RecurrenceRule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	BySetPos   []int
+
+	// start is the DTSTART of the recurrence the rule is a part of
+	start time.Time
+
+	// counted is the latest period whose instances were counted from the start by Next and count is the number
+	// of instances before it, so that rules with a COUNT are not re-evaluated from the start by every call.
+	mu      sync.Mutex
+	counted int
+	count   int
+}
+
+// Recurrence is the set of date times formed by the RRULE, RDATE and EXDATE lines of a recurring event
+// Instances are generated from DTSTART by each rule. Unlike RFC 5545, DTSTART itself is
+// only an instance of the set if it matches a rule or is listed as an RDATE.
+type Recurrence struct {
+	Start   time.Time
+	Rules   []*RecurrenceRule
+	Dates   []time.Time
+	ExDates []time.Time
+}
+
 // ParseExclusionDates parses the exclusion dates from the vals string according to RFC 5545
 func ParseExclusionDates(vals []string) ([]time.Time, error) {
 	exclusionDates := make([]time.Time, 0)
@@ -39,3 +127,609 @@ func parseDateTimes(s string) ([]time.Time, error) {
 	}
 	return res, nil
 }
+
+// ParseRecurrence parses the DTSTART, RRULE, RDATE and EXDATE lines of vals according to RFC 5545
+// Date times without a UTC designator or TZID parameter are evaluated in the given location.
+func ParseRecurrence(vals []string, location *time.Location) (*Recurrence, error) {
+	r := &Recurrence{
+		Rules:   make([]*RecurrenceRule, 0),
+		Dates:   make([]time.Time, 0),
+		ExDates: make([]time.Time, 0),
+	}
+	rules := make([]string, 0)
+	for _, val := range vals {
+		name, params, value, err := parseContentLine(val)
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case recurrenceStart:
+			if !r.Start.IsZero() {
+				return nil, fmt.Errorf("invalid recurrence line '%s': DTSTART is specified more than once", val)
+			}
+			r.Start, err = ParseDateTimeProperty(val, location)
+			if err != nil {
+				return nil, err
+			}
+		case recurrenceRule:
+			if len(params) > 0 {
+				return nil, fmt.Errorf("invalid recurrence line '%s': RRULE does not accept parameters", val)
+			}
+			rules = append(rules, value)
+		case recurrenceDate:
+			dates, err := parseDateList(value, params, location)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recurrence line '%s': %s", val, err)
+			}
+			r.Dates = append(r.Dates, dates...)
+		case exceptionDate:
+			dates, err := parseDateList(value, params, location)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recurrence line '%s': %s", val, err)
+			}
+			r.ExDates = append(r.ExDates, dates...)
+		default:
+			return nil, fmt.Errorf("invalid recurrence line '%s': unsupported property '%s'", val, name)
+		}
+	}
+	if len(rules) > 0 && r.Start.IsZero() {
+		return nil, fmt.Errorf("invalid recurrence: RRULE requires a DTSTART")
+	}
+	for _, val := range rules {
+		rule, err := ParseRecurrenceRule(val, r.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence line '%s:%s': %s", recurrenceRule, val, err)
+		}
+		r.Rules = append(r.Rules, rule)
+	}
+	return r, nil
+}
+
+// ParseDateTimeProperty parses the single date or date time value of a property line, e.g. DTSTART;TZID=America/New_York:20180704T090000
+// Date times without a UTC designator or TZID parameter are evaluated in the given location.
+func ParseDateTimeProperty(line string, location *time.Location) (time.Time, error) {
+	name, params, value, err := parseContentLine(line)
+	if err != nil {
+		return time.Time{}, err
+	}
+	dates, err := parseDateList(value, params, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recurrence line '%s': %s", line, err)
+	}
+	if len(dates) != 1 {
+		return time.Time{}, fmt.Errorf("invalid recurrence line '%s': %s must be a single date time", line, name)
+	}
+	return dates[0], nil
+}
+
+// ParseRecurrenceRule parses the value of a RRULE for a recurrence starting at start
+func ParseRecurrenceRule(s string, start time.Time) (*RecurrenceRule, error) {
+	rule := &RecurrenceRule{
+		Freq:     -1,
+		Interval: 1,
+		start:    start,
+	}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("rule part '%s' must be of the form NAME=VALUE", part)
+		}
+		name, value := strings.ToUpper(kv[0]), kv[1]
+		if seen[name] {
+			return nil, fmt.Errorf("rule part %s is specified more than once", name)
+		}
+		seen[name] = true
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := frequencies[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid FREQ '%s'", value)
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+			if err != nil || rule.Interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL '%s': must be a positive integer", value)
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+			if err != nil || rule.Count < 1 {
+				return nil, fmt.Errorf("invalid COUNT '%s': must be a positive integer", value)
+			}
+		case "UNTIL":
+			until, err := parseDate(value, start.Location())
+			if err != nil {
+				return nil, fmt.Errorf("invalid UNTIL '%s': %s", value, err)
+			}
+			if len(value) == len(dateFormat) {
+				// a date is inclusive of the entire day
+				until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+			rule.Until = &until
+		case "BYMONTH":
+			months, err := parseIntList(value, 1, 12, false)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTH '%s': %s", value, err)
+			}
+			for _, m := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(value, 1, 31, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTHDAY '%s': %s", value, err)
+			}
+		case "BYDAY":
+			rule.ByDay, err = parseWeekdayNums(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYDAY '%s': %s", value, err)
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseIntList(value, 1, 366, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYSETPOS '%s': %s", value, err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part '%s'", name)
+		}
+	}
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (rule *RecurrenceRule) validate() error {
+	if rule.Freq < 0 {
+		return fmt.Errorf("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return fmt.Errorf("COUNT and UNTIL must not both be specified")
+	}
+	if rule.Freq == Weekly && len(rule.ByMonthDay) > 0 {
+		return fmt.Errorf("BYMONTHDAY must not be specified with FREQ=WEEKLY")
+	}
+	if rule.Freq != Monthly && rule.Freq != Yearly {
+		for _, wd := range rule.ByDay {
+			if wd.N != 0 {
+				return fmt.Errorf("BYDAY must not specify a numeric value unless FREQ is MONTHLY or YEARLY")
+			}
+		}
+	}
+	if len(rule.BySetPos) > 0 && len(rule.ByMonth) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+		return fmt.Errorf("BYSETPOS must be used in conjunction with another BYxxx rule part")
+	}
+	return nil
+}
+
+// Next returns the earliest instance of the recurrence after t
+// If the recurrence has no instances after t, the zero time is returned.
+func (r *Recurrence) Next(t time.Time) time.Time {
+	for {
+		var next time.Time
+		for _, rule := range r.Rules {
+			if n := rule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+				next = n
+			}
+		}
+		for _, d := range r.Dates {
+			if d.After(t) && (next.IsZero() || d.Before(next)) {
+				next = d
+			}
+		}
+		if next.IsZero() || !r.isExcluded(next) {
+			return next
+		}
+		t = next
+	}
+}
+
+// IsSchedule returns true if the recurrence defines instances by RRULE or RDATE
+func (r *Recurrence) IsSchedule() bool {
+	return len(r.Rules) > 0 || len(r.Dates) > 0
+}
+
+func (r *Recurrence) isExcluded(t time.Time) bool {
+	for _, exDate := range r.ExDates {
+		if exDate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the earliest instance of the rule after t
+// If the rule has no instances after t, the zero time is returned.
+func (rule *RecurrenceRule) Next(t time.Time) time.Time {
+	if rule.Freq < Daily && len(rule.ByMonth) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+		return rule.nextPeriod(t)
+	}
+	rule.mu.Lock()
+	defer rule.mu.Unlock()
+	end := t
+	if end.Before(rule.start) {
+		end = rule.start
+	}
+	end = end.AddDate(maxSearchYears, 0, 0)
+	k, count := rule.firstPeriod(t)
+	for ; !rule.periodStart(k).After(end); k++ {
+		instances := rule.instances(k)
+		if len(instances) == 0 {
+			if rule.Freq < Daily {
+				k = rule.skip(k) - 1
+			}
+			continue
+		}
+		if rule.Count > 0 {
+			rule.counted, rule.count = k, count
+		}
+		for _, instance := range instances {
+			if instance.Before(rule.start) {
+				continue
+			}
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return time.Time{}
+			}
+			if rule.Until != nil && instance.After(*rule.Until) {
+				return time.Time{}
+			}
+			if instance.After(t) {
+				return instance
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// nextPeriod returns the earliest instance after t of a rule more frequent than daily without BYxxx rule parts
+// every period of such a rule has exactly one instance at its start, so the instances are not counted.
+func (rule *RecurrenceRule) nextPeriod(t time.Time) time.Time {
+	k := 0
+	if !t.Before(rule.start) {
+		k = int(t.Sub(rule.start)/(time.Duration(rule.Interval)*rule.unit())) + 1
+	}
+	if rule.Count > 0 && k >= rule.Count {
+		return time.Time{}
+	}
+	instance := rule.periodStart(k)
+	if rule.Until != nil && instance.After(*rule.Until) {
+		return time.Time{}
+	}
+	return instance
+}
+
+// firstPeriod returns the index of the first period which needs to be evaluated to find the next instance after t
+// and the number of instances before it.
+func (rule *RecurrenceRule) firstPeriod(t time.Time) (int, int) {
+	if !t.After(rule.start) {
+		return 0, 0
+	}
+	t = t.In(rule.start.Location())
+	var periods int
+	switch rule.Freq {
+	case Yearly:
+		periods = t.Year() - rule.start.Year()
+	case Monthly:
+		periods = (t.Year()-rule.start.Year())*12 + int(t.Month()) - int(rule.start.Month())
+	case Weekly:
+		periods = daysBetween(weekStart(rule.start), t) / 7
+	case Daily:
+		periods = daysBetween(rule.start, t)
+	default:
+		periods = int(t.Sub(rule.start) / rule.unit())
+	}
+	k := periods/rule.Interval - 1
+	if k < 0 {
+		k = 0
+	}
+	if rule.Count > 0 {
+		// instances need to be counted from the start, or from the latest counted period if it starts before t
+		// since the instances of a period are within the period.
+		if !rule.periodStart(rule.counted).After(t) {
+			return rule.counted, rule.count
+		}
+		return 0, 0
+	}
+	return k, 0
+}
+
+// periodStart returns the start of the k-th period of the rule
+func (rule *RecurrenceRule) periodStart(k int) time.Time {
+	start := rule.start
+	n := k * rule.Interval
+	switch rule.Freq {
+	case Yearly:
+		return date(start.Year()+n, time.January, 1, start.Location())
+	case Monthly:
+		return date(start.Year(), start.Month()+time.Month(n), 1, start.Location())
+	case Weekly:
+		return weekStart(start).AddDate(0, 0, 7*n)
+	case Daily:
+		return date(start.Year(), start.Month(), start.Day()+n, start.Location())
+	default:
+		return start.Add(time.Duration(n) * rule.unit())
+	}
+}
+
+// skip returns the index of the first period after the k-th period of a rule more frequent than daily
+// which is not in the same month, if the month of the k-th period does not match, or otherwise in the same day.
+func (rule *RecurrenceRule) skip(k int) int {
+	t := rule.periodStart(k)
+	next := date(t.Year(), t.Month(), t.Day()+1, t.Location())
+	if !rule.matchMonth(t) {
+		next = date(t.Year(), t.Month()+1, 1, t.Location())
+	}
+	step := time.Duration(rule.Interval) * rule.unit()
+	if j := int((next.Sub(rule.start) + step - 1) / step); j > k {
+		return j
+	}
+	return k + 1
+}
+
+func (rule *RecurrenceRule) unit() time.Duration {
+	switch rule.Freq {
+	case Hourly:
+		return time.Hour
+	case Minutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// instances returns the sorted instances of the k-th period of the rule
+func (rule *RecurrenceRule) instances(k int) []time.Time {
+	start := rule.start
+	first := rule.periodStart(k)
+	if rule.Freq < Daily {
+		instance := first
+		if !rule.matchMonth(instance) || !rule.matchMonthDay(instance) || !rule.matchDay(instance, false) {
+			return nil
+		}
+		return rule.setPos([]time.Time{instance})
+	}
+
+	// candidate days of the period
+	var days int
+	switch rule.Freq {
+	case Yearly:
+		days = daysBetween(first, first.AddDate(1, 0, 0))
+	case Monthly:
+		days = daysIn(first)
+	case Weekly:
+		days = 7
+	case Daily:
+		days = 1
+	}
+
+	instances := make([]time.Time, 0)
+	for i := 0; i < days; i++ {
+		d := date(first.Year(), first.Month(), first.Day()+i, start.Location())
+		if !rule.matchMonth(d) {
+			continue
+		}
+		switch {
+		case len(rule.ByMonthDay) > 0 || len(rule.ByDay) > 0:
+			if !rule.matchMonthDay(d) || !rule.matchDay(d, rule.Freq == Yearly && len(rule.ByMonth) == 0) {
+				continue
+			}
+		case rule.Freq == Yearly && len(rule.ByMonth) == 0:
+			if d.Month() != start.Month() || d.Day() != start.Day() {
+				continue
+			}
+		case rule.Freq == Yearly || rule.Freq == Monthly:
+			if d.Day() != start.Day() {
+				continue
+			}
+		case rule.Freq == Weekly:
+			if d.Weekday() != start.Weekday() {
+				continue
+			}
+		}
+		instances = append(instances, time.Date(d.Year(), d.Month(), d.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location()))
+	}
+	return rule.setPos(instances)
+}
+
+func (rule *RecurrenceRule) setPos(instances []time.Time) []time.Time {
+	if len(rule.BySetPos) == 0 {
+		return instances
+	}
+	res := make([]time.Time, 0)
+	for _, pos := range rule.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(instances) + pos
+		}
+		if i >= 0 && i < len(instances) {
+			res = append(res, instances[i])
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Before(res[j]) })
+	return res
+}
+
+func (rule *RecurrenceRule) matchMonth(t time.Time) bool {
+	if len(rule.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range rule.ByMonth {
+		if t.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *RecurrenceRule) matchMonthDay(t time.Time) bool {
+	if len(rule.ByMonthDay) == 0 {
+		return true
+	}
+	for _, d := range rule.ByMonthDay {
+		if d == t.Day() || d < 0 && daysIn(t)+d+1 == t.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDay matches t against the BYDAY rule part
+// numeric values are relative to the year if inYear is true and otherwise relative to the month.
+func (rule *RecurrenceRule) matchDay(t time.Time, inYear bool) bool {
+	if len(rule.ByDay) == 0 {
+		return true
+	}
+	day, days := t.Day(), daysIn(t)
+	if inYear {
+		day, days = t.YearDay(), daysBetween(date(t.Year(), time.January, 1, t.Location()), date(t.Year()+1, time.January, 1, t.Location()))
+	}
+	for _, wd := range rule.ByDay {
+		if wd.Weekday != t.Weekday() {
+			continue
+		}
+		switch {
+		case wd.N == 0:
+			return true
+		case wd.N > 0 && (day-1)/7+1 == wd.N:
+			return true
+		case wd.N < 0 && (days-day)/7+1 == -wd.N:
+			return true
+		}
+	}
+	return false
+}
+
+// parseContentLine splits a line of the form NAME;PARAM=VALUE:VALUE
+func parseContentLine(line string) (string, map[string]string, string, error) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "", nil, "", fmt.Errorf("invalid recurrence line '%s': missing ':' between property name and value", line)
+	}
+	nameParams := strings.Split(line[:i], ";")
+	params := make(map[string]string)
+	for _, param := range nameParams[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, "", fmt.Errorf("invalid recurrence line '%s': invalid parameter '%s'", line, param)
+		}
+		params[strings.ToUpper(kv[0])] = kv[1]
+	}
+	return strings.ToUpper(nameParams[0]), params, line[i+1:], nil
+}
+
+// parseDateList parses a comma separated list of dates or date times with the VALUE and TZID parameters
+func parseDateList(s string, params map[string]string, location *time.Location) ([]time.Time, error) {
+	for name, value := range params {
+		switch name {
+		case "VALUE":
+			if value != "DATE" && value != "DATE-TIME" {
+				return nil, fmt.Errorf("unsupported VALUE '%s'", value)
+			}
+		case "TZID":
+			loc, err := time.LoadLocation(value)
+			if err != nil {
+				return nil, fmt.Errorf("unknown TZID '%s'", value)
+			}
+			location = loc
+		default:
+			return nil, fmt.Errorf("unsupported parameter '%s'", name)
+		}
+	}
+	res := make([]time.Time, 0)
+	for _, s := range strings.Split(s, ",") {
+		if (params["VALUE"] == "DATE") != (len(s) == len(dateFormat)) {
+			return nil, fmt.Errorf("value '%s' does not match the VALUE type", s)
+		}
+		t, err := parseDate(s, location)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+// parseDate parses a UTC date time, a local date time or a date in the given location
+func parseDate(s string, location *time.Location) (time.Time, error) {
+	var t time.Time
+	var err error
+	switch {
+	case strings.HasSuffix(s, "Z"):
+		t, err = time.Parse(dateTimeFormat, s)
+	case len(s) == len(dateFormat):
+		t, err = time.ParseInLocation(dateFormat, s, location)
+	default:
+		t, err = time.ParseInLocation(localDateTimeFormat, s, location)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("'%s' is not a valid date or date time", s)
+	}
+	return t, nil
+}
+
+func parseIntList(s string, min, max int, allowNegative bool) ([]int, error) {
+	res := make([]int, 0)
+	for _, v := range strings.Split(s, ",") {
+		i, err := strconv.Atoi(v)
+		abs := i
+		if abs < 0 && allowNegative {
+			abs = -abs
+		}
+		if err != nil || abs < min || abs > max {
+			if allowNegative {
+				return nil, fmt.Errorf("'%s' is not an integer in the range [-%d,-%d] or [%d,%d]", v, max, min, min, max)
+			}
+			return nil, fmt.Errorf("'%s' is not an integer in the range [%d,%d]", v, min, max)
+		}
+		res = append(res, i)
+	}
+	return res, nil
+}
+
+func parseWeekdayNums(s string) ([]WeekdayNum, error) {
+	res := make([]WeekdayNum, 0)
+	for _, v := range strings.Split(s, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weekday", v)
+		}
+		weekday, ok := weekdays[strings.ToUpper(v[len(v)-2:])]
+		if !ok {
+			return nil, fmt.Errorf("'%s' is not a valid weekday", v)
+		}
+		wd := WeekdayNum{Weekday: weekday}
+		if num := v[:len(v)-2]; num != "" {
+			n, err := strconv.Atoi(num)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("'%s' is not a valid weekday: ordinal must be in the range [-53,-1] or [1,53]", v)
+			}
+			wd.N = n
+		}
+		res = append(res, wd)
+	}
+	return res, nil
+}
+
+func date(year int, month time.Month, day int, location *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+// daysIn returns the number of days in the month of t
+func daysIn(t time.Time) int {
+	return date(t.Year(), t.Month()+1, 0, time.UTC).Day()
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	a = date(a.Year(), a.Month(), a.Day(), time.UTC)
+	b = date(b.Year(), b.Month(), b.Day(), time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// weekStart returns the monday of the week of t
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return date(t.Year(), t.Month(), t.Day()-offset, t.Location())
+}
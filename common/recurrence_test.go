@@ -32,3 +32,236 @@ func TestParseExclusionDate(t *testing.T) {
 	assert.Equal(t, 10, second.Minute())
 	assert.Equal(t, 30, second.Second())
 }
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		vals    []string
+		wantErr bool
+	}{
+		{"exdate only", []string{"EXDATE:20180704T130000Z"}, false},
+		{"rdate with tzid", []string{"RDATE;TZID=America/New_York:20180704T090000,20180705T090000"}, false},
+		{"rdate with value date", []string{"RDATE;VALUE=DATE:20180704"}, false},
+		{"rule", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3"}, false},
+		{"missing colon", []string{"RRULE"}, true},
+		{"unsupported property", []string{"EXRULE:FREQ=DAILY"}, true},
+		{"rule without start", []string{"RRULE:FREQ=DAILY"}, true},
+		{"rule without freq", []string{"DTSTART:20180101T090000Z", "RRULE:COUNT=1"}, true},
+		{"invalid freq", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=FORTNIGHTLY"}, true},
+		{"invalid interval", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=DAILY;INTERVAL=0"}, true},
+		{"count and until", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=DAILY;COUNT=1;UNTIL=20180102T000000Z"}, true},
+		{"invalid byday", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYDAY=1XX"}, true},
+		{"byday ordinal with weekly", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=WEEKLY;BYDAY=1MO"}, true},
+		{"bymonthday with weekly", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=WEEKLY;BYMONTHDAY=1"}, true},
+		{"invalid bymonthday", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYMONTHDAY=32"}, true},
+		{"bysetpos alone", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYSETPOS=1"}, true},
+		{"duplicate part", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=DAILY;FREQ=WEEKLY"}, true},
+		{"unsupported part", []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=DAILY;BYWEEKNO=1"}, true},
+		{"invalid rdate", []string{"RDATE:2018-07-04"}, true},
+		{"rdate value mismatch", []string{"RDATE;VALUE=DATE:20180704T090000Z"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRecurrence(tt.vals, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRecurrence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		vals     []string
+		after    time.Time
+		expected []time.Time
+	}{
+		{
+			name:  "daily with interval and count",
+			vals:  []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=DAILY;INTERVAL=2;COUNT=3"},
+			after: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 3, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 5, 9, 0, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			name:  "weekly by day until",
+			vals:  []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;UNTIL=20180111T090000Z"},
+			after: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 9, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 11, 9, 0, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			name:  "last weekday of the month",
+			vals:  []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+			after: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 2, 28, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 3, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "second to last day of the month",
+			vals:  []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;BYMONTHDAY=-2"},
+			after: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 2, 27, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 3, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "thanksgiving in new york",
+			vals:  []string{"DTSTART;TZID=America/New_York:20101125T120000", "RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH"},
+			after: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 11, 22, 12, 0, 0, 0, ny),
+				time.Date(2019, 11, 28, 12, 0, 0, 0, ny),
+			},
+		},
+		{
+			name:  "yearly on dtstart",
+			vals:  []string{"DTSTART:20160229T000000Z", "RRULE:FREQ=YEARLY"},
+			after: time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "hourly by day",
+			vals:  []string{"DTSTART:20180105T220000Z", "RRULE:FREQ=HOURLY;INTERVAL=1;BYDAY=FR"},
+			after: time.Date(2018, 1, 5, 22, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 5, 23, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 12, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "secondly by day",
+			vals:  []string{"DTSTART:20181008T000000Z", "RRULE:FREQ=SECONDLY;BYDAY=MO"},
+			after: time.Date(2018, 10, 8, 23, 59, 58, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 10, 8, 23, 59, 59, 0, time.UTC),
+				time.Date(2018, 10, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2018, 10, 15, 0, 0, 1, 0, time.UTC),
+			},
+		},
+		{
+			name:  "minutely by month",
+			vals:  []string{"DTSTART:20180101T000000Z", "RRULE:FREQ=MINUTELY;INTERVAL=7;BYMONTH=1"},
+			after: time.Date(2018, 1, 31, 23, 50, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 31, 23, 52, 0, 0, time.UTC),
+				time.Date(2018, 1, 31, 23, 59, 0, 0, time.UTC),
+				time.Date(2019, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "no instance",
+			vals:     []string{"DTSTART:20180101T000000Z", "RRULE:FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30"},
+			after:    time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{{}},
+		},
+		{
+			name:  "rdates and exdates",
+			vals:  []string{"RDATE:20180704T090000Z,20180101T090000Z,20181225T090000Z", "EXDATE:20180704T090000Z"},
+			after: time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 12, 25, 9, 0, 0, 0, time.UTC),
+				{},
+			},
+		},
+		{
+			name:  "rule and rdates",
+			vals:  []string{"DTSTART:20180101T090000Z", "RRULE:FREQ=MONTHLY;COUNT=2", "RDATE:20180115T090000Z"},
+			after: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 1, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2018, 2, 1, 9, 0, 0, 0, time.UTC),
+				{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.vals, time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next := tt.after
+			for _, expected := range tt.expected {
+				next = r.Next(next)
+				if !next.Equal(expected) {
+					t.Fatalf("Next()\nexpected: %s\nactual: %s", expected, next)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleNextCount(t *testing.T) {
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	rule, err := ParseRecurrenceRule("FREQ=HOURLY;BYDAY=MO,TU,WE,TH,FR,SA,SU;COUNT=10000", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the instances are counted from the latest counted period instead of the start
+	next := start.Add(-time.Second)
+	for i := 0; i < 10000; i++ {
+		next = rule.Next(next)
+		assert.Equal(t, start.Add(time.Duration(i)*time.Hour), next)
+	}
+	assert.True(t, rule.Next(next).IsZero())
+	// and from the start for times before the latest counted period
+	assert.Equal(t, start.Add(time.Hour), rule.Next(start))
+	assert.True(t, rule.Next(start.Add(10000*time.Hour)).IsZero())
+}
+
+func TestRecurrenceRuleNextPeriod(t *testing.T) {
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	rule, err := ParseRecurrenceRule("FREQ=SECONDLY;INTERVAL=2;COUNT=100000000", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the instances of a large count are computed without counting the previous instances
+	assert.Equal(t, start, rule.Next(start.Add(-time.Second)))
+	assert.Equal(t, start.Add(2*time.Second), rule.Next(start))
+	assert.Equal(t, start.Add(199999998*time.Second), rule.Next(start.Add(199999997*time.Second)))
+	assert.True(t, rule.Next(start.Add(199999998*time.Second)).IsZero())
+
+	rule, err = ParseRecurrenceRule("FREQ=MINUTELY;INTERVAL=15;UNTIL=20180101T100000Z", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, start.Add(time.Hour), rule.Next(start.Add(50*time.Minute)))
+	assert.True(t, rule.Next(start.Add(time.Hour)).IsZero())
+}
+
+func TestRecurrenceRuleNextSetPos(t *testing.T) {
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	// every period of a rule more frequent than daily has a single instance
+	rule, err := ParseRecurrenceRule("FREQ=HOURLY;BYDAY=MO;BYSETPOS=-1", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, start.Add(time.Hour), rule.Next(start))
+	rule, err = ParseRecurrenceRule("FREQ=HOURLY;BYDAY=MO;BYSETPOS=2", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, rule.Next(start).IsZero())
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"time"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +32,39 @@ var DefaultRetry = wait.Backoff{
 	Jitter:   0.1,
 }
 
+// Backoff is the backoff of the reconnections of the signals after failures
+// the duration between attempts doubles from Min up to Max with a jitter of 10%.
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+
+	duration time.Duration
+}
+
+// Wait waits for the next attempt and returns false if the context is done first
+func (b *Backoff) Wait(ctx context.Context) bool {
+	if b.duration == 0 {
+		b.duration = b.Min
+	}
+	timer := time.NewTimer(wait.Jitter(b.duration, 0.1))
+	defer timer.Stop()
+	b.duration *= 2
+	if b.duration > b.Max {
+		b.duration = b.Max
+	}
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Reset resets the duration of the next attempt to Min, e.g. after a successful reconnection
+func (b *Backoff) Reset() {
+	b.duration = 0
+}
+
 // IsRetryableKubeAPIError returns if the error is a retryable kubernetes error
 func IsRetryableKubeAPIError(err error) bool {
 	// get original error if it was wrapped
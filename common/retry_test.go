@@ -16,7 +16,9 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -36,3 +38,18 @@ func TestRetryableKubeAPIError(t *testing.T) {
 	assert.False(t, IsRetryableKubeAPIError(errInvalid))
 	assert.False(t, IsRetryableKubeAPIError(errMethodNotSupported))
 }
+
+func TestBackoff(t *testing.T) {
+	b := Backoff{Min: time.Millisecond, Max: 3 * time.Millisecond}
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.True(t, b.Wait(ctx))
+	assert.Equal(t, 2*time.Millisecond, b.duration)
+	assert.True(t, b.Wait(ctx))
+	assert.Equal(t, 3*time.Millisecond, b.duration)
+	b.Reset()
+	assert.Equal(t, time.Duration(0), b.duration)
+
+	cancel()
+	b = Backoff{Min: time.Hour, Max: time.Hour}
+	assert.False(t, b.Wait(ctx))
+}
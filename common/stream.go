@@ -0,0 +1,73 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// EventStream sends the events received by a server shared by the signals to a listening signal
+// the events channel is only closed once the events being sent are done, so that the server never sends
+// on a closed channel after the signal stopped listening.
+type EventStream struct {
+	events chan *v1alpha1.Event
+	done   <-chan struct{}
+
+	// mu guards the events channel, which is closed once the events being sent are done
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewEventStream creates the event stream of a signal listening until done is closed
+func NewEventStream(done <-chan struct{}) *EventStream {
+	return &EventStream{events: make(chan *v1alpha1.Event), done: done}
+}
+
+// Events returns the channel of the events of the stream
+func (s *EventStream) Events() <-chan *v1alpha1.Event {
+	return s.events
+}
+
+// Close closes the events channel once the events being sent are done
+func (s *EventStream) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+}
+
+// Send sends the event to the signal
+// returns false if the signal stopped listening or the cancel channel was closed before the event was sent.
+func (s *EventStream) Send(event *v1alpha1.Event, cancel <-chan struct{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return false
+	}
+	select {
+	case s.events <- event:
+		return true
+	case <-s.done:
+		return false
+	case <-cancel:
+		return false
+	}
+}
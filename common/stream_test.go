@@ -0,0 +1,51 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStream(t *testing.T) {
+	done := make(chan struct{})
+	s := NewEventStream(done)
+	event := &v1alpha1.Event{Context: v1alpha1.EventContext{EventID: "1"}}
+
+	sent := make(chan bool)
+	go func() {
+		sent <- s.Send(event, nil)
+	}()
+	assert.Equal(t, event, <-s.Events())
+	assert.True(t, <-sent)
+
+	// the send is canceled when nothing receives the event
+	cancel := make(chan struct{})
+	close(cancel)
+	assert.False(t, s.Send(event, cancel))
+
+	// the events are not sent once the signal stopped listening
+	close(done)
+	assert.False(t, s.Send(event, nil))
+	s.Close()
+	s.Close()
+	_, ok := <-s.Events()
+	assert.False(t, ok)
+	assert.False(t, s.Send(event, nil))
+}
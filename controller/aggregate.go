@@ -0,0 +1,274 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxAggregateSamples is the maximum number of samples of an aggregate, so that it fits in the sensor status
+// the events of sliding windows are sampled in as many buckets and distinct aggregations are limited to as many values.
+const maxAggregateSamples = 1000
+
+// aggregateEvent adds the event to the aggregate of the window of the signal
+// returns the updated aggregate and true if it satisfies the threshold of the aggregation.
+// returns an error if the event cannot be aggregated, e.g. it has no value at the path or it is older than the window.
+func aggregateEvent(aggregation *v1alpha1.Aggregation, aggregate *v1alpha1.Aggregate, event *v1alpha1.Event) (*v1alpha1.Aggregate, bool, error) {
+	window, err := parseWindow(aggregation.Window)
+	if err != nil {
+		return nil, false, err
+	}
+	threshold, err := strconv.ParseFloat(aggregation.Threshold, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid aggregation threshold '%s'", aggregation.Threshold)
+	}
+	value, err := aggregatedValue(aggregation, event)
+	if err != nil {
+		return nil, false, err
+	}
+	eventTime := event.Context.EventTime.Time
+	if eventTime.IsZero() {
+		eventTime = time.Now().UTC()
+	}
+	sliding := window > 0 && aggregation.WindowType != v1alpha1.WindowTypeTumbling
+
+	if aggregate == nil {
+		aggregate = &v1alpha1.Aggregate{}
+	} else {
+		aggregate = aggregate.DeepCopy()
+	}
+	switch {
+	case window == 0:
+		if aggregate.Count == 0 {
+			aggregate.WindowStart = metav1.Time{Time: eventTime}
+		}
+		if eventTime.After(aggregate.WindowEnd.Time) {
+			aggregate.WindowEnd = metav1.Time{Time: eventTime}
+		}
+	case sliding:
+		end := eventTime
+		if aggregate.WindowEnd.After(end) {
+			end = aggregate.WindowEnd.Time
+		}
+		start := end.Add(-window)
+		if !eventTime.After(start) {
+			return nil, false, fmt.Errorf("event time %s is before the window starting at %s", eventTime, start)
+		}
+		samples := aggregate.Samples[:0]
+		for _, sample := range aggregate.Samples {
+			if sample.Time.After(start) {
+				samples = append(samples, sample)
+			}
+		}
+		aggregate.Samples = samples
+		aggregate.WindowStart = metav1.Time{Time: start}
+		aggregate.WindowEnd = metav1.Time{Time: end}
+	default:
+		start := eventTime.Truncate(window)
+		if aggregate.Count > 0 && start.Before(aggregate.WindowStart.Time) {
+			return nil, false, fmt.Errorf("event time %s is before the window starting at %s", eventTime, aggregate.WindowStart.Time)
+		}
+		if aggregate.Count == 0 || start.After(aggregate.WindowStart.Time) {
+			aggregate = &v1alpha1.Aggregate{
+				WindowStart: metav1.Time{Time: start},
+				WindowEnd:   metav1.Time{Time: start.Add(window)},
+			}
+		}
+	}
+
+	previous, count := aggregate.Value, aggregate.Count
+	switch {
+	case aggregation.Function == v1alpha1.AggregationFunctionDistinct:
+		// the distinct values are kept as samples, with the time of their latest event
+		samples, err := addDistinctSample(aggregate.Samples, eventTime, value)
+		if err != nil {
+			return nil, false, err
+		}
+		aggregate.Samples = samples
+		aggregate.Count++
+		if sliding {
+			aggregate.Count = countSamples(aggregate.Samples)
+		}
+	case sliding:
+		aggregate.Samples = addBucketSample(aggregation.Function, aggregate.Samples, window/maxAggregateSamples, eventTime, value)
+		aggregate.Count = countSamples(aggregate.Samples)
+	default:
+		aggregate.Count++
+	}
+
+	var result float64
+	switch {
+	case aggregation.Function == v1alpha1.AggregationFunctionCount:
+		result = float64(aggregate.Count)
+	case aggregation.Function == v1alpha1.AggregationFunctionDistinct:
+		result = float64(len(aggregate.Samples))
+	case sliding:
+		values := make([]float64, len(aggregate.Samples))
+		for i, sample := range aggregate.Samples {
+			values[i], _ = strconv.ParseFloat(sample.Value, 64)
+		}
+		result = reduce(aggregation.Function, values)
+	default:
+		// the windows which do not slide are aggregated incrementally
+		v, _ := strconv.ParseFloat(value, 64)
+		if count > 0 {
+			p, _ := strconv.ParseFloat(previous, 64)
+			v = reduce(aggregation.Function, []float64{p, v})
+		}
+		result = v
+	}
+	aggregate.Value = strconv.FormatFloat(result, 'f', -1, 64)
+	return aggregate, compareAggregate(result, aggregation.Operator, threshold), nil
+}
+
+// parseWindow parses the duration of the window of an aggregation, zero if the aggregation has no window
+func parseWindow(window string) (time.Duration, error) {
+	if window == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid aggregation window '%s'", window)
+	}
+	return d, nil
+}
+
+// aggregatedValue returns the value at the path of the data of the event which is aggregated
+// the values of sum, min and max aggregations must be numbers or strings of numbers.
+func aggregatedValue(aggregation *v1alpha1.Aggregation, event *v1alpha1.Event) (string, error) {
+	if aggregation.Function == v1alpha1.AggregationFunctionCount {
+		return "", nil
+	}
+	js, err := renderEventDataAsJSON(event)
+	if err != nil {
+		return "", err
+	}
+	res := gjson.GetBytes(js, aggregation.Path)
+	if !res.Exists() {
+		return "", fmt.Errorf("no value at aggregation path '%s'", aggregation.Path)
+	}
+	if aggregation.Function == v1alpha1.AggregationFunctionDistinct {
+		return res.String(), nil
+	}
+	v, err := strconv.ParseFloat(res.String(), 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", fmt.Errorf("value '%s' at aggregation path '%s' is not a number", res.String(), aggregation.Path)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), nil
+}
+
+// reduce reduces the values with the sum, min or max function
+func reduce(function v1alpha1.AggregationFunction, values []float64) float64 {
+	var result float64
+	for i, v := range values {
+		switch {
+		case i == 0:
+			result = v
+		case function == v1alpha1.AggregationFunctionSum:
+			result += v
+		case function == v1alpha1.AggregationFunctionMin:
+			result = math.Min(result, v)
+		case function == v1alpha1.AggregationFunctionMax:
+			result = math.Max(result, v)
+		}
+	}
+	return result
+}
+
+// addBucketSample adds the event to the sample of its bucket of the sliding window
+// the samples are the partial aggregates of the buckets, which leave the window once their latest event does.
+func addBucketSample(function v1alpha1.AggregationFunction, samples []v1alpha1.AggregateSample, bucket time.Duration, eventTime time.Time, value string) []v1alpha1.AggregateSample {
+	for i, sample := range samples {
+		if !sample.Time.Truncate(bucket).Equal(eventTime.Truncate(bucket)) {
+			continue
+		}
+		if function != v1alpha1.AggregationFunctionCount {
+			p, _ := strconv.ParseFloat(sample.Value, 64)
+			v, _ := strconv.ParseFloat(value, 64)
+			samples[i].Value = strconv.FormatFloat(reduce(function, []float64{p, v}), 'f', -1, 64)
+		}
+		if eventTime.After(sample.Time.Time) {
+			samples[i].Time = metav1.Time{Time: eventTime}
+		}
+		samples[i].Count++
+		return samples
+	}
+	return append(samples, v1alpha1.AggregateSample{Time: metav1.Time{Time: eventTime}, Value: value, Count: 1})
+}
+
+// addDistinctSample adds the event to the sample of its value
+// returns an error if the value is new and the aggregate already has the maximum number of distinct values.
+func addDistinctSample(samples []v1alpha1.AggregateSample, eventTime time.Time, value string) ([]v1alpha1.AggregateSample, error) {
+	for i, sample := range samples {
+		if sample.Value != value {
+			continue
+		}
+		if eventTime.After(sample.Time.Time) {
+			samples[i].Time = metav1.Time{Time: eventTime}
+		}
+		samples[i].Count++
+		return samples, nil
+	}
+	if len(samples) >= maxAggregateSamples {
+		return nil, fmt.Errorf("aggregate exceeds the maximum of %d distinct values", maxAggregateSamples)
+	}
+	return append(samples, v1alpha1.AggregateSample{Time: metav1.Time{Time: eventTime}, Value: value, Count: 1}), nil
+}
+
+// countSamples returns the number of events of the samples
+func countSamples(samples []v1alpha1.AggregateSample) int32 {
+	var count int32
+	for _, sample := range samples {
+		count += sample.Count
+	}
+	return count
+}
+
+// compareAggregate compares the aggregate to the threshold with the operator, which defaults to >=
+func compareAggregate(aggregate float64, operator v1alpha1.AggregationOperator, threshold float64) bool {
+	switch operator {
+	case v1alpha1.AggregationOperatorGreaterThan:
+		return aggregate > threshold
+	case v1alpha1.AggregationOperatorLessThan:
+		return aggregate < threshold
+	case v1alpha1.AggregationOperatorLessThanOrEqual:
+		return aggregate <= threshold
+	case v1alpha1.AggregationOperatorEqual:
+		return aggregate == threshold
+	default:
+		return aggregate >= threshold
+	}
+}
+
+// renderAggregateAsJSON renders the aggregate as JSON without its samples, so that trigger parameters can select its
+// value, count, windowStart and windowEnd.
+func renderAggregateAsJSON(aggregate *v1alpha1.Aggregate) ([]byte, error) {
+	if aggregate == nil {
+		return nil, fmt.Errorf("aggregate is nil")
+	}
+	a := *aggregate
+	a.Samples = nil
+	return json.Marshal(a)
+}
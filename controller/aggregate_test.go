@@ -0,0 +1,192 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_aggregateEvent(t *testing.T) {
+	start := time.Date(2018, time.October, 11, 10, 0, 0, 0, time.UTC)
+	type step struct {
+		minutes int
+		data    string
+		value   string
+		count   int32
+		met     bool
+		wantErr bool
+	}
+	tests := []struct {
+		name        string
+		aggregation v1alpha1.Aggregation
+		steps       []step
+	}{
+		{
+			name:        "count within sliding window",
+			aggregation: v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionCount, Window: "10m", Threshold: "3"},
+			steps: []step{
+				{minutes: 0, value: "1", count: 1},
+				{minutes: 5, value: "2", count: 2},
+				// the first event slides out of the window
+				{minutes: 12, value: "2", count: 2},
+				{minutes: 14, value: "3", count: 3, met: true},
+				// late events are ignored
+				{minutes: 1, wantErr: true},
+			},
+		},
+		{
+			name:        "sum of path without window",
+			aggregation: v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionSum, Path: "bytes", Operator: v1alpha1.AggregationOperatorGreaterThan, Threshold: "1e9"},
+			steps: []step{
+				{minutes: 0, data: `{"bytes":600000000}`, value: "600000000", count: 1},
+				{minutes: 60, data: `{"bytes":"400000000"}`, value: "1000000000", count: 2},
+				{minutes: 120, data: `{"size":1}`, wantErr: true},
+				{minutes: 120, data: `{"bytes":"many"}`, wantErr: true},
+				{minutes: 180, data: `{"bytes":1}`, value: "1000000001", count: 3, met: true},
+			},
+		},
+		{
+			name:        "max within tumbling window",
+			aggregation: v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionMax, Path: "latency", Window: "10m", WindowType: v1alpha1.WindowTypeTumbling, Threshold: "100"},
+			steps: []step{
+				{minutes: 1, data: `{"latency":80}`, value: "80", count: 1},
+				{minutes: 9, data: `{"latency":90}`, value: "90", count: 2},
+				// the next window starts from scratch
+				{minutes: 10, data: `{"latency":20}`, value: "20", count: 1},
+				{minutes: 9, data: `{"latency":500}`, wantErr: true},
+				{minutes: 19, data: `{"latency":120.5}`, value: "120.5", count: 2, met: true},
+			},
+		},
+		{
+			name:        "min within sliding window",
+			aggregation: v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionMin, Path: "free", Window: "5m", Operator: v1alpha1.AggregationOperatorLessThan, Threshold: "10"},
+			steps: []step{
+				{minutes: 0, data: `{"free":8}`, value: "8", count: 1, met: true},
+				{minutes: 6, data: `{"free":30}`, value: "30", count: 1},
+				{minutes: 7, data: `{"free":20}`, value: "20", count: 2},
+			},
+		},
+		{
+			name:        "distinct within tumbling window",
+			aggregation: v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionDistinct, Path: "host", Window: "1h", WindowType: v1alpha1.WindowTypeTumbling, Operator: v1alpha1.AggregationOperatorEqual, Threshold: "2"},
+			steps: []step{
+				{minutes: 0, data: `{"host":"a"}`, value: "1", count: 1},
+				{minutes: 1, data: `{"host":"a"}`, value: "1", count: 2},
+				{minutes: 2, data: `{"host":"b"}`, value: "2", count: 3, met: true},
+				{minutes: 61, data: `{"host":"b"}`, value: "1", count: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var aggregate *v1alpha1.Aggregate
+			for i, s := range tt.steps {
+				event := &v1alpha1.Event{
+					Context: v1alpha1.EventContext{
+						ContentType: MediaTypeJSON,
+						EventTime:   metav1.Time{Time: start.Add(time.Duration(s.minutes) * time.Minute)},
+					},
+					Data: []byte(s.data),
+				}
+				got, met, err := aggregateEvent(&tt.aggregation, aggregate, event)
+				if (err != nil) != s.wantErr {
+					t.Fatalf("step %d: aggregateEvent() error = %v, wantErr %v", i, err, s.wantErr)
+				}
+				if err != nil {
+					continue
+				}
+				if got.Value != s.value || got.Count != s.count || met != s.met {
+					t.Errorf("step %d: aggregateEvent() = %s, %d, %v, want %s, %d, %v", i, got.Value, got.Count, met, s.value, s.count, s.met)
+				}
+				aggregate = got
+			}
+		})
+	}
+}
+
+func Test_aggregateEventSamples(t *testing.T) {
+	start := time.Date(2018, time.October, 11, 10, 0, 0, 0, time.UTC)
+	newEvent := func(i int, data string) *v1alpha1.Event {
+		return &v1alpha1.Event{
+			Context: v1alpha1.EventContext{
+				ContentType: MediaTypeJSON,
+				EventTime:   metav1.Time{Time: start.Add(time.Duration(i) * time.Millisecond)},
+			},
+			Data: []byte(data),
+		}
+	}
+
+	// the events of a sliding window are sampled in buckets
+	sum := &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionSum, Path: "bytes", Window: "1s", Threshold: "1e9"}
+	var aggregate *v1alpha1.Aggregate
+	for i := 0; i < 5*maxAggregateSamples; i++ {
+		var err error
+		aggregate, _, err = aggregateEvent(sum, aggregate, newEvent(i/5, `{"bytes":2}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(aggregate.Samples) > maxAggregateSamples+1 {
+		t.Errorf("expected at most %d samples but found %d", maxAggregateSamples+1, len(aggregate.Samples))
+	}
+	if aggregate.Value != "10000" || aggregate.Count != 5000 {
+		t.Errorf("expected sum 10000 of 5000 events but found %s of %d", aggregate.Value, aggregate.Count)
+	}
+
+	// distinct aggregations are limited in the number of values
+	distinct := &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionDistinct, Path: "host", Threshold: "1e9"}
+	aggregate = nil
+	for i := 0; i < maxAggregateSamples; i++ {
+		var err error
+		aggregate, _, err = aggregateEvent(distinct, aggregate, newEvent(i, `{"host":"`+strconv.Itoa(i)+`"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := aggregateEvent(distinct, aggregate, newEvent(0, `{"host":"other"}`)); err == nil {
+		t.Error("expected an error for a distinct value exceeding the maximum")
+	}
+	if got, _, err := aggregateEvent(distinct, aggregate, newEvent(0, `{"host":"0"}`)); err != nil || got.Value != strconv.Itoa(maxAggregateSamples) {
+		t.Errorf("expected a known distinct value to be aggregated but found %v", err)
+	}
+}
+
+func Test_renderAggregateAsJSON(t *testing.T) {
+	windowStart := metav1.Time{Time: time.Date(2018, time.October, 11, 10, 0, 0, 0, time.UTC)}
+	js, err := renderAggregateAsJSON(&v1alpha1.Aggregate{
+		Value:       "5",
+		Count:       5,
+		WindowStart: windowStart,
+		WindowEnd:   metav1.Time{Time: windowStart.Add(10 * time.Minute)},
+		Samples:     []v1alpha1.AggregateSample{v1alpha1.AggregateSample{Time: windowStart}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"value":"5","count":5,"windowStart":"2018-10-11T10:00:00Z","windowEnd":"2018-10-11T10:10:00Z"}`
+	if string(js) != want {
+		t.Errorf("renderAggregateAsJSON() = %s, want %s", js, want)
+	}
+	if _, err := renderAggregateAsJSON(nil); err == nil {
+		t.Error("renderAggregateAsJSON() expected an error for a nil aggregate")
+	}
+}
@@ -19,10 +19,9 @@ package controller
 import (
 	"fmt"
 	"reflect"
-	"strconv"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
-	"github.com/tidwall/gjson"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -105,37 +104,7 @@ func filterData(dataFilters []*v1alpha1.DataFilter, event *v1alpha1.Event) (bool
 	if err != nil {
 		return false, err
 	}
-	for _, f := range dataFilters {
-		res := gjson.GetBytes(js, f.Path)
-		if !res.Exists() {
-			return false, nil
-		}
-		switch f.Type {
-		case v1alpha1.JSONTypeBool:
-			val, err := strconv.ParseBool(f.Value)
-			if err != nil {
-				return false, err
-			}
-			if val != res.Bool() {
-				return false, nil
-			}
-		case v1alpha1.JSONTypeNumber:
-			val, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				return false, err
-			}
-			if val != res.Float() {
-				return false, nil
-			}
-		case v1alpha1.JSONTypeString:
-			if f.Value != res.Str {
-				return false, nil
-			}
-		default:
-			return false, fmt.Errorf("unsupported JSON type %s", f.Type)
-		}
-	}
-	return true, nil
+	return common.FilterData(dataFilters, js)
 }
 
 // checks that m contains the k,v pairs of sub
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
@@ -124,6 +126,7 @@ func (soc *sOperationCtx) watchSignal(signal *v1alpha1.Signal) error {
 		ctx, cancel = context.WithCancel(ctx)
 	}
 
+	signal = soc.resolveSignalState(signal)
 	stream, err := client.Listen(ctx, signal)
 	if err != nil {
 		cancel()
@@ -148,6 +151,50 @@ func (soc *sOperationCtx) watchSignal(signal *v1alpha1.Signal) error {
 	return nil
 }
 
+// resolveSignalState returns a copy of the signal with the state persisted in the sensor status
+// e.g. the time of the latest accepted event of calendar signals, used to catch up on missed events.
+func (soc *sOperationCtx) resolveSignalState(signal *v1alpha1.Signal) *v1alpha1.Signal {
+	signal = signal.DeepCopy()
+	signal.State = signalState(&soc.s.Status, signal)
+	return signal
+}
+
+// signalState returns the state of the signal persisted in the sensor status
+func signalState(status *v1alpha1.SensorStatus, signal *v1alpha1.Signal) *v1alpha1.SignalState {
+	state := &v1alpha1.SignalState{}
+	if lastEventTime, ok := status.LastEventTimes[signal.Name]; ok && signal.Calendar != nil {
+		state.LastFired = &lastEventTime
+	}
+	if fingerprint, ok := status.ArtifactFingerprints[signal.Name]; ok && isPolledArtifact(signal) {
+		state.ArtifactFingerprint = fingerprint
+	}
+	if refs, ok := status.GitRefs[signal.Name]; ok && signal.Git != nil {
+		state.GitRefs = refs.Refs
+	}
+	if position, ok := status.PostgresPositions[signal.Name]; ok && isReplicatedPostgres(signal) {
+		state.PostgresPosition = &position
+	}
+	return state
+}
+
+// sendSignalState sends the state of the signal persisted in the sensor status on the stream of the signal, if it is
+// still watched, so that the signal can acknowledge the accepted events to its source.
+// the stream is only sent to while the lock is held, so that it is not sent to concurrently when the signal is stopped.
+func (c *SensorController) sendSignalState(streamCtx *streamCtx, status *v1alpha1.SensorStatus) {
+	state := signalState(status, streamCtx.signal)
+	if reflect.DeepEqual(state, &v1alpha1.SignalState{}) {
+		return
+	}
+	c.signalMu.Lock()
+	defer c.signalMu.Unlock()
+	if stream, ok := c.signalStreams[streamCtx.nodeID]; !ok || stream != streamCtx.stream {
+		return
+	}
+	if err := streamCtx.stream.Send(&sdk.SignalContext{State: state}); err != nil {
+		log.Warnf("Event Stream (%s/%s) Failed to send the signal state: %s", streamCtx.sensor, streamCtx.signal.Name, err)
+	}
+}
+
 // stop the signal by:
 // 1. deleting the stream from the controller's signalStreams map
 // 2. sending the terminate signal on the stream and close it
@@ -232,23 +279,45 @@ func (c *SensorController) listenOnStream(streamCtx *streamCtx) {
 				log.Errorf("Event Stream (%s/%s) Msg: (Action:IGNORED) - Failed to filter event: %s", streamCtx.sensor, streamCtx.signal.Name, err)
 				continue
 			}
-			if ok {
+			if !ok {
+				log.Debugf("Event Stream (%s/%s) Msg: (Action:FILTERED) - Context: %s", streamCtx.sensor, streamCtx.signal.Name, in.Event.Context)
+				continue
+			}
+			if streamCtx.signal.Aggregation == nil {
 				log.Infof("Event Stream (%s/%s) Msg: (Action:ACCEPTED) - Context: %s", streamCtx.sensor, streamCtx.signal.Name, in.Event.Context)
 				node.LatestEvent = &v1alpha1.EventWrapper{Event: *in.Event}
 			} else {
-				log.Debugf("Event Stream (%s/%s) Msg: (Action:FILTERED) - Context: %s", streamCtx.sensor, streamCtx.signal.Name, in.Event.Context)
-				continue
+				// the node only completes once the aggregate of the window satisfies the threshold
+				aggregate, met, err := aggregateEvent(streamCtx.signal.Aggregation, node.Aggregate, in.Event)
+				if err != nil {
+					log.Errorf("Event Stream (%s/%s) Msg: (Action:IGNORED) - Failed to aggregate event: %s", streamCtx.sensor, streamCtx.signal.Name, err)
+					continue
+				}
+				node.Aggregate = aggregate
+				if met {
+					log.Infof("Event Stream (%s/%s) Msg: (Action:ACCEPTED) - Aggregate: %s - Context: %s", streamCtx.sensor, streamCtx.signal.Name, aggregate.Value, in.Event.Context)
+					node.LatestEvent = &v1alpha1.EventWrapper{Event: *in.Event}
+				} else {
+					log.Infof("Event Stream (%s/%s) Msg: (Action:AGGREGATED) - Aggregate: %s - Context: %s", streamCtx.sensor, streamCtx.signal.Name, aggregate.Value, in.Event.Context)
+				}
 			}
 		}
 
 		// TODO: perform a Patch here instead as the sensor could become stale
 		// and this exponential backoff is slow
+		var status v1alpha1.SensorStatus
 		err = wait.ExponentialBackoff(common.DefaultRetry, func() (bool, error) {
 			s, err := sensors.Get(streamCtx.sensor, metav1.GetOptions{})
 			if err != nil {
 				return false, err
 			}
 			s.Status.Nodes[streamCtx.nodeID] = node
+			if streamErr == nil {
+				updateLastEventTime(&s.Status, streamCtx.signal, in.Event.Context.EventTime)
+				updateArtifactFingerprint(&s.Status, streamCtx.signal, in.Event)
+				updateGitRef(&s.Status, streamCtx.signal, in.Event)
+				updatePostgresPosition(&s.Status, streamCtx.signal, in.Event)
+			}
 			s.Status.Phase = phase
 			s.Status.Message = msg
 			_, err = sensors.Update(s)
@@ -258,11 +327,15 @@ func (c *SensorController) listenOnStream(streamCtx *streamCtx) {
 				}
 				return false, nil
 			}
+			status = s.Status
 			return true, nil
 		})
 		if err != nil {
 			log.Panicf("Event Stream (%s/%s) Update Resource Failed: %s", streamCtx.sensor, streamCtx.signal.Name, err)
 		}
+		if streamErr == nil {
+			c.sendSignalState(streamCtx, &status)
+		}
 
 		// finally check if there was a streamErr, we must return
 		if streamErr != nil {
@@ -270,3 +343,91 @@ func (c *SensorController) listenOnStream(streamCtx *streamCtx) {
 		}
 	}
 }
+
+// updateLastEventTime records the event time as the latest event time of the calendar signal if it is after the current one
+func updateLastEventTime(status *v1alpha1.SensorStatus, signal *v1alpha1.Signal, eventTime metav1.Time) {
+	if signal.Calendar == nil {
+		return
+	}
+	if status.LastEventTimes == nil {
+		status.LastEventTimes = make(map[string]metav1.Time)
+	}
+	if last, ok := status.LastEventTimes[signal.Name]; ok && !last.Time.Before(eventTime.Time) {
+		return
+	}
+	status.LastEventTimes[signal.Name] = eventTime
+}
+
+// updateArtifactFingerprint records the fingerprint of the artifact of the event of the polled artifact signal, if any,
+// as the latest fingerprint of the signal
+func updateArtifactFingerprint(status *v1alpha1.SensorStatus, signal *v1alpha1.Signal, event *v1alpha1.Event) {
+	if !isPolledArtifact(signal) {
+		return
+	}
+	fingerprint, ok := event.Context.Extensions[sdk.ContextExtensionFingerprintKey]
+	if !ok {
+		return
+	}
+	if status.ArtifactFingerprints == nil {
+		status.ArtifactFingerprints = make(map[string]string)
+	}
+	status.ArtifactFingerprints[signal.Name] = fingerprint
+}
+
+// isPolledArtifact returns true if the signal polls an artifact
+func isPolledArtifact(signal *v1alpha1.Signal) bool {
+	return signal.Artifact != nil && signal.Artifact.Mode == v1alpha1.ArtifactSignalModePoll
+}
+
+// updateGitRef records the commit of the event of the git signal, if any, as the latest commit of its ref for the signal
+func updateGitRef(status *v1alpha1.SensorStatus, signal *v1alpha1.Signal, event *v1alpha1.Event) {
+	if signal.Git == nil {
+		return
+	}
+	ref, ok := event.Context.Extensions[sdk.ContextExtensionGitRefKey]
+	if !ok {
+		return
+	}
+	commit, ok := event.Context.Extensions[sdk.ContextExtensionGitCommitKey]
+	if !ok {
+		return
+	}
+	if status.GitRefs == nil {
+		status.GitRefs = make(map[string]v1alpha1.GitRefs)
+	}
+	refs := status.GitRefs[signal.Name]
+	if refs.Refs == nil {
+		refs.Refs = make(map[string]string)
+	}
+	refs.Refs[ref] = commit
+	status.GitRefs[signal.Name] = refs
+}
+
+// updatePostgresPosition records the position of the row change of the event of the replicated postgres signal, if any,
+// as the latest position of the signal
+func updatePostgresPosition(status *v1alpha1.SensorStatus, signal *v1alpha1.Signal, event *v1alpha1.Event) {
+	if !isReplicatedPostgres(signal) {
+		return
+	}
+	lsn, ok := event.Context.Extensions[sdk.ContextExtensionPostgresLSNKey]
+	if !ok {
+		return
+	}
+	row, err := strconv.ParseInt(event.Context.Extensions[sdk.ContextExtensionPostgresRowKey], 10, 32)
+	if err != nil {
+		return
+	}
+	rows, err := strconv.ParseInt(event.Context.Extensions[sdk.ContextExtensionPostgresRowsKey], 10, 32)
+	if err != nil {
+		return
+	}
+	if status.PostgresPositions == nil {
+		status.PostgresPositions = make(map[string]v1alpha1.PostgresPosition)
+	}
+	status.PostgresPositions[signal.Name] = v1alpha1.PostgresPosition{LSN: lsn, Row: int32(row), Rows: int32(rows)}
+}
+
+// isReplicatedPostgres returns true if the signal consumes the row changes of a postgres database
+func isReplicatedPostgres(signal *v1alpha1.Signal) bool {
+	return signal.Postgres != nil && signal.Postgres.Replication != nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveSignalState(t *testing.T) {
+	lastFired := metav1.Time{Time: time.Date(2018, 10, 11, 9, 0, 0, 0, time.UTC)}
+	sensor := &v1alpha1.Sensor{
+		Status: v1alpha1.SensorStatus{
+			LastEventTimes:       map[string]metav1.Time{"nightly": lastFired, "orders": lastFired},
+			ArtifactFingerprints: map[string]string{"report": "etag:1", "orders": "etag:1"},
+			GitRefs:              map[string]v1alpha1.GitRefs{"commits": {Refs: map[string]string{"refs/heads/master": "a1"}}},
+			PostgresPositions:    map[string]v1alpha1.PostgresPosition{"rows": {LSN: "0/16B3748", Row: 1, Rows: 2}},
+		},
+	}
+	soc := newSensorOperationCtx(sensor, nil)
+
+	calendar := &v1alpha1.Signal{Name: "nightly", Calendar: &v1alpha1.CalendarSignal{Schedule: "0 0 * * *"}}
+	resolved := soc.resolveSignalState(calendar)
+	assert.Equal(t, &lastFired, resolved.State.LastFired)
+	assert.Nil(t, calendar.State, "the state must not be set on the signal of the spec")
+
+	artifact := &v1alpha1.Signal{Name: "report", Artifact: &v1alpha1.ArtifactSignal{Mode: v1alpha1.ArtifactSignalModePoll}}
+	assert.Equal(t, "etag:1", soc.resolveSignalState(artifact).State.ArtifactFingerprint)
+
+	git := &v1alpha1.Signal{Name: "commits", Git: &v1alpha1.GitSignal{URL: "https://github.com/argoproj/argo-events.git"}}
+	assert.Equal(t, map[string]string{"refs/heads/master": "a1"}, soc.resolveSignalState(git).State.GitRefs)
+
+	postgres := &v1alpha1.Signal{Name: "rows", Postgres: &v1alpha1.PostgresSignal{Replication: &v1alpha1.PostgresReplication{Slot: "argo_events"}}}
+	assert.Equal(t, &v1alpha1.PostgresPosition{LSN: "0/16B3748", Row: 1, Rows: 2}, soc.resolveSignalState(postgres).State.PostgresPosition)
+
+	// the state cannot be set in the spec
+	var signal v1alpha1.Signal
+	assert.Nil(t, json.Unmarshal([]byte(`{"name":"nightly","state":{"lastFired":"2018-10-11T09:00:00Z"}}`), &signal))
+	assert.Nil(t, signal.State)
+
+	// the state is only resolved for the signals of its type
+	webhook := &v1alpha1.Signal{Name: "orders", Webhook: &v1alpha1.WebhookSignal{Endpoint: "/orders"}}
+	assert.Equal(t, &v1alpha1.SignalState{}, soc.resolveSignalState(webhook).State)
+}
+
+func TestUpdateSignalState(t *testing.T) {
+	eventTime := metav1.Time{Time: time.Date(2018, 10, 11, 9, 0, 0, 0, time.UTC)}
+	calendar := &v1alpha1.Signal{Name: "nightly", Calendar: &v1alpha1.CalendarSignal{Schedule: "0 0 * * *"}}
+	webhook := &v1alpha1.Signal{Name: "orders", Webhook: &v1alpha1.WebhookSignal{Endpoint: "/orders"}}
+
+	var status v1alpha1.SensorStatus
+	updateLastEventTime(&status, calendar, eventTime)
+	updateLastEventTime(&status, calendar, metav1.Time{Time: eventTime.Add(-time.Hour)})
+	updateLastEventTime(&status, webhook, eventTime)
+	assert.Equal(t, map[string]metav1.Time{"nightly": eventTime}, status.LastEventTimes)
+
+	// the state of a signal is only recorded from the events of the signals of its type
+	artifact := &v1alpha1.Signal{Name: "report", Artifact: &v1alpha1.ArtifactSignal{Mode: v1alpha1.ArtifactSignalModePoll}}
+	event := &v1alpha1.Event{Context: v1alpha1.EventContext{Extensions: map[string]string{sdk.ContextExtensionFingerprintKey: "etag:2"}}}
+	updateArtifactFingerprint(&status, artifact, event)
+	updateArtifactFingerprint(&status, webhook, event)
+	assert.Equal(t, map[string]string{"report": "etag:2"}, status.ArtifactFingerprints)
+
+	git := &v1alpha1.Signal{Name: "commits", Git: &v1alpha1.GitSignal{URL: "https://github.com/argoproj/argo-events.git"}}
+	event = &v1alpha1.Event{Context: v1alpha1.EventContext{Extensions: map[string]string{
+		sdk.ContextExtensionGitRefKey:    "refs/heads/master",
+		sdk.ContextExtensionGitCommitKey: "a2",
+	}}}
+	updateGitRef(&status, git, event)
+	updateGitRef(&status, webhook, event)
+	assert.Equal(t, map[string]v1alpha1.GitRefs{"commits": {Refs: map[string]string{"refs/heads/master": "a2"}}}, status.GitRefs)
+
+	postgres := &v1alpha1.Signal{Name: "rows", Postgres: &v1alpha1.PostgresSignal{Replication: &v1alpha1.PostgresReplication{Slot: "argo_events"}}}
+	event = &v1alpha1.Event{Context: v1alpha1.EventContext{Extensions: map[string]string{
+		sdk.ContextExtensionPostgresLSNKey:  "0/16B3748",
+		sdk.ContextExtensionPostgresRowKey:  "1",
+		sdk.ContextExtensionPostgresRowsKey: "2",
+	}}}
+	updatePostgresPosition(&status, postgres, event)
+	updatePostgresPosition(&status, webhook, event)
+	assert.Equal(t, map[string]v1alpha1.PostgresPosition{"rows": {LSN: "0/16B3748", Row: 1, Rows: 2}}, status.PostgresPositions)
+}
@@ -25,11 +25,11 @@ import (
 )
 
 // apply the params to the resource json object
-func applyParams(jsonObj []byte, params []v1alpha1.ResourceParameter, events map[string]v1alpha1.Event) ([]byte, error) {
+func applyParams(jsonObj []byte, params []v1alpha1.ResourceParameter, events map[string]v1alpha1.Event, aggregates map[string]*v1alpha1.Aggregate) ([]byte, error) {
 	tmp := make([]byte, len(jsonObj))
 	for _, param := range params {
 		// let's grab the param value
-		v, err := resolveParamValue(param.Src, events)
+		v, err := resolveParamValue(param.Src, events, aggregates)
 		if err != nil {
 			return nil, err
 		}
@@ -46,9 +46,8 @@ func applyParams(jsonObj []byte, params []v1alpha1.ResourceParameter, events map
 
 // helper method to resolve the parameter's value from the src
 // returns an error if the Path is invalid/not found and the default value is nil OR if the signal event doesn't exist and default value is nil
-func resolveParamValue(src *v1alpha1.ResourceParameterSource, events map[string]v1alpha1.Event) (string, error) {
-	if e, ok := events[src.Signal]; ok {
-		js, err := renderEventDataAsJSON(&e)
+func resolveParamValue(src *v1alpha1.ResourceParameterSource, events map[string]v1alpha1.Event, aggregates map[string]*v1alpha1.Aggregate) (string, error) {
+	if js, ok, err := renderParamSource(src, events, aggregates); ok {
 		if err != nil {
 			if src.Value != nil {
 				return *src.Value, nil
@@ -65,3 +64,22 @@ func resolveParamValue(src *v1alpha1.ResourceParameterSource, events map[string]
 	}
 	return "", fmt.Errorf("unable to resolve '%s' parameter value. verify the path: '%s' is valid and/or set a default value for this param", src.Signal, src.Path)
 }
+
+// renderParamSource renders the event data or, for aggregate sources, the aggregate of the signal of the source as JSON
+// returns false if the signal has no event or aggregate.
+func renderParamSource(src *v1alpha1.ResourceParameterSource, events map[string]v1alpha1.Event, aggregates map[string]*v1alpha1.Aggregate) ([]byte, bool, error) {
+	if src.Aggregate {
+		a, ok := aggregates[src.Signal]
+		if !ok {
+			return nil, false, nil
+		}
+		js, err := renderAggregateAsJSON(a)
+		return js, true, err
+	}
+	e, ok := events[src.Signal]
+	if !ok {
+		return nil, false, nil
+	}
+	js, err := renderEventDataAsJSON(&e)
+	return js, true, err
+}
@@ -38,10 +38,18 @@ func Test_applyParams(t *testing.T) {
 			Data: []byte(`apiVersion: v1alpha1`),
 		},
 	}
+	aggregates := map[string]*v1alpha1.Aggregate{
+		"simpleJSON": &v1alpha1.Aggregate{
+			Value:   "5",
+			Count:   5,
+			Samples: []v1alpha1.AggregateSample{v1alpha1.AggregateSample{Value: "matt"}},
+		},
+	}
 	type args struct {
-		jsonObj []byte
-		params  []v1alpha1.ResourceParameter
-		events  map[string]v1alpha1.Event
+		jsonObj    []byte
+		params     []v1alpha1.ResourceParameter
+		events     map[string]v1alpha1.Event
+		aggregates map[string]*v1alpha1.Aggregate
 	}
 	tests := []struct {
 		name    string
@@ -153,6 +161,53 @@ func Test_applyParams(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "simpleJSON aggregate and event -> success",
+			args: args{
+				jsonObj: []byte(`{"count":"","name":""}`),
+				params: []v1alpha1.ResourceParameter{
+					v1alpha1.ResourceParameter{
+						Src: &v1alpha1.ResourceParameterSource{
+							Signal:    "simpleJSON",
+							Path:      "value",
+							Aggregate: true,
+						},
+						Dest: "count",
+					},
+					v1alpha1.ResourceParameter{
+						Src: &v1alpha1.ResourceParameterSource{
+							Signal: "simpleJSON",
+							Path:   "name.first",
+						},
+						Dest: "name",
+					},
+				},
+				events:     events,
+				aggregates: aggregates,
+			},
+			want:    []byte(`{"count":"5","name":"matt"}`),
+			wantErr: false,
+		},
+		{
+			name: "missing aggregate, no default -> error",
+			args: args{
+				jsonObj: []byte(``),
+				params: []v1alpha1.ResourceParameter{
+					v1alpha1.ResourceParameter{
+						Src: &v1alpha1.ResourceParameterSource{
+							Signal:    "invalidJSON",
+							Path:      "value",
+							Aggregate: true,
+						},
+						Dest: "x",
+					},
+				},
+				events:     events,
+				aggregates: aggregates,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "invalidJSON, default set -> success",
 			args: args{
@@ -174,7 +229,7 @@ func Test_applyParams(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := applyParams(tt.args.jsonObj, tt.args.params, tt.args.events)
+			got, err := applyParams(tt.args.jsonObj, tt.args.params, tt.args.events, tt.args.aggregates)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("applyParams() error = %v, wantErr %v", err, tt.wantErr)
 				return
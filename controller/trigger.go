@@ -117,7 +117,7 @@ func (soc *sOperationCtx) createResourceObject(resource *v1alpha1.ResourceObject
 
 	// passing parameters to the resource object requires 4 steps
 	// 1. marshaling the obj to JSON
-	// 2. extract the appropriate signal events based on the resource params
+	// 2. extract the appropriate signal events and aggregates based on the resource params
 	// 3. apply the params to the JSON object
 	// 4. unmarshal the obj from the updated JSON
 	if len(resource.Parameters) > 0 {
@@ -126,7 +126,8 @@ func (soc *sOperationCtx) createResourceObject(resource *v1alpha1.ResourceObject
 			return err
 		}
 		events := soc.extractSignalEvents(resource.Parameters)
-		jUpdatedObj, err := applyParams(jObj, resource.Parameters, events)
+		aggregates := soc.extractSignalAggregates(resource.Parameters)
+		jUpdatedObj, err := applyParams(jObj, resource.Parameters, events, aggregates)
 		if err != nil {
 			return err
 		}
@@ -176,7 +177,7 @@ func (soc *sOperationCtx) createResourceObject(resource *v1alpha1.ResourceObject
 func (soc *sOperationCtx) extractSignalEvents(params []v1alpha1.ResourceParameter) map[string]v1alpha1.Event {
 	events := make(map[string]v1alpha1.Event)
 	for _, param := range params {
-		if param.Src != nil {
+		if param.Src != nil && !param.Src.Aggregate {
 			node := soc.getNodeByName(param.Src.Signal)
 			if node == nil {
 				soc.log.Warnf("WARNING: signal node for '%s' does not exist, cannot apply parameter '%s'", param.Src.Signal, param.Dest)
@@ -191,3 +192,20 @@ func (soc *sOperationCtx) extractSignalEvents(params []v1alpha1.ResourceParamete
 	}
 	return events
 }
+
+// helper method to extract the aggregates of the signals associated with the aggregate resource params
+// returns a map of the aggregates keyed by the signal name
+func (soc *sOperationCtx) extractSignalAggregates(params []v1alpha1.ResourceParameter) map[string]*v1alpha1.Aggregate {
+	aggregates := make(map[string]*v1alpha1.Aggregate)
+	for _, param := range params {
+		if param.Src != nil && param.Src.Aggregate {
+			node := soc.getNodeByName(param.Src.Signal)
+			if node == nil || node.Aggregate == nil {
+				soc.log.Warnf("WARNING: signal node for '%s' does not contain an aggregate, cannot apply parameter '%s'", param.Src.Signal, param.Dest)
+				continue
+			}
+			aggregates[param.Src.Signal] = node.Aggregate
+		}
+	}
+	return aggregates
+}
@@ -18,11 +18,21 @@ package controller
 
 import (
 	"fmt"
+	"net/mail"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // validateSensor accepts a sensor and performs validation against it
@@ -90,13 +100,81 @@ func validateSignals(signals []v1alpha1.Signal) error {
 			i++
 		}
 		if signal.Resource != nil {
-			//todo: validate resource signals
+			if err := validateResourceSignal(signal.Resource); err != nil {
+				signalErrs[v1alpha1.SignalTypeResource] = err
+			}
 			i++
 		}
 		if signal.Webhook != nil {
 			//todo: validate webhook signals
 			i++
 		}
+		if signal.File != nil {
+			if err := validateFileSignal(signal.File); err != nil {
+				signalErrs[v1alpha1.SignalTypeFile] = err
+			}
+			i++
+		}
+		if signal.Git != nil {
+			if err := validateGitSignal(signal.Git); err != nil {
+				signalErrs[v1alpha1.SignalTypeGit] = err
+			}
+			i++
+		}
+		if signal.HTTPPoll != nil {
+			if err := validateHTTPPollSignal(signal.HTTPPoll); err != nil {
+				signalErrs[v1alpha1.SignalTypeHTTPPoll] = err
+			}
+			i++
+		}
+		if signal.CloudEvents != nil {
+			if err := validateCloudEventsSignal(signal.CloudEvents); err != nil {
+				signalErrs[v1alpha1.SignalTypeCloudEvents] = err
+			}
+			i++
+		}
+		if signal.KubeEvents != nil {
+			if err := validateKubeEventsSignal(signal.KubeEvents); err != nil {
+				signalErrs[v1alpha1.SignalTypeKubeEvents] = err
+			}
+			i++
+		}
+		if signal.Alertmanager != nil {
+			if err := validateAlertmanagerSignal(signal.Alertmanager); err != nil {
+				signalErrs[v1alpha1.SignalTypeAlertmanager] = err
+			}
+			i++
+		}
+		if signal.Postgres != nil {
+			if err := validatePostgresSignal(signal.Postgres); err != nil {
+				signalErrs[v1alpha1.SignalTypePostgres] = err
+			}
+			i++
+		}
+		if signal.GRPC != nil {
+			if err := validateGRPCSignal(signal.GRPC); err != nil {
+				signalErrs[v1alpha1.SignalTypeGRPC] = err
+			}
+			i++
+		}
+		if signal.Syslog != nil {
+			if err := validateSyslogSignal(signal.Syslog); err != nil {
+				signalErrs[v1alpha1.SignalTypeSyslog] = err
+			}
+			i++
+		}
+		if signal.SMTP != nil {
+			if err := validateSMTPSignal(signal.SMTP); err != nil {
+				signalErrs[v1alpha1.SignalTypeSMTP] = err
+			}
+			i++
+		}
+		if signal.SFTP != nil {
+			if err := validateSFTPSignal(signal.SFTP); err != nil {
+				signalErrs[v1alpha1.SignalTypeSFTP] = err
+			}
+			i++
+		}
 		if i != 1 {
 			return fmt.Errorf("signal '%s' defines multiple types", signal.Name)
 		}
@@ -107,6 +185,11 @@ func validateSignals(signals []v1alpha1.Signal) error {
 		if err := validateSignalFilter(signal.Filters); err != nil {
 			return err
 		}
+		if signal.Aggregation != nil {
+			if err := validateSignalAggregation(signal.Aggregation); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -125,18 +208,384 @@ func validateArtifactSignal(artifact *v1alpha1.ArtifactSignal) error {
 	if !artifact.HasLocation() {
 		return fmt.Errorf("invalid artifact signal: source location is missing")
 	}
-	if err := validateStreamSignal(&artifact.Target); err != nil {
-		return fmt.Errorf("invalid artifact signal: target stream failed with %s", err)
+	if artifact.InlineContentLimit < 0 {
+		return fmt.Errorf("invalid artifact signal: inline content limit must not be negative")
+	}
+	switch artifact.Mode {
+	case "", v1alpha1.ArtifactSignalModeStream:
+		if err := validateStreamSignal(&artifact.Target); err != nil {
+			return fmt.Errorf("invalid artifact signal: target stream failed with %s", err)
+		}
+	case v1alpha1.ArtifactSignalModeListen:
+		if artifact.S3 == nil {
+			return fmt.Errorf("invalid artifact signal: listen mode requires an s3 location")
+		}
+		if artifact.S3.Endpoint == "" || artifact.S3.Bucket == "" || artifact.S3.Event == "" {
+			return fmt.Errorf("invalid artifact signal: listen mode requires the s3 endpoint, bucket and event")
+		}
+	case v1alpha1.ArtifactSignalModePoll:
+		if artifact.S3 != nil && artifact.S3.Key == "" {
+			return fmt.Errorf("invalid artifact signal: poll mode requires the s3 key")
+		}
+		if artifact.Poll != nil && artifact.Poll.Interval != "" {
+			if _, err := time.ParseDuration(artifact.Poll.Interval); err != nil {
+				return fmt.Errorf("invalid artifact signal: invalid poll interval '%s'", artifact.Poll.Interval)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid artifact signal: unknown mode '%s'", artifact.Mode)
 	}
 	return nil
 }
 
 func validateCalendarSignal(calendar *v1alpha1.CalendarSignal) error {
-	if calendar.Interval == "" && calendar.Schedule == "" {
-		return fmt.Errorf("invalid calendar signal: one of interval or schedule should be specified")
+	location := time.Local
+	if calendar.Timezone != "" {
+		var err error
+		if location, err = time.LoadLocation(calendar.Timezone); err != nil {
+			return fmt.Errorf("invalid calendar signal: unknown timezone '%s'", calendar.Timezone)
+		}
 	}
-	if _, err := common.ParseExclusionDates(calendar.Recurrence); err != nil {
-		return err
+	recurrence, err := common.ParseRecurrence(calendar.Recurrence, location)
+	if err != nil {
+		return fmt.Errorf("invalid calendar signal: %s", err)
+	}
+	if calendar.ICalendar != nil {
+		if err := validateICalendarSource(calendar.ICalendar); err != nil {
+			return err
+		}
+	}
+	includesICalendar := calendar.ICalendar != nil && calendar.ICalendar.Mode == v1alpha1.ICalendarModeInclude
+	if calendar.Interval == "" && calendar.Schedule == "" && !recurrence.IsSchedule() && !includesICalendar {
+		return fmt.Errorf("invalid calendar signal: one of interval, schedule or recurrence rules and dates should be specified")
+	}
+	if (calendar.Interval != "" || calendar.Schedule != "") && recurrence.IsSchedule() {
+		return fmt.Errorf("invalid calendar signal: recurrence rules and dates cannot be combined with an interval or schedule")
+	}
+	if calendar.CatchUp != nil {
+		switch calendar.CatchUp.Mode {
+		case v1alpha1.CatchUpModeSkip, v1alpha1.CatchUpModeOnce:
+		case v1alpha1.CatchUpModeAll:
+			if calendar.CatchUp.Limit <= 0 {
+				return fmt.Errorf("invalid calendar signal: catch up mode '%s' requires a positive limit", calendar.CatchUp.Mode)
+			}
+		default:
+			return fmt.Errorf("invalid calendar signal: unknown catch up mode '%s'", calendar.CatchUp.Mode)
+		}
+	}
+	return nil
+}
+
+func validateResourceSignal(resource *v1alpha1.ResourceSignal) error {
+	scopes := 0
+	if resource.Namespace != "" {
+		scopes++
+	}
+	if len(resource.Namespaces) > 0 {
+		scopes++
+	}
+	if resource.NamespaceSelector != "" {
+		scopes++
+		if _, err := labels.Parse(resource.NamespaceSelector); err != nil {
+			return fmt.Errorf("invalid resource signal: invalid namespace selector '%s': %s", resource.NamespaceSelector, err)
+		}
+	}
+	if scopes > 1 {
+		return fmt.Errorf("invalid resource signal: only one of namespace, namespaces and namespaceSelector can be specified")
+	}
+	for _, fc := range resource.FieldChanges {
+		if fc.Path == "" {
+			return fmt.Errorf("invalid resource signal: field change path must be specified")
+		}
+	}
+	if resource.Filter == nil {
+		return nil
+	}
+	for _, eventType := range resource.Filter.EventTypes {
+		switch eventType {
+		case v1alpha1.ResourceEventTypeAdded, v1alpha1.ResourceEventTypeModified, v1alpha1.ResourceEventTypeDeleted:
+		default:
+			return fmt.Errorf("invalid resource signal: unknown event type '%s'", eventType)
+		}
+	}
+	if _, err := fields.ParseSelector(resource.Filter.FieldSelector); err != nil {
+		return fmt.Errorf("invalid resource signal: invalid field selector '%s': %s", resource.Filter.FieldSelector, err)
+	}
+	return nil
+}
+
+func validateFileSignal(file *v1alpha1.FileSignal) error {
+	if !filepath.IsAbs(file.Directory) {
+		return fmt.Errorf("invalid file signal: directory must be an absolute path")
+	}
+	for _, op := range file.Operations {
+		switch op {
+		case v1alpha1.FileOperationCreate, v1alpha1.FileOperationWrite, v1alpha1.FileOperationRemove, v1alpha1.FileOperationRename:
+		default:
+			return fmt.Errorf("invalid file signal: unknown operation '%s'", op)
+		}
+	}
+	for _, pattern := range file.Patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid file signal: invalid pattern '%s'", pattern)
+		}
+	}
+	if file.Debounce != "" {
+		if _, err := time.ParseDuration(file.Debounce); err != nil {
+			return fmt.Errorf("invalid file signal: invalid debounce '%s'", file.Debounce)
+		}
+	}
+	return nil
+}
+
+func validateGitSignal(git *v1alpha1.GitSignal) error {
+	if git.URL == "" {
+		return fmt.Errorf("invalid git signal: url must be specified")
+	}
+	if git.SSHKey != nil && git.Token != nil {
+		return fmt.Errorf("invalid git signal: only one of sshKey and token can be specified")
+	}
+	for _, ref := range git.Refs {
+		if _, err := path.Match(ref, ""); err != nil {
+			return fmt.Errorf("invalid git signal: invalid ref pattern '%s'", ref)
+		}
+	}
+	if git.Interval != "" {
+		if _, err := time.ParseDuration(git.Interval); err != nil {
+			return fmt.Errorf("invalid git signal: invalid interval '%s'", git.Interval)
+		}
+	}
+	if git.MaxCommits < 0 {
+		return fmt.Errorf("invalid git signal: max commits must not be negative")
+	}
+	return nil
+}
+
+func validateHTTPPollSignal(poll *v1alpha1.HTTPPollSignal) error {
+	if poll.URL == "" {
+		return fmt.Errorf("invalid httppoll signal: url must be specified")
+	}
+	if _, err := url.Parse(poll.URL); err != nil {
+		return fmt.Errorf("invalid httppoll signal: invalid url '%s'", poll.URL)
+	}
+	if poll.BasicAuth != nil && poll.BearerToken != nil {
+		return fmt.Errorf("invalid httppoll signal: only one of basicAuth and bearerToken can be specified")
+	}
+	for name, value := range map[string]string{"interval": poll.Interval, "timeout": poll.Timeout, "maxBackoff": poll.MaxBackoff} {
+		if value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			return fmt.Errorf("invalid httppoll signal: invalid %s '%s'", name, value)
+		}
+	}
+	for _, c := range poll.Conditions {
+		if c == nil || c.Path == "" {
+			return fmt.Errorf("invalid httppoll signal: condition path must be specified")
+		}
+		switch c.Type {
+		case v1alpha1.JSONTypeBool, v1alpha1.JSONTypeNumber, v1alpha1.JSONTypeString:
+		default:
+			return fmt.Errorf("invalid httppoll signal: unsupported condition type '%s'", c.Type)
+		}
+	}
+	return nil
+}
+
+func validateCloudEventsSignal(ce *v1alpha1.CloudEventsSignal) error {
+	if ce.Endpoint == "" {
+		return fmt.Errorf("invalid cloudevents signal: endpoint must be specified")
+	}
+	if !strings.HasPrefix(ce.Endpoint, "/") {
+		return fmt.Errorf("invalid cloudevents signal: endpoint '%s' must start with /", ce.Endpoint)
+	}
+	return nil
+}
+
+func validateAlertmanagerSignal(am *v1alpha1.AlertmanagerSignal) error {
+	if am.Endpoint == "" {
+		return fmt.Errorf("invalid alertmanager signal: endpoint must be specified")
+	}
+	if !strings.HasPrefix(am.Endpoint, "/") {
+		return fmt.Errorf("invalid alertmanager signal: endpoint '%s' must start with /", am.Endpoint)
+	}
+	return nil
+}
+
+func validatePostgresSignal(pg *v1alpha1.PostgresSignal) error {
+	if pg.ConnectionString == nil {
+		return fmt.Errorf("invalid postgres signal: connection string must be specified")
+	}
+	if len(pg.Channels) == 0 && pg.Replication == nil {
+		return fmt.Errorf("invalid postgres signal: at least one of channels and replication must be specified")
+	}
+	for _, channel := range pg.Channels {
+		if channel == "" {
+			return fmt.Errorf("invalid postgres signal: channel must not be empty")
+		}
+	}
+	if r := pg.Replication; r != nil {
+		if !isPostgresName(r.Slot) {
+			return fmt.Errorf("invalid postgres signal: slot '%s' must only contain lower case letters, numbers and underscores", r.Slot)
+		}
+		switch r.Plugin {
+		case "", v1alpha1.PostgresReplicationPluginWal2JSON:
+		case v1alpha1.PostgresReplicationPluginPGOutput:
+			if r.Publication == "" {
+				return fmt.Errorf("invalid postgres signal: publication must be specified for the pgoutput plugin")
+			}
+		default:
+			return fmt.Errorf("invalid postgres signal: unsupported plugin '%s'", r.Plugin)
+		}
+		for _, table := range r.Tables {
+			if table == "" || strings.Count(table, ".") > 1 {
+				return fmt.Errorf("invalid postgres signal: invalid table '%s'", table)
+			}
+		}
+	}
+	return nil
+}
+
+// isPostgresName checks if the name is a valid name of a replication slot
+func isPostgresName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+func validateGRPCSignal(g *v1alpha1.GRPCSignal) error {
+	if g.Token != nil && g.Token.Key == "" {
+		return fmt.Errorf("invalid grpc signal: token key must be specified")
+	}
+	for _, cn := range g.ClientCommonNames {
+		if cn == "" {
+			return fmt.Errorf("invalid grpc signal: client common name must not be empty")
+		}
+	}
+	if g.Token == nil && len(g.ClientCommonNames) == 0 && !g.AllowAnonymous {
+		return fmt.Errorf("invalid grpc signal: one of token and clientCommonNames must be specified unless allowAnonymous is set")
+	}
+	return nil
+}
+
+func validateSyslogSignal(sl *v1alpha1.SyslogSignal) error {
+	for _, protocol := range sl.Protocols {
+		switch protocol {
+		case v1alpha1.SyslogProtocolUDP, v1alpha1.SyslogProtocolTCP, v1alpha1.SyslogProtocolTLS:
+		default:
+			return fmt.Errorf("invalid syslog signal: unknown protocol '%s'", protocol)
+		}
+	}
+	switch sl.Severity {
+	case "", v1alpha1.SyslogSeverityEmergency, v1alpha1.SyslogSeverityAlert, v1alpha1.SyslogSeverityCritical, v1alpha1.SyslogSeverityError,
+		v1alpha1.SyslogSeverityWarning, v1alpha1.SyslogSeverityNotice, v1alpha1.SyslogSeverityInfo, v1alpha1.SyslogSeverityDebug:
+	default:
+		return fmt.Errorf("invalid syslog signal: unknown severity '%s'", sl.Severity)
+	}
+	if sl.Pattern != "" {
+		if _, err := regexp.Compile(sl.Pattern); err != nil {
+			return fmt.Errorf("invalid syslog signal: invalid pattern '%s'. Cause: %s", sl.Pattern, err)
+		}
+	}
+	return nil
+}
+
+func validateSMTPSignal(sm *v1alpha1.SMTPSignal) error {
+	if len(sm.Recipients) == 0 {
+		return fmt.Errorf("invalid smtp signal: at least one recipient must be specified")
+	}
+	for _, recipient := range sm.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return fmt.Errorf("invalid smtp signal: invalid recipient '%s'", recipient)
+		}
+	}
+	for _, sender := range sm.Senders {
+		if strings.TrimPrefix(sender, "@") == "" {
+			return fmt.Errorf("invalid smtp signal: sender must not be empty")
+		}
+	}
+	if sm.Attachments != nil {
+		if sm.Attachments.S3 == nil {
+			return fmt.Errorf("invalid smtp signal: attachments must be an s3 location")
+		}
+		if sm.Attachments.S3.Bucket == "" {
+			return fmt.Errorf("invalid smtp signal: bucket of the attachments must be specified")
+		}
+	}
+	return nil
+}
+
+func validateSFTPSignal(sf *v1alpha1.SFTPSignal) error {
+	if sf.Address == "" {
+		return fmt.Errorf("invalid sftp signal: address must be specified")
+	}
+	if sf.Directory == "" {
+		return fmt.Errorf("invalid sftp signal: directory must be specified")
+	}
+	if sf.Username == "" {
+		return fmt.Errorf("invalid sftp signal: username must be specified")
+	}
+	if sf.Password == nil && sf.PrivateKey == nil {
+		return fmt.Errorf("invalid sftp signal: one of password and privateKey must be specified")
+	}
+	if sf.HostKey == "" && !sf.InsecureIgnoreHostKey {
+		return fmt.Errorf("invalid sftp signal: hostKey must be specified unless insecureIgnoreHostKey is set")
+	}
+	for _, pattern := range sf.Patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid sftp signal: invalid pattern '%s'", pattern)
+		}
+	}
+	if sf.Interval != "" {
+		if _, err := time.ParseDuration(sf.Interval); err != nil {
+			return fmt.Errorf("invalid sftp signal: invalid interval '%s'", sf.Interval)
+		}
+	}
+	if sf.ArchiveDirectory != "" && path.Clean(sf.ArchiveDirectory) == path.Clean(sf.Directory) {
+		return fmt.Errorf("invalid sftp signal: archive directory must differ from the directory")
+	}
+	return nil
+}
+
+func validateKubeEventsSignal(ke *v1alpha1.KubeEventsSignal) error {
+	for _, typ := range ke.Types {
+		if typ != apiv1.EventTypeNormal && typ != apiv1.EventTypeWarning {
+			return fmt.Errorf("invalid kube events signal: unknown event type '%s'", typ)
+		}
+	}
+	if ke.InvolvedObject != nil && ke.InvolvedObject.Name != "" {
+		if _, err := path.Match(ke.InvolvedObject.Name, ""); err != nil {
+			return fmt.Errorf("invalid kube events signal: invalid involved object name pattern '%s'", ke.InvolvedObject.Name)
+		}
+	}
+	if ke.CollapseInterval != "" {
+		if d, err := time.ParseDuration(ke.CollapseInterval); err != nil || d < 0 {
+			return fmt.Errorf("invalid kube events signal: invalid collapse interval '%s'", ke.CollapseInterval)
+		}
+	}
+	return nil
+}
+
+func validateICalendarSource(source *v1alpha1.ICalendarSource) error {
+	switch source.Mode {
+	case "", v1alpha1.ICalendarModeExclude, v1alpha1.ICalendarModeInclude:
+	default:
+		return fmt.Errorf("invalid calendar signal: unknown icalendar mode '%s'", source.Mode)
+	}
+	if source.RefreshInterval != "" {
+		if _, err := time.ParseDuration(source.RefreshInterval); err != nil {
+			return fmt.Errorf("invalid calendar signal: invalid icalendar refresh interval '%s'", source.RefreshInterval)
+		}
+	}
+	loc := source.Location
+	if loc.S3 == nil && loc.Inline == nil && loc.File == nil && loc.URL == nil {
+		return fmt.Errorf("invalid calendar signal: icalendar location must be specified")
 	}
 	return nil
 }
@@ -150,6 +599,40 @@ func validateSignalFilter(filter v1alpha1.SignalFilter) error {
 	return nil
 }
 
+func validateSignalAggregation(aggregation *v1alpha1.Aggregation) error {
+	switch aggregation.Function {
+	case v1alpha1.AggregationFunctionCount:
+	case v1alpha1.AggregationFunctionSum, v1alpha1.AggregationFunctionMin, v1alpha1.AggregationFunctionMax, v1alpha1.AggregationFunctionDistinct:
+		if aggregation.Path == "" {
+			return fmt.Errorf("invalid signal aggregation: path must be specified for the %s function", aggregation.Function)
+		}
+	default:
+		return fmt.Errorf("invalid signal aggregation: unknown function '%s'", aggregation.Function)
+	}
+	if _, err := parseWindow(aggregation.Window); err != nil {
+		return fmt.Errorf("invalid signal aggregation: %s", err)
+	}
+	switch aggregation.WindowType {
+	case "", v1alpha1.WindowTypeSliding:
+	case v1alpha1.WindowTypeTumbling:
+		if aggregation.Window == "" {
+			return fmt.Errorf("invalid signal aggregation: window must be specified for tumbling windows")
+		}
+	default:
+		return fmt.Errorf("invalid signal aggregation: unknown window type '%s'", aggregation.WindowType)
+	}
+	switch aggregation.Operator {
+	case "", v1alpha1.AggregationOperatorGreaterThan, v1alpha1.AggregationOperatorGreaterThanOrEqual,
+		v1alpha1.AggregationOperatorLessThan, v1alpha1.AggregationOperatorLessThanOrEqual, v1alpha1.AggregationOperatorEqual:
+	default:
+		return fmt.Errorf("invalid signal aggregation: unknown operator '%s'", aggregation.Operator)
+	}
+	if _, err := strconv.ParseFloat(aggregation.Threshold, 64); err != nil {
+		return fmt.Errorf("invalid signal aggregation: invalid threshold '%s'", aggregation.Threshold)
+	}
+	return nil
+}
+
 func validateSignalTimeFilter(tFilter *v1alpha1.TimeFilter) error {
 	currentT := metav1.Time{Time: time.Now().UTC()}
 	if tFilter.Start != nil && tFilter.Stop != nil {
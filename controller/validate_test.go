@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	minio "github.com/minio/minio-go"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -152,6 +154,490 @@ func Test_validateSignals(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid artifact - listen mode",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "artifact-test",
+					Artifact: &v1alpha1.ArtifactSignal{
+						ArtifactLocation: v1alpha1.ArtifactLocation{
+							S3: &v1alpha1.S3Artifact{
+								S3Bucket: v1alpha1.S3Bucket{Endpoint: "minio:9000", Bucket: "images"},
+								Event:    minio.ObjectCreatedPut,
+							},
+						},
+						Mode: v1alpha1.ArtifactSignalModeListen,
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid artifact - listen mode without bucket",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "artifact-test",
+					Artifact: &v1alpha1.ArtifactSignal{
+						ArtifactLocation: v1alpha1.ArtifactLocation{
+							S3: &v1alpha1.S3Artifact{
+								S3Bucket: v1alpha1.S3Bucket{Endpoint: "minio:9000"},
+								Event:    minio.ObjectCreatedPut,
+							},
+						},
+						Mode: v1alpha1.ArtifactSignalModeListen,
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid artifact - negative inline content limit",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "artifact-test",
+					Artifact: &v1alpha1.ArtifactSignal{
+						ArtifactLocation: v1alpha1.ArtifactLocation{
+							S3: &v1alpha1.S3Artifact{
+								S3Bucket: v1alpha1.S3Bucket{Endpoint: "minio:9000", Bucket: "images"},
+								Event:    minio.ObjectCreatedPut,
+							},
+						},
+						Mode:               v1alpha1.ArtifactSignalModeListen,
+						InlineContentLimit: -1,
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid file",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "file-test",
+					File: &v1alpha1.FileSignal{
+						Directory:  "/files",
+						Operations: []v1alpha1.FileOperation{v1alpha1.FileOperationCreate},
+						Patterns:   []string{"*.csv"},
+						Debounce:   "5s",
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid file - relative directory",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "file-test",
+					File: &v1alpha1.FileSignal{Directory: "files"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid file - unknown operation",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "file-test",
+					File: &v1alpha1.FileSignal{
+						Directory:  "/files",
+						Operations: []v1alpha1.FileOperation{"Chmod"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid git",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "git-test",
+					Git: &v1alpha1.GitSignal{
+						URL:      "https://github.com/argoproj/argo-events.git",
+						Refs:     []string{"master", "refs/tags/v*"},
+						Interval: "5m",
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid git - multiple credentials",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "git-test",
+					Git: &v1alpha1.GitSignal{
+						URL:    "git@github.com:argoproj/argo-events.git",
+						SSHKey: &apiv1.SecretKeySelector{Key: "key"},
+						Token:  &apiv1.SecretKeySelector{Key: "token"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid httppoll",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "httppoll-test",
+					HTTPPoll: &v1alpha1.HTTPPollSignal{
+						URL:      "https://example.com/status",
+						Interval: "30s",
+						Conditions: []*v1alpha1.DataFilter{
+							{Path: "status", Type: v1alpha1.JSONTypeString, Value: "done"},
+						},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid httppoll - unsupported condition type",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "httppoll-test",
+					HTTPPoll: &v1alpha1.HTTPPollSignal{
+						URL: "https://example.com/status",
+						Conditions: []*v1alpha1.DataFilter{
+							{Path: "status", Type: "object"},
+						},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid cloudevents",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "cloudevents-test",
+					CloudEvents: &v1alpha1.CloudEventsSignal{Endpoint: "/orders"},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid cloudevents - relative endpoint",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "cloudevents-test",
+					CloudEvents: &v1alpha1.CloudEventsSignal{Endpoint: "orders"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid kube events",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "kube-events-test",
+					KubeEvents: &v1alpha1.KubeEventsSignal{
+						Reasons:        []string{"FailedScheduling", "BackOff"},
+						Types:          []string{apiv1.EventTypeWarning},
+						InvolvedObject: &v1alpha1.KubeEventsInvolvedObject{Kind: "Pod", Name: "etl-*"},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid kube events - unknown type",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:       "kube-events-test",
+					KubeEvents: &v1alpha1.KubeEventsSignal{Types: []string{"Error"}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid alertmanager",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "alertmanager-test",
+					Alertmanager: &v1alpha1.AlertmanagerSignal{
+						Endpoint: "/alerts",
+						Labels:   map[string]string{"alertname": "DiskAlmostFull"},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid alertmanager - missing endpoint",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:         "alertmanager-test",
+					Alertmanager: &v1alpha1.AlertmanagerSignal{},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid postgres",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "postgres-test",
+					Postgres: &v1alpha1.PostgresSignal{
+						ConnectionString: &apiv1.SecretKeySelector{Key: "url"},
+						Channels:         []string{"orders"},
+						Replication: &v1alpha1.PostgresReplication{
+							Slot:        "argo_events",
+							Plugin:      v1alpha1.PostgresReplicationPluginPGOutput,
+							Publication: "orders",
+							Tables:      []string{"public.orders"},
+						},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid postgres - missing channels and replication",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:     "postgres-test",
+					Postgres: &v1alpha1.PostgresSignal{ConnectionString: &apiv1.SecretKeySelector{Key: "url"}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid postgres - invalid slot",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "postgres-test",
+					Postgres: &v1alpha1.PostgresSignal{
+						ConnectionString: &apiv1.SecretKeySelector{Key: "url"},
+						Replication:      &v1alpha1.PostgresReplication{Slot: "argo-events"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid postgres - missing publication",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "postgres-test",
+					Postgres: &v1alpha1.PostgresSignal{
+						ConnectionString: &apiv1.SecretKeySelector{Key: "url"},
+						Replication:      &v1alpha1.PostgresReplication{Slot: "argo_events", Plugin: v1alpha1.PostgresReplicationPluginPGOutput},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid grpc",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "grpc-test",
+					GRPC: &v1alpha1.GRPCSignal{
+						Token:             &apiv1.SecretKeySelector{Key: "token"},
+						ClientCommonNames: []string{"producer.example.com"},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid grpc - empty client common name",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "grpc-test",
+					GRPC: &v1alpha1.GRPCSignal{ClientCommonNames: []string{""}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid grpc - anonymous",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "grpc-test",
+					GRPC: &v1alpha1.GRPCSignal{},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid grpc - allow anonymous",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "grpc-test",
+					GRPC: &v1alpha1.GRPCSignal{AllowAnonymous: true},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid syslog",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "syslog-test",
+					Syslog: &v1alpha1.SyslogSignal{
+						Protocols: []v1alpha1.SyslogProtocol{v1alpha1.SyslogProtocolUDP, v1alpha1.SyslogProtocolTLS},
+						Severity:  v1alpha1.SyslogSeverityWarning,
+						Pattern:   "link (up|down)",
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid syslog - unknown severity",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:   "syslog-test",
+					Syslog: &v1alpha1.SyslogSignal{Severity: "fatal"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid syslog - invalid pattern",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:   "syslog-test",
+					Syslog: &v1alpha1.SyslogSignal{Pattern: "link (up"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid smtp",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "smtp-test",
+					SMTP: &v1alpha1.SMTPSignal{
+						Recipients: []string{"reports@events.example.com"},
+						Senders:    []string{"@vendor.com"},
+						Attachments: &v1alpha1.ArtifactLocation{
+							S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "reports"}, Key: "inbox"},
+						},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid smtp - invalid recipient",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "smtp-test",
+					SMTP: &v1alpha1.SMTPSignal{Recipients: []string{"reports"}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid smtp - attachments not in s3",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "smtp-test",
+					SMTP: &v1alpha1.SMTPSignal{
+						Recipients:  []string{"reports@events.example.com"},
+						Attachments: &v1alpha1.ArtifactLocation{File: &v1alpha1.FileArtifact{Path: "/tmp"}},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid sftp",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "sftp-test",
+					SFTP: &v1alpha1.SFTPSignal{
+						Address:          "sftp.partner.com",
+						Directory:        "/outgoing",
+						Patterns:         []string{"*.csv"},
+						Username:         "argo",
+						PrivateKey:       &apiv1.SecretKeySelector{Key: "key"},
+						HostKey:          "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+						ArchiveDirectory: "/archive",
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid sftp - missing host key",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "sftp-test",
+					SFTP: &v1alpha1.SFTPSignal{
+						Address:   "sftp.partner.com",
+						Directory: "/outgoing",
+						Username:  "argo",
+						Password:  &apiv1.SecretKeySelector{Key: "password"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid sftp - archive directory is the directory",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "sftp-test",
+					SFTP: &v1alpha1.SFTPSignal{
+						Address:               "sftp.partner.com",
+						Directory:             "/outgoing",
+						Username:              "argo",
+						Password:              &apiv1.SecretKeySelector{Key: "password"},
+						InsecureIgnoreHostKey: true,
+						ArchiveDirectory:      "/outgoing/",
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid aggregation",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "aggregation-test",
+					Webhook:     &v1alpha1.WebhookSignal{Endpoint: "/uploads", Method: "POST"},
+					Aggregation: &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionSum, Path: "bytes", Window: "1h", WindowType: v1alpha1.WindowTypeTumbling, Operator: v1alpha1.AggregationOperatorGreaterThan, Threshold: "1e9"},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid aggregation - missing path",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "aggregation-test",
+					Webhook:     &v1alpha1.WebhookSignal{Endpoint: "/uploads", Method: "POST"},
+					Aggregation: &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionDistinct, Threshold: "3"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid aggregation - tumbling window without duration",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "aggregation-test",
+					Webhook:     &v1alpha1.WebhookSignal{Endpoint: "/uploads", Method: "POST"},
+					Aggregation: &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionCount, WindowType: v1alpha1.WindowTypeTumbling, Threshold: "5"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid aggregation - invalid threshold",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name:        "aggregation-test",
+					Webhook:     &v1alpha1.WebhookSignal{Endpoint: "/uploads", Method: "POST"},
+					Aggregation: &v1alpha1.Aggregation{Function: v1alpha1.AggregationFunctionCount, Window: "10m", Threshold: "five"},
+				}},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid calendar - missing schedule",
 			args: args{
@@ -173,6 +659,206 @@ func Test_validateSignals(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid calendar - recurrence rule",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "time",
+					Calendar: &v1alpha1.CalendarSignal{
+						Recurrence: []string{"DTSTART:20180101T090000", "RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid calendar - malformed recurrence rule",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Recurrence: []string{"DTSTART:20180101T090000", "RRULE:FREQ=WEEKLY;BYDAY=1MO"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid calendar - recurrence rule with schedule",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Schedule:   "@every 5s",
+						Recurrence: []string{"RDATE:20180704T090000Z"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid calendar - catch up all without limit",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Schedule: "@every 5s",
+						CatchUp:  &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeAll},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid calendar - unknown catch up mode",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Schedule: "@every 5s",
+						CatchUp:  &v1alpha1.CatchUpPolicy{Mode: "Sometimes"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid calendar - included icalendar",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "holidays",
+					Calendar: &v1alpha1.CalendarSignal{
+						ICalendar: &v1alpha1.ICalendarSource{
+							Location: v1alpha1.ArtifactLocation{
+								URL: &v1alpha1.URLArtifact{Path: "https://example.com/holidays.ics"},
+							},
+							Mode:            v1alpha1.ICalendarModeInclude,
+							RefreshInterval: "12h",
+						},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid calendar - icalendar without location",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Schedule:  "@every 5s",
+						ICalendar: &v1alpha1.ICalendarSource{},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid resource - event types and field selector",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod-succeeded",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind: v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						Filter: &v1alpha1.ResourceFilter{
+							EventTypes:    []v1alpha1.ResourceEventType{v1alpha1.ResourceEventTypeModified},
+							FieldSelector: "status.phase=Succeeded",
+						},
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid resource - unknown event type",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind: v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						Filter: &v1alpha1.ResourceFilter{
+							EventTypes: []v1alpha1.ResourceEventType{"UPDATED"},
+						},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid resource - invalid field selector",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind: v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						Filter: &v1alpha1.ResourceFilter{
+							FieldSelector: "status.phase",
+						},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid resource - field change without path",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind: v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						FieldChanges:     []v1alpha1.ResourceFieldChange{{Value: "Failed"}},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid resource - namespace selector",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind:  v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						NamespaceSelector: "team in (data, ml)",
+					},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid resource - namespace and namespaces",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind: v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						Namespace:        "default",
+						Namespaces:       []string{"argo"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid resource - invalid namespace selector",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Name: "pod",
+					Resource: &v1alpha1.ResourceSignal{
+						GroupVersionKind:  v1alpha1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+						NamespaceSelector: "team in (",
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid calendar - unknown timezone",
+			args: args{
+				signals: []v1alpha1.Signal{v1alpha1.Signal{
+					Calendar: &v1alpha1.CalendarSignal{
+						Schedule: "@every 5s",
+						Timezone: "Mars/Olympus_Mons",
+					},
+				}},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
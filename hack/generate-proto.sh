@@ -57,7 +57,7 @@ GOPROTOBINARY=gofast
 go build -i -o dist/protoc-gen-micro ./vendor/github.com/micro/protoc-gen-micro
 
 # Generate job/<service>/(<service>.pb.go)
-PROTO_FILES=$(find $PROJECT_ROOT \( -name "*.proto" -and -path '*/sdk/*' \))
+PROTO_FILES=$(find $PROJECT_ROOT \( -name "*.proto" -and -path '*/sdk/*' -and -not -path '*/sdk/publish/*' \))
 for i in ${PROTO_FILES}; do
     protoc \
         -I${PROJECT_ROOT} \
@@ -67,4 +67,14 @@ for i in ${PROTO_FILES}; do
         --micro_out=$GOPATH/src \
         --${GOPROTOBINARY}_out=plugins=grpc:$GOPATH/src \
         $i
-done
\ No newline at end of file
+done
+
+# Generate sdk/publish/publish.pb.go
+# the publish API is served by the gRPC signal service with a plain gRPC server, so no micro code is generated.
+protoc \
+    -I${PROJECT_ROOT} \
+    -I/usr/local/include \
+    -I./vendor \
+    -I$GOPATH/src \
+    --${GOPROTOBINARY}_out=plugins=grpc:$GOPATH/src \
+    ${PROJECT_ROOT}/sdk/publish/publish.proto
@@ -21,6 +21,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
+import v11 "k8s.io/api/core/v1"
 import v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 import github_com_minio_minio_go "github.com/minio/minio-go"
@@ -43,10 +44,122 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+func (m *Aggregate) Reset()      { *m = Aggregate{} }
+func (*Aggregate) ProtoMessage() {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{0}
+}
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(dst, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *AggregateSample) Reset()      { *m = AggregateSample{} }
+func (*AggregateSample) ProtoMessage() {}
+func (*AggregateSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{1}
+}
+func (m *AggregateSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *AggregateSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateSample.Merge(dst, src)
+}
+func (m *AggregateSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateSample proto.InternalMessageInfo
+
+func (m *Aggregation) Reset()      { *m = Aggregation{} }
+func (*Aggregation) ProtoMessage() {}
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{2}
+}
+func (m *Aggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Aggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Aggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregation.Merge(dst, src)
+}
+func (m *Aggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Aggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregation proto.InternalMessageInfo
+
+func (m *AlertmanagerSignal) Reset()      { *m = AlertmanagerSignal{} }
+func (*AlertmanagerSignal) ProtoMessage() {}
+func (*AlertmanagerSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{3}
+}
+func (m *AlertmanagerSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertmanagerSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *AlertmanagerSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertmanagerSignal.Merge(dst, src)
+}
+func (m *AlertmanagerSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertmanagerSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertmanagerSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertmanagerSignal proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{0}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{4}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,10 +184,38 @@ func (m *ArtifactLocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 
+func (m *ArtifactPoll) Reset()      { *m = ArtifactPoll{} }
+func (*ArtifactPoll) ProtoMessage() {}
+func (*ArtifactPoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{5}
+}
+func (m *ArtifactPoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactPoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ArtifactPoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactPoll.Merge(dst, src)
+}
+func (m *ArtifactPoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactPoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactPoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactPoll proto.InternalMessageInfo
+
 func (m *ArtifactSignal) Reset()      { *m = ArtifactSignal{} }
 func (*ArtifactSignal) ProtoMessage() {}
 func (*ArtifactSignal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{1}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{6}
 }
 func (m *ArtifactSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -102,7 +243,7 @@ var xxx_messageInfo_ArtifactSignal proto.InternalMessageInfo
 func (m *CalendarSignal) Reset()      { *m = CalendarSignal{} }
 func (*CalendarSignal) ProtoMessage() {}
 func (*CalendarSignal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{2}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{7}
 }
 func (m *CalendarSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,10 +268,66 @@ func (m *CalendarSignal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CalendarSignal proto.InternalMessageInfo
 
+func (m *CatchUpPolicy) Reset()      { *m = CatchUpPolicy{} }
+func (*CatchUpPolicy) ProtoMessage() {}
+func (*CatchUpPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{8}
+}
+func (m *CatchUpPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CatchUpPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *CatchUpPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatchUpPolicy.Merge(dst, src)
+}
+func (m *CatchUpPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CatchUpPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatchUpPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatchUpPolicy proto.InternalMessageInfo
+
+func (m *CloudEventsSignal) Reset()      { *m = CloudEventsSignal{} }
+func (*CloudEventsSignal) ProtoMessage() {}
+func (*CloudEventsSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{9}
+}
+func (m *CloudEventsSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudEventsSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *CloudEventsSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudEventsSignal.Merge(dst, src)
+}
+func (m *CloudEventsSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudEventsSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudEventsSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudEventsSignal proto.InternalMessageInfo
+
 func (m *DataFilter) Reset()      { *m = DataFilter{} }
 func (*DataFilter) ProtoMessage() {}
 func (*DataFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{3}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{10}
 }
 func (m *DataFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +355,7 @@ var xxx_messageInfo_DataFilter proto.InternalMessageInfo
 func (m *EscalationPolicy) Reset()      { *m = EscalationPolicy{} }
 func (*EscalationPolicy) ProtoMessage() {}
 func (*EscalationPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{4}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{11}
 }
 func (m *EscalationPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +383,7 @@ var xxx_messageInfo_EscalationPolicy proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{5}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{12}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +411,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *EventContext) Reset()      { *m = EventContext{} }
 func (*EventContext) ProtoMessage() {}
 func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{6}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{13}
 }
 func (m *EventContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +439,7 @@ var xxx_messageInfo_EventContext proto.InternalMessageInfo
 func (m *EventWrapper) Reset()      { *m = EventWrapper{} }
 func (*EventWrapper) ProtoMessage() {}
 func (*EventWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{7}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{14}
 }
 func (m *EventWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +467,7 @@ var xxx_messageInfo_EventWrapper proto.InternalMessageInfo
 func (m *FileArtifact) Reset()      { *m = FileArtifact{} }
 func (*FileArtifact) ProtoMessage() {}
 func (*FileArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{8}
+	return fileDescriptor_generated_eecc52000541b0f8, []int{15}
 }
 func (m *FileArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,15 +492,15 @@ func (m *FileArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileArtifact proto.InternalMessageInfo
 
-func (m *GroupVersionKind) Reset()      { *m = GroupVersionKind{} }
-func (*GroupVersionKind) ProtoMessage() {}
-func (*GroupVersionKind) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{9}
+func (m *FileSignal) Reset()      { *m = FileSignal{} }
+func (*FileSignal) ProtoMessage() {}
+func (*FileSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{16}
 }
-func (m *GroupVersionKind) XXX_Unmarshal(b []byte) error {
+func (m *FileSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupVersionKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FileSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -311,27 +508,27 @@ func (m *GroupVersionKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (dst *GroupVersionKind) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupVersionKind.Merge(dst, src)
+func (dst *FileSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSignal.Merge(dst, src)
 }
-func (m *GroupVersionKind) XXX_Size() int {
+func (m *FileSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupVersionKind) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupVersionKind.DiscardUnknown(m)
+func (m *FileSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupVersionKind proto.InternalMessageInfo
+var xxx_messageInfo_FileSignal proto.InternalMessageInfo
 
-func (m *Message) Reset()      { *m = Message{} }
-func (*Message) ProtoMessage() {}
-func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{10}
+func (m *GRPCSignal) Reset()      { *m = GRPCSignal{} }
+func (*GRPCSignal) ProtoMessage() {}
+func (*GRPCSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{17}
 }
-func (m *Message) XXX_Unmarshal(b []byte) error {
+func (m *GRPCSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GRPCSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -339,27 +536,27 @@ func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Message.Merge(dst, src)
+func (dst *GRPCSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCSignal.Merge(dst, src)
 }
-func (m *Message) XXX_Size() int {
+func (m *GRPCSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_Message.DiscardUnknown(m)
+func (m *GRPCSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Message proto.InternalMessageInfo
+var xxx_messageInfo_GRPCSignal proto.InternalMessageInfo
 
-func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
-func (*NodeStatus) ProtoMessage() {}
-func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{11}
+func (m *GitRefs) Reset()      { *m = GitRefs{} }
+func (*GitRefs) ProtoMessage() {}
+func (*GitRefs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{18}
 }
-func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
+func (m *GitRefs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitRefs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -367,27 +564,27 @@ func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *NodeStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeStatus.Merge(dst, src)
+func (dst *GitRefs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitRefs.Merge(dst, src)
 }
-func (m *NodeStatus) XXX_Size() int {
+func (m *GitRefs) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeStatus.DiscardUnknown(m)
+func (m *GitRefs) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitRefs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
+var xxx_messageInfo_GitRefs proto.InternalMessageInfo
 
-func (m *ResourceFilter) Reset()      { *m = ResourceFilter{} }
-func (*ResourceFilter) ProtoMessage() {}
-func (*ResourceFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{12}
+func (m *GitSignal) Reset()      { *m = GitSignal{} }
+func (*GitSignal) ProtoMessage() {}
+func (*GitSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{19}
 }
-func (m *ResourceFilter) XXX_Unmarshal(b []byte) error {
+func (m *GitSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -395,27 +592,27 @@ func (m *ResourceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (dst *ResourceFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceFilter.Merge(dst, src)
+func (dst *GitSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitSignal.Merge(dst, src)
 }
-func (m *ResourceFilter) XXX_Size() int {
+func (m *GitSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceFilter.DiscardUnknown(m)
+func (m *GitSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceFilter proto.InternalMessageInfo
+var xxx_messageInfo_GitSignal proto.InternalMessageInfo
 
-func (m *ResourceObject) Reset()      { *m = ResourceObject{} }
-func (*ResourceObject) ProtoMessage() {}
-func (*ResourceObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{13}
+func (m *GroupVersionKind) Reset()      { *m = GroupVersionKind{} }
+func (*GroupVersionKind) ProtoMessage() {}
+func (*GroupVersionKind) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{20}
 }
-func (m *ResourceObject) XXX_Unmarshal(b []byte) error {
+func (m *GroupVersionKind) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupVersionKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -423,27 +620,27 @@ func (m *ResourceObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (dst *ResourceObject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceObject.Merge(dst, src)
+func (dst *GroupVersionKind) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupVersionKind.Merge(dst, src)
 }
-func (m *ResourceObject) XXX_Size() int {
+func (m *GroupVersionKind) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceObject) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceObject.DiscardUnknown(m)
+func (m *GroupVersionKind) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupVersionKind.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceObject proto.InternalMessageInfo
+var xxx_messageInfo_GroupVersionKind proto.InternalMessageInfo
 
-func (m *ResourceParameter) Reset()      { *m = ResourceParameter{} }
-func (*ResourceParameter) ProtoMessage() {}
-func (*ResourceParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{14}
+func (m *HTTPBasicAuth) Reset()      { *m = HTTPBasicAuth{} }
+func (*HTTPBasicAuth) ProtoMessage() {}
+func (*HTTPBasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{21}
 }
-func (m *ResourceParameter) XXX_Unmarshal(b []byte) error {
+func (m *HTTPBasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HTTPBasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -451,27 +648,27 @@ func (m *ResourceParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (dst *ResourceParameter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceParameter.Merge(dst, src)
+func (dst *HTTPBasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPBasicAuth.Merge(dst, src)
 }
-func (m *ResourceParameter) XXX_Size() int {
+func (m *HTTPBasicAuth) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceParameter) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceParameter.DiscardUnknown(m)
+func (m *HTTPBasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPBasicAuth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceParameter proto.InternalMessageInfo
+var xxx_messageInfo_HTTPBasicAuth proto.InternalMessageInfo
 
-func (m *ResourceParameterSource) Reset()      { *m = ResourceParameterSource{} }
-func (*ResourceParameterSource) ProtoMessage() {}
-func (*ResourceParameterSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{15}
+func (m *HTTPPollSignal) Reset()      { *m = HTTPPollSignal{} }
+func (*HTTPPollSignal) ProtoMessage() {}
+func (*HTTPPollSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{22}
 }
-func (m *ResourceParameterSource) XXX_Unmarshal(b []byte) error {
+func (m *HTTPPollSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceParameterSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HTTPPollSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -479,27 +676,27 @@ func (m *ResourceParameterSource) XXX_Marshal(b []byte, deterministic bool) ([]b
 	}
 	return b[:n], nil
 }
-func (dst *ResourceParameterSource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceParameterSource.Merge(dst, src)
+func (dst *HTTPPollSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPPollSignal.Merge(dst, src)
 }
-func (m *ResourceParameterSource) XXX_Size() int {
+func (m *HTTPPollSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceParameterSource) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceParameterSource.DiscardUnknown(m)
+func (m *HTTPPollSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPPollSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceParameterSource proto.InternalMessageInfo
+var xxx_messageInfo_HTTPPollSignal proto.InternalMessageInfo
 
-func (m *ResourceSignal) Reset()      { *m = ResourceSignal{} }
-func (*ResourceSignal) ProtoMessage() {}
-func (*ResourceSignal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{16}
+func (m *ICalendarSource) Reset()      { *m = ICalendarSource{} }
+func (*ICalendarSource) ProtoMessage() {}
+func (*ICalendarSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{23}
 }
-func (m *ResourceSignal) XXX_Unmarshal(b []byte) error {
+func (m *ICalendarSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ICalendarSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -507,27 +704,27 @@ func (m *ResourceSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (dst *ResourceSignal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceSignal.Merge(dst, src)
+func (dst *ICalendarSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICalendarSource.Merge(dst, src)
 }
-func (m *ResourceSignal) XXX_Size() int {
+func (m *ICalendarSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceSignal) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceSignal.DiscardUnknown(m)
+func (m *ICalendarSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICalendarSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceSignal proto.InternalMessageInfo
+var xxx_messageInfo_ICalendarSource proto.InternalMessageInfo
 
-func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
-func (*RetryStrategy) ProtoMessage() {}
-func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{17}
+func (m *KubeEventsInvolvedObject) Reset()      { *m = KubeEventsInvolvedObject{} }
+func (*KubeEventsInvolvedObject) ProtoMessage() {}
+func (*KubeEventsInvolvedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{24}
 }
-func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
+func (m *KubeEventsInvolvedObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KubeEventsInvolvedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -535,27 +732,27 @@ func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (dst *RetryStrategy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryStrategy.Merge(dst, src)
+func (dst *KubeEventsInvolvedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeEventsInvolvedObject.Merge(dst, src)
 }
-func (m *RetryStrategy) XXX_Size() int {
+func (m *KubeEventsInvolvedObject) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryStrategy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryStrategy.DiscardUnknown(m)
+func (m *KubeEventsInvolvedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeEventsInvolvedObject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
+var xxx_messageInfo_KubeEventsInvolvedObject proto.InternalMessageInfo
 
-func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
-func (*S3Artifact) ProtoMessage() {}
-func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{18}
+func (m *KubeEventsSignal) Reset()      { *m = KubeEventsSignal{} }
+func (*KubeEventsSignal) ProtoMessage() {}
+func (*KubeEventsSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{25}
 }
-func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
+func (m *KubeEventsSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *S3Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KubeEventsSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -563,27 +760,27 @@ func (m *S3Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *S3Artifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_S3Artifact.Merge(dst, src)
+func (dst *KubeEventsSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeEventsSignal.Merge(dst, src)
 }
-func (m *S3Artifact) XXX_Size() int {
+func (m *KubeEventsSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *S3Artifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_S3Artifact.DiscardUnknown(m)
+func (m *KubeEventsSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeEventsSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
+var xxx_messageInfo_KubeEventsSignal proto.InternalMessageInfo
 
-func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
-func (*S3Bucket) ProtoMessage() {}
-func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{19}
+func (m *Message) Reset()      { *m = Message{} }
+func (*Message) ProtoMessage() {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{26}
 }
-func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
+func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *S3Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -591,27 +788,27 @@ func (m *S3Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *S3Bucket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_S3Bucket.Merge(dst, src)
+func (dst *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(dst, src)
 }
-func (m *S3Bucket) XXX_Size() int {
+func (m *Message) XXX_Size() int {
 	return m.Size()
 }
-func (m *S3Bucket) XXX_DiscardUnknown() {
-	xxx_messageInfo_S3Bucket.DiscardUnknown(m)
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
+var xxx_messageInfo_Message proto.InternalMessageInfo
 
-func (m *S3Filter) Reset()      { *m = S3Filter{} }
-func (*S3Filter) ProtoMessage() {}
-func (*S3Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{20}
+func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
+func (*NodeStatus) ProtoMessage() {}
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{27}
 }
-func (m *S3Filter) XXX_Unmarshal(b []byte) error {
+func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *S3Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -619,27 +816,27 @@ func (m *S3Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *S3Filter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_S3Filter.Merge(dst, src)
+func (dst *NodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStatus.Merge(dst, src)
 }
-func (m *S3Filter) XXX_Size() int {
+func (m *NodeStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *S3Filter) XXX_DiscardUnknown() {
-	xxx_messageInfo_S3Filter.DiscardUnknown(m)
+func (m *NodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_S3Filter proto.InternalMessageInfo
+var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 
-func (m *Sensor) Reset()      { *m = Sensor{} }
-func (*Sensor) ProtoMessage() {}
-func (*Sensor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{21}
+func (m *PostgresPosition) Reset()      { *m = PostgresPosition{} }
+func (*PostgresPosition) ProtoMessage() {}
+func (*PostgresPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{28}
 }
-func (m *Sensor) XXX_Unmarshal(b []byte) error {
+func (m *PostgresPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PostgresPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -647,27 +844,27 @@ func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *Sensor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Sensor.Merge(dst, src)
+func (dst *PostgresPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostgresPosition.Merge(dst, src)
 }
-func (m *Sensor) XXX_Size() int {
+func (m *PostgresPosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *Sensor) XXX_DiscardUnknown() {
-	xxx_messageInfo_Sensor.DiscardUnknown(m)
+func (m *PostgresPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostgresPosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Sensor proto.InternalMessageInfo
+var xxx_messageInfo_PostgresPosition proto.InternalMessageInfo
 
-func (m *SensorList) Reset()      { *m = SensorList{} }
-func (*SensorList) ProtoMessage() {}
-func (*SensorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{22}
+func (m *PostgresReplication) Reset()      { *m = PostgresReplication{} }
+func (*PostgresReplication) ProtoMessage() {}
+func (*PostgresReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{29}
 }
-func (m *SensorList) XXX_Unmarshal(b []byte) error {
+func (m *PostgresReplication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SensorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PostgresReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -675,27 +872,27 @@ func (m *SensorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *SensorList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SensorList.Merge(dst, src)
+func (dst *PostgresReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostgresReplication.Merge(dst, src)
 }
-func (m *SensorList) XXX_Size() int {
+func (m *PostgresReplication) XXX_Size() int {
 	return m.Size()
 }
-func (m *SensorList) XXX_DiscardUnknown() {
-	xxx_messageInfo_SensorList.DiscardUnknown(m)
+func (m *PostgresReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostgresReplication.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SensorList proto.InternalMessageInfo
+var xxx_messageInfo_PostgresReplication proto.InternalMessageInfo
 
-func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
-func (*SensorSpec) ProtoMessage() {}
-func (*SensorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{23}
+func (m *PostgresSignal) Reset()      { *m = PostgresSignal{} }
+func (*PostgresSignal) ProtoMessage() {}
+func (*PostgresSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{30}
 }
-func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
+func (m *PostgresSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SensorSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PostgresSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -703,27 +900,27 @@ func (m *SensorSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *SensorSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SensorSpec.Merge(dst, src)
+func (dst *PostgresSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostgresSignal.Merge(dst, src)
 }
-func (m *SensorSpec) XXX_Size() int {
+func (m *PostgresSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *SensorSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_SensorSpec.DiscardUnknown(m)
+func (m *PostgresSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostgresSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
+var xxx_messageInfo_PostgresSignal proto.InternalMessageInfo
 
-func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
-func (*SensorStatus) ProtoMessage() {}
-func (*SensorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{24}
+func (m *ResourceFieldChange) Reset()      { *m = ResourceFieldChange{} }
+func (*ResourceFieldChange) ProtoMessage() {}
+func (*ResourceFieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{31}
 }
-func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
+func (m *ResourceFieldChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SensorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceFieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -731,27 +928,27 @@ func (m *SensorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (dst *SensorStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SensorStatus.Merge(dst, src)
+func (dst *ResourceFieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceFieldChange.Merge(dst, src)
 }
-func (m *SensorStatus) XXX_Size() int {
+func (m *ResourceFieldChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *SensorStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_SensorStatus.DiscardUnknown(m)
+func (m *ResourceFieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceFieldChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
+var xxx_messageInfo_ResourceFieldChange proto.InternalMessageInfo
 
-func (m *Signal) Reset()      { *m = Signal{} }
-func (*Signal) ProtoMessage() {}
-func (*Signal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{25}
+func (m *ResourceFilter) Reset()      { *m = ResourceFilter{} }
+func (*ResourceFilter) ProtoMessage() {}
+func (*ResourceFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{32}
 }
-func (m *Signal) XXX_Unmarshal(b []byte) error {
+func (m *ResourceFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Signal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -759,27 +956,27 @@ func (m *Signal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *Signal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Signal.Merge(dst, src)
+func (dst *ResourceFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceFilter.Merge(dst, src)
 }
-func (m *Signal) XXX_Size() int {
+func (m *ResourceFilter) XXX_Size() int {
 	return m.Size()
 }
-func (m *Signal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Signal.DiscardUnknown(m)
+func (m *ResourceFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceFilter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Signal proto.InternalMessageInfo
+var xxx_messageInfo_ResourceFilter proto.InternalMessageInfo
 
-func (m *SignalFilter) Reset()      { *m = SignalFilter{} }
-func (*SignalFilter) ProtoMessage() {}
-func (*SignalFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{26}
+func (m *ResourceObject) Reset()      { *m = ResourceObject{} }
+func (*ResourceObject) ProtoMessage() {}
+func (*ResourceObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{33}
 }
-func (m *SignalFilter) XXX_Unmarshal(b []byte) error {
+func (m *ResourceObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignalFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -787,27 +984,27 @@ func (m *SignalFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (dst *SignalFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignalFilter.Merge(dst, src)
+func (dst *ResourceObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceObject.Merge(dst, src)
 }
-func (m *SignalFilter) XXX_Size() int {
+func (m *ResourceObject) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignalFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignalFilter.DiscardUnknown(m)
+func (m *ResourceObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceObject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignalFilter proto.InternalMessageInfo
+var xxx_messageInfo_ResourceObject proto.InternalMessageInfo
 
-func (m *Stream) Reset()      { *m = Stream{} }
-func (*Stream) ProtoMessage() {}
-func (*Stream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{27}
+func (m *ResourceParameter) Reset()      { *m = ResourceParameter{} }
+func (*ResourceParameter) ProtoMessage() {}
+func (*ResourceParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{34}
 }
-func (m *Stream) XXX_Unmarshal(b []byte) error {
+func (m *ResourceParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -815,27 +1012,27 @@ func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *Stream) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stream.Merge(dst, src)
+func (dst *ResourceParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceParameter.Merge(dst, src)
 }
-func (m *Stream) XXX_Size() int {
+func (m *ResourceParameter) XXX_Size() int {
 	return m.Size()
 }
-func (m *Stream) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stream.DiscardUnknown(m)
+func (m *ResourceParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceParameter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stream proto.InternalMessageInfo
+var xxx_messageInfo_ResourceParameter proto.InternalMessageInfo
 
-func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
-func (*TimeFilter) ProtoMessage() {}
-func (*TimeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{28}
+func (m *ResourceParameterSource) Reset()      { *m = ResourceParameterSource{} }
+func (*ResourceParameterSource) ProtoMessage() {}
+func (*ResourceParameterSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{35}
 }
-func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
+func (m *ResourceParameterSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceParameterSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -843,27 +1040,27 @@ func (m *TimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *TimeFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeFilter.Merge(dst, src)
+func (dst *ResourceParameterSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceParameterSource.Merge(dst, src)
 }
-func (m *TimeFilter) XXX_Size() int {
+func (m *ResourceParameterSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *TimeFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeFilter.DiscardUnknown(m)
+func (m *ResourceParameterSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceParameterSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
+var xxx_messageInfo_ResourceParameterSource proto.InternalMessageInfo
 
-func (m *Trigger) Reset()      { *m = Trigger{} }
-func (*Trigger) ProtoMessage() {}
-func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{29}
+func (m *ResourceSignal) Reset()      { *m = ResourceSignal{} }
+func (*ResourceSignal) ProtoMessage() {}
+func (*ResourceSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{36}
 }
-func (m *Trigger) XXX_Unmarshal(b []byte) error {
+func (m *ResourceSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -871,27 +1068,27 @@ func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *Trigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Trigger.Merge(dst, src)
+func (dst *ResourceSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSignal.Merge(dst, src)
 }
-func (m *Trigger) XXX_Size() int {
+func (m *ResourceSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *Trigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_Trigger.DiscardUnknown(m)
+func (m *ResourceSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Trigger proto.InternalMessageInfo
+var xxx_messageInfo_ResourceSignal proto.InternalMessageInfo
 
-func (m *URI) Reset()      { *m = URI{} }
-func (*URI) ProtoMessage() {}
-func (*URI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{30}
+func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
+func (*RetryStrategy) ProtoMessage() {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{37}
 }
-func (m *URI) XXX_Unmarshal(b []byte) error {
+func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *URI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -899,27 +1096,27 @@ func (m *URI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (dst *URI) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_URI.Merge(dst, src)
+func (dst *RetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryStrategy.Merge(dst, src)
 }
-func (m *URI) XXX_Size() int {
+func (m *RetryStrategy) XXX_Size() int {
 	return m.Size()
 }
-func (m *URI) XXX_DiscardUnknown() {
-	xxx_messageInfo_URI.DiscardUnknown(m)
+func (m *RetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryStrategy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_URI proto.InternalMessageInfo
+var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 
-func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
-func (*URLArtifact) ProtoMessage() {}
-func (*URLArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{31}
+func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
+func (*S3Artifact) ProtoMessage() {}
+func (*S3Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{38}
 }
-func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
+func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *URLArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *S3Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -927,27 +1124,27 @@ func (m *URLArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (dst *URLArtifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_URLArtifact.Merge(dst, src)
+func (dst *S3Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Artifact.Merge(dst, src)
 }
-func (m *URLArtifact) XXX_Size() int {
+func (m *S3Artifact) XXX_Size() int {
 	return m.Size()
 }
-func (m *URLArtifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_URLArtifact.DiscardUnknown(m)
+func (m *S3Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Artifact.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_URLArtifact proto.InternalMessageInfo
+var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 
-func (m *WebhookSignal) Reset()      { *m = WebhookSignal{} }
-func (*WebhookSignal) ProtoMessage() {}
-func (*WebhookSignal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_680506a6b2f9cb01, []int{32}
+func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
+func (*S3Bucket) ProtoMessage() {}
+func (*S3Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{39}
 }
-func (m *WebhookSignal) XXX_Unmarshal(b []byte) error {
+func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WebhookSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *S3Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalTo(b)
 	if err != nil {
@@ -955,425 +1152,568 @@ func (m *WebhookSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (dst *WebhookSignal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WebhookSignal.Merge(dst, src)
+func (dst *S3Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Bucket.Merge(dst, src)
 }
-func (m *WebhookSignal) XXX_Size() int {
+func (m *S3Bucket) XXX_Size() int {
 	return m.Size()
 }
-func (m *WebhookSignal) XXX_DiscardUnknown() {
-	xxx_messageInfo_WebhookSignal.DiscardUnknown(m)
+func (m *S3Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Bucket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WebhookSignal proto.InternalMessageInfo
+var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactLocation")
-	proto.RegisterType((*ArtifactSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactSignal")
-	proto.RegisterType((*CalendarSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CalendarSignal")
-	proto.RegisterType((*DataFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DataFilter")
-	proto.RegisterType((*EscalationPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EscalationPolicy")
-	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Event")
-	proto.RegisterType((*EventContext)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext.ExtensionsEntry")
-	proto.RegisterType((*EventWrapper)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventWrapper")
-	proto.RegisterType((*FileArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.FileArtifact")
-	proto.RegisterType((*GroupVersionKind)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GroupVersionKind")
-	proto.RegisterType((*Message)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Message")
-	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.NodeStatus")
-	proto.RegisterType((*ResourceFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter.LabelsEntry")
-	proto.RegisterType((*ResourceObject)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceObject")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceObject.LabelsEntry")
-	proto.RegisterType((*ResourceParameter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceParameter")
-	proto.RegisterType((*ResourceParameterSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceParameterSource")
-	proto.RegisterType((*ResourceSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceSignal")
-	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RetryStrategy")
-	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Artifact")
-	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Bucket")
-	proto.RegisterType((*S3Filter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Filter")
-	proto.RegisterType((*Sensor)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Sensor")
-	proto.RegisterType((*SensorList)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorList")
-	proto.RegisterType((*SensorSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorSpec")
-	proto.RegisterType((*SensorStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus")
-	proto.RegisterMapType((map[string]NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.NodesEntry")
-	proto.RegisterType((*Signal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Signal")
-	proto.RegisterType((*SignalFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SignalFilter")
-	proto.RegisterType((*Stream)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Stream")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Stream.AttributesEntry")
-	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
-	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
-	proto.RegisterType((*URI)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URI")
-	proto.RegisterType((*URLArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URLArtifact")
-	proto.RegisterType((*WebhookSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.WebhookSignal")
+func (m *S3Filter) Reset()      { *m = S3Filter{} }
+func (*S3Filter) ProtoMessage() {}
+func (*S3Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{40}
 }
-func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (m *S3Filter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *S3Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ArtifactLocation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.S3 != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.S3.Size()))
-		n1, err := m.S3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.Inline != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Inline)))
-		i += copy(dAtA[i:], *m.Inline)
-	}
-	if m.File != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.File.Size()))
-		n2, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if m.URL != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.URL.Size()))
-		n3, err := m.URL.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	return i, nil
+func (dst *S3Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Filter.Merge(dst, src)
+}
+func (m *S3Filter) XXX_Size() int {
+	return m.Size()
+}
+func (m *S3Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Filter.DiscardUnknown(m)
 }
 
-func (m *ArtifactSignal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_S3Filter proto.InternalMessageInfo
+
+func (m *SFTPSignal) Reset()      { *m = SFTPSignal{} }
+func (*SFTPSignal) ProtoMessage() {}
+func (*SFTPSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{41}
+}
+func (m *SFTPSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SFTPSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (dst *SFTPSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SFTPSignal.Merge(dst, src)
+}
+func (m *SFTPSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SFTPSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SFTPSignal.DiscardUnknown(m)
 }
 
-func (m *ArtifactSignal) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Target.Size()))
-	n4, err := m.Target.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ArtifactLocation.Size()))
-	n5, err := m.ArtifactLocation.MarshalTo(dAtA[i:])
+var xxx_messageInfo_SFTPSignal proto.InternalMessageInfo
+
+func (m *SMTPSignal) Reset()      { *m = SMTPSignal{} }
+func (*SMTPSignal) ProtoMessage() {}
+func (*SMTPSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{42}
+}
+func (m *SMTPSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SMTPSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n5
-	return i, nil
+	return b[:n], nil
+}
+func (dst *SMTPSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SMTPSignal.Merge(dst, src)
+}
+func (m *SMTPSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SMTPSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SMTPSignal.DiscardUnknown(m)
 }
 
-func (m *CalendarSignal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_SMTPSignal proto.InternalMessageInfo
+
+func (m *Sensor) Reset()      { *m = Sensor{} }
+func (*Sensor) ProtoMessage() {}
+func (*Sensor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{43}
+}
+func (m *Sensor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *CalendarSignal) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
-	i += copy(dAtA[i:], m.Schedule)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
-	i += copy(dAtA[i:], m.Interval)
-	if len(m.Recurrence) > 0 {
-		for _, s := range m.Recurrence {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	return i, nil
+func (dst *Sensor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sensor.Merge(dst, src)
+}
+func (m *Sensor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sensor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sensor.DiscardUnknown(m)
 }
 
-func (m *DataFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_Sensor proto.InternalMessageInfo
+
+func (m *SensorList) Reset()      { *m = SensorList{} }
+func (*SensorList) ProtoMessage() {}
+func (*SensorList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{44}
+}
+func (m *SensorList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SensorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *DataFilter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i += copy(dAtA[i:], m.Type)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+func (dst *SensorList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SensorList.Merge(dst, src)
+}
+func (m *SensorList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SensorList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SensorList.DiscardUnknown(m)
 }
 
-func (m *EscalationPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_SensorList proto.InternalMessageInfo
+
+func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
+func (*SensorSpec) ProtoMessage() {}
+func (*SensorSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{45}
+}
+func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SensorSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *EscalationPolicy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Level)))
-	i += copy(dAtA[i:], m.Level)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Message.Size()))
-	n6, err := m.Message.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n6
-	return i, nil
+func (dst *SensorSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SensorSpec.Merge(dst, src)
+}
+func (m *SensorSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *SensorSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SensorSpec.DiscardUnknown(m)
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
+
+func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
+func (*SensorStatus) ProtoMessage() {}
+func (*SensorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{46}
+}
+func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SensorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Context.Size()))
-	n7, err := m.Context.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n7
-	if m.Data != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	return i, nil
+func (dst *SensorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SensorStatus.Merge(dst, src)
+}
+func (m *SensorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SensorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SensorStatus.DiscardUnknown(m)
 }
 
-func (m *EventContext) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
+
+func (m *Signal) Reset()      { *m = Signal{} }
+func (*Signal) ProtoMessage() {}
+func (*Signal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{47}
+}
+func (m *Signal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Signal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (dst *Signal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signal.Merge(dst, src)
+}
+func (m *Signal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Signal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signal.DiscardUnknown(m)
 }
 
-func (m *EventContext) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventType)))
-	i += copy(dAtA[i:], m.EventType)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTypeVersion)))
-	i += copy(dAtA[i:], m.EventTypeVersion)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEventsVersion)))
-	i += copy(dAtA[i:], m.CloudEventsVersion)
-	if m.Source != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-		n8, err := m.Source.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventID)))
-	i += copy(dAtA[i:], m.EventID)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.EventTime.Size()))
-	n9, err := m.EventTime.MarshalTo(dAtA[i:])
+var xxx_messageInfo_Signal proto.InternalMessageInfo
+
+func (m *SignalFilter) Reset()      { *m = SignalFilter{} }
+func (*SignalFilter) ProtoMessage() {}
+func (*SignalFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{48}
+}
+func (m *SignalFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
-		return 0, err
-	}
-	i += n9
-	if m.SchemaURL != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.SchemaURL.Size()))
-		n10, err := m.SchemaURL.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContentType)))
-	i += copy(dAtA[i:], m.ContentType)
-	if len(m.Extensions) > 0 {
-		keysForExtensions := make([]string, 0, len(m.Extensions))
-		for k := range m.Extensions {
-			keysForExtensions = append(keysForExtensions, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
-		for _, k := range keysForExtensions {
-			dAtA[i] = 0x4a
-			i++
-			v := m.Extensions[string(k)]
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+		return nil, err
 	}
-	return i, nil
+	return b[:n], nil
+}
+func (dst *SignalFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalFilter.Merge(dst, src)
+}
+func (m *SignalFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalFilter.DiscardUnknown(m)
 }
 
-func (m *EventWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_SignalFilter proto.InternalMessageInfo
+
+func (m *SignalState) Reset()      { *m = SignalState{} }
+func (*SignalState) ProtoMessage() {}
+func (*SignalState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{49}
+}
+func (m *SignalState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (dst *SignalState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalState.Merge(dst, src)
+}
+func (m *SignalState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalState.DiscardUnknown(m)
 }
 
-func (m *EventWrapper) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Event.Size()))
-	n11, err := m.Event.MarshalTo(dAtA[i:])
+var xxx_messageInfo_SignalState proto.InternalMessageInfo
+
+func (m *Stream) Reset()      { *m = Stream{} }
+func (*Stream) ProtoMessage() {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{50}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
-		return 0, err
-	}
-	i += n11
-	dAtA[i] = 0x10
-	i++
-	if m.Seen {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+		return nil, err
 	}
-	i++
-	return i, nil
+	return b[:n], nil
+}
+func (dst *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(dst, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
 }
 
-func (m *FileArtifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *SyslogSignal) Reset()      { *m = SyslogSignal{} }
+func (*SyslogSignal) ProtoMessage() {}
+func (*SyslogSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{51}
+}
+func (m *SyslogSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyslogSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (dst *SyslogSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyslogSignal.Merge(dst, src)
+}
+func (m *SyslogSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyslogSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyslogSignal.DiscardUnknown(m)
 }
 
-func (m *FileArtifact) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	return i, nil
+var xxx_messageInfo_SyslogSignal proto.InternalMessageInfo
+
+func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
+func (*TimeFilter) ProtoMessage() {}
+func (*TimeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{52}
+}
+func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *TimeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeFilter.Merge(dst, src)
+}
+func (m *TimeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeFilter.DiscardUnknown(m)
 }
 
-func (m *GroupVersionKind) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
+
+func (m *Trigger) Reset()      { *m = Trigger{} }
+func (*Trigger) ProtoMessage() {}
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{53}
+}
+func (m *Trigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Trigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trigger.Merge(dst, src)
+}
+func (m *Trigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trigger proto.InternalMessageInfo
+
+func (m *URI) Reset()      { *m = URI{} }
+func (*URI) ProtoMessage() {}
+func (*URI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{54}
+}
+func (m *URI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *URI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *URI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_URI.Merge(dst, src)
+}
+func (m *URI) XXX_Size() int {
+	return m.Size()
+}
+func (m *URI) XXX_DiscardUnknown() {
+	xxx_messageInfo_URI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_URI proto.InternalMessageInfo
+
+func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
+func (*URLArtifact) ProtoMessage() {}
+func (*URLArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{55}
+}
+func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *URLArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *URLArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_URLArtifact.Merge(dst, src)
+}
+func (m *URLArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *URLArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_URLArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_URLArtifact proto.InternalMessageInfo
+
+func (m *WebhookSignal) Reset()      { *m = WebhookSignal{} }
+func (*WebhookSignal) ProtoMessage() {}
+func (*WebhookSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_eecc52000541b0f8, []int{56}
+}
+func (m *WebhookSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *WebhookSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookSignal.Merge(dst, src)
+}
+func (m *WebhookSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookSignal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Aggregate)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Aggregate")
+	proto.RegisterType((*AggregateSample)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AggregateSample")
+	proto.RegisterType((*Aggregation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Aggregation")
+	proto.RegisterType((*AlertmanagerSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AlertmanagerSignal")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AlertmanagerSignal.LabelsEntry")
+	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactLocation")
+	proto.RegisterType((*ArtifactPoll)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactPoll")
+	proto.RegisterType((*ArtifactSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactSignal")
+	proto.RegisterType((*CalendarSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CalendarSignal")
+	proto.RegisterType((*CatchUpPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CatchUpPolicy")
+	proto.RegisterType((*CloudEventsSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CloudEventsSignal")
+	proto.RegisterType((*DataFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DataFilter")
+	proto.RegisterType((*EscalationPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EscalationPolicy")
+	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Event")
+	proto.RegisterType((*EventContext)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext.ExtensionsEntry")
+	proto.RegisterType((*EventWrapper)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventWrapper")
+	proto.RegisterType((*FileArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.FileArtifact")
+	proto.RegisterType((*FileSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.FileSignal")
+	proto.RegisterType((*GRPCSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GRPCSignal")
+	proto.RegisterType((*GitRefs)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitRefs")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitRefs.RefsEntry")
+	proto.RegisterType((*GitSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitSignal")
+	proto.RegisterType((*GroupVersionKind)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GroupVersionKind")
+	proto.RegisterType((*HTTPBasicAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPBasicAuth")
+	proto.RegisterType((*HTTPPollSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPPollSignal")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPPollSignal.HeadersEntry")
+	proto.RegisterType((*ICalendarSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ICalendarSource")
+	proto.RegisterType((*KubeEventsInvolvedObject)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.KubeEventsInvolvedObject")
+	proto.RegisterType((*KubeEventsSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.KubeEventsSignal")
+	proto.RegisterType((*Message)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Message")
+	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.NodeStatus")
+	proto.RegisterType((*PostgresPosition)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PostgresPosition")
+	proto.RegisterType((*PostgresReplication)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PostgresReplication")
+	proto.RegisterType((*PostgresSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PostgresSignal")
+	proto.RegisterType((*ResourceFieldChange)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFieldChange")
+	proto.RegisterType((*ResourceFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceFilter.LabelsEntry")
+	proto.RegisterType((*ResourceObject)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceObject")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceObject.LabelsEntry")
+	proto.RegisterType((*ResourceParameter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceParameter")
+	proto.RegisterType((*ResourceParameterSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceParameterSource")
+	proto.RegisterType((*ResourceSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ResourceSignal")
+	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RetryStrategy")
+	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Artifact")
+	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Bucket")
+	proto.RegisterType((*S3Filter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.S3Filter")
+	proto.RegisterType((*SFTPSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SFTPSignal")
+	proto.RegisterType((*SMTPSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SMTPSignal")
+	proto.RegisterType((*Sensor)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Sensor")
+	proto.RegisterType((*SensorList)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorList")
+	proto.RegisterType((*SensorSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorSpec")
+	proto.RegisterType((*SensorStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.ArtifactFingerprintsEntry")
+	proto.RegisterMapType((map[string]GitRefs)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.GitRefsEntry")
+	proto.RegisterMapType((map[string]v1.Time)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.LastEventTimesEntry")
+	proto.RegisterMapType((map[string]NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.NodesEntry")
+	proto.RegisterMapType((map[string]PostgresPosition)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.PostgresPositionsEntry")
+	proto.RegisterType((*Signal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Signal")
+	proto.RegisterType((*SignalFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SignalFilter")
+	proto.RegisterType((*SignalState)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SignalState")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SignalState.GitRefsEntry")
+	proto.RegisterType((*Stream)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Stream")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Stream.AttributesEntry")
+	proto.RegisterType((*SyslogSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SyslogSignal")
+	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
+	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
+	proto.RegisterType((*URI)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URI")
+	proto.RegisterType((*URLArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URLArtifact")
+	proto.RegisterType((*WebhookSignal)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.WebhookSignal")
+}
+func (m *Aggregate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1383,27 +1723,50 @@ func (m *GroupVersionKind) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupVersionKind) MarshalTo(dAtA []byte) (int, error) {
+func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	dAtA[i] = 0x10
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WindowStart.Size()))
+	n1, err := m.WindowStart.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WindowEnd.Size()))
+	n2, err := m.WindowEnd.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.Samples) > 0 {
+		for _, msg := range m.Samples {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *AggregateSample) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1413,27 +1776,30 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregateSample) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
-	i += copy(dAtA[i:], m.Body)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Stream.Size()))
-	n12, err := m.Stream.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Time.Size()))
+	n3, err := m.Time.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n3
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
 	return i, nil
 }
 
-func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
+func (m *Aggregation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1443,83 +1809,57 @@ func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Aggregation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i += copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Function)))
+	i += copy(dAtA[i:], m.Function)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i += copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i += copy(dAtA[i:], m.Window)
 	dAtA[i] = 0x22
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i += copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WindowType)))
+	i += copy(dAtA[i:], m.WindowType)
 	dAtA[i] = 0x2a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i += copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operator)))
+	i += copy(dAtA[i:], m.Operator)
 	dAtA[i] = 0x32
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n13, err := m.StartedAt.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Threshold)))
+	i += copy(dAtA[i:], m.Threshold)
+	return i, nil
+}
+
+func (m *AlertmanagerSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
-	}
-	i += n13
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
-	n14, err := m.CompletedAt.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n14
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i += copy(dAtA[i:], m.Message)
-	if m.LatestEvent != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.LatestEvent.Size()))
-		n15, err := m.LatestEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
-	}
-	return i, nil
-}
-
-func (m *ResourceFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ResourceFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlertmanagerSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
-	i += copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i += copy(dAtA[i:], m.Endpoint)
 	if len(m.Labels) > 0 {
 		keysForLabels := make([]string, 0, len(m.Labels))
 		for k := range m.Labels {
@@ -1542,40 +1882,10 @@ func (m *ResourceFilter) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], v)
 		}
 	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for _, k := range keysForAnnotations {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Annotations[string(k)]
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.CreatedBy.Size()))
-	n16, err := m.CreatedBy.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n16
 	return i, nil
 }
 
-func (m *ResourceObject) Marshal() (dAtA []byte, err error) {
+func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1585,69 +1895,81 @@ func (m *ResourceObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *ArtifactLocation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
+	if m.S3 != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.S3.Size()))
+		n4, err := m.S3.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for _, k := range keysForLabels {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Labels[string(k)]
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+		i += n4
+	}
+	if m.Inline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Inline)))
+		i += copy(dAtA[i:], *m.Inline)
+	}
+	if m.File != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.File.Size()))
+		n5, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n5
 	}
-	if len(m.Parameters) > 0 {
-		for _, msg := range m.Parameters {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.URL != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.URL.Size()))
+		n6, err := m.URL.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.GroupVersionKind.Size()))
-	n17, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
+	return i, nil
+}
+
+func (m *ArtifactPoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n17
-	dAtA[i] = 0x32
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactPoll) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n18, err := m.Source.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i += copy(dAtA[i:], m.Interval)
+	dAtA[i] = 0x10
+	i++
+	if m.IncludeContent {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i += n18
+	i++
 	return i, nil
 }
 
-func (m *ResourceParameter) Marshal() (dAtA []byte, err error) {
+func (m *ArtifactSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1657,29 +1979,48 @@ func (m *ResourceParameter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceParameter) MarshalTo(dAtA []byte) (int, error) {
+func (m *ArtifactSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Src != nil {
-		dAtA[i] = 0xa
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Target.Size()))
+	n7, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ArtifactLocation.Size()))
+	n8, err := m.ArtifactLocation.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i += copy(dAtA[i:], m.Mode)
+	if m.Poll != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Src.Size()))
-		n19, err := m.Src.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Poll.Size()))
+		n9, err := m.Poll.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n9
 	}
-	dAtA[i] = 0x12
+	dAtA[i] = 0x28
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dest)))
-	i += copy(dAtA[i:], m.Dest)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InlineContentLimit))
 	return i, nil
 }
 
-func (m *ResourceParameterSource) Marshal() (dAtA []byte, err error) {
+func (m *CalendarSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1689,29 +2030,62 @@ func (m *ResourceParameterSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceParameterSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *CalendarSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Signal)))
-	i += copy(dAtA[i:], m.Signal)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i += copy(dAtA[i:], m.Schedule)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	if m.Value != nil {
-		dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i += copy(dAtA[i:], m.Interval)
+	if len(m.Recurrence) > 0 {
+		for _, s := range m.Recurrence {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i += copy(dAtA[i:], m.Timezone)
+	if m.CatchUp != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
-		i += copy(dAtA[i:], *m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(m.CatchUp.Size()))
+		n10, err := m.CatchUp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.ICalendar != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ICalendar.Size()))
+		n11, err := m.ICalendar.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
 	return i, nil
 }
 
-func (m *ResourceSignal) Marshal() (dAtA []byte, err error) {
+func (m *CatchUpPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1721,37 +2095,22 @@ func (m *ResourceSignal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceSignal) MarshalTo(dAtA []byte) (int, error) {
+func (m *CatchUpPolicy) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	if m.Filter != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Filter.Size()))
-		n20, err := m.Filter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
-	}
-	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i += copy(dAtA[i:], m.Mode)
+	dAtA[i] = 0x10
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.GroupVersionKind.Size()))
-	n21, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n21
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
 	return i, nil
 }
 
-func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+func (m *CloudEventsSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1761,15 +2120,19 @@ func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloudEventsSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i += copy(dAtA[i:], m.Endpoint)
 	return i, nil
 }
 
-func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+func (m *DataFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1779,41 +2142,27 @@ func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataFilter) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i += copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Event)))
-	i += copy(dAtA[i:], m.Event)
-	if m.Filter != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Filter.Size()))
-		n22, err := m.Filter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
-	}
-	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.S3Bucket.Size()))
-	n23, err := m.S3Bucket.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n23
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
 	return i, nil
 }
 
-func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
+func (m *EscalationPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1823,51 +2172,59 @@ func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EscalationPolicy) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i += copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Level)))
+	i += copy(dAtA[i:], m.Level)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
-	i += copy(dAtA[i:], m.Bucket)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
-	i += copy(dAtA[i:], m.Region)
-	dAtA[i] = 0x20
-	i++
-	if m.Insecure {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.AccessKey.Size()))
-	n24, err := m.AccessKey.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Message.Size()))
+	n12, err := m.Message.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
-	dAtA[i] = 0x32
+	i += n12
+	return i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.SecretKey.Size()))
-	n25, err := m.SecretKey.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Context.Size()))
+	n13, err := m.Context.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n13
+	if m.Data != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
 	return i, nil
 }
 
-func (m *S3Filter) Marshal() (dAtA []byte, err error) {
+func (m *EventContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1877,23 +2234,85 @@ func (m *S3Filter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Filter) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventContext) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
-	i += copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventType)))
+	i += copy(dAtA[i:], m.EventType)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Suffix)))
-	i += copy(dAtA[i:], m.Suffix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTypeVersion)))
+	i += copy(dAtA[i:], m.EventTypeVersion)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEventsVersion)))
+	i += copy(dAtA[i:], m.CloudEventsVersion)
+	if m.Source != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+		n14, err := m.Source.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventID)))
+	i += copy(dAtA[i:], m.EventID)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EventTime.Size()))
+	n15, err := m.EventTime.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if m.SchemaURL != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SchemaURL.Size()))
+		n16, err := m.SchemaURL.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContentType)))
+	i += copy(dAtA[i:], m.ContentType)
+	if len(m.Extensions) > 0 {
+		keysForExtensions := make([]string, 0, len(m.Extensions))
+		for k := range m.Extensions {
+			keysForExtensions = append(keysForExtensions, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
+		for _, k := range keysForExtensions {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Extensions[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
-func (m *Sensor) Marshal() (dAtA []byte, err error) {
+func (m *EventWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1903,39 +2322,31 @@ func (m *Sensor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventWrapper) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n26, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Event.Size()))
+	n17, err := m.Event.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
-	dAtA[i] = 0x12
+	i += n17
+	dAtA[i] = 0x10
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n27, err := m.Spec.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.Seen {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i += n27
-	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n28, err := m.Status.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n28
 	return i, nil
 }
 
-func (m *SensorList) Marshal() (dAtA []byte, err error) {
+func (m *FileArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1945,35 +2356,19 @@ func (m *SensorList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorList) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileArtifact) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n29, err := m.ListMeta.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n29
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
 	return i, nil
 }
 
-func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
+func (m *FileSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1983,57 +2378,61 @@ func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signals) > 0 {
-		for _, msg := range m.Signals {
-			dAtA[i] = 0xa
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Directory)))
+	i += copy(dAtA[i:], m.Directory)
+	dAtA[i] = 0x10
+	i++
+	if m.Recursive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			dAtA[i] = 0x1a
 			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Triggers) > 0 {
-		for _, msg := range m.Triggers {
-			dAtA[i] = 0x12
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			dAtA[i] = 0x22
 			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
-		}
-	}
-	if m.Escalation != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Escalation.Size()))
-		n30, err := m.Escalation.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n30
-	}
-	dAtA[i] = 0x20
-	i++
-	if m.Repeat {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
 	}
+	dAtA[i] = 0x2a
 	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Debounce)))
+	i += copy(dAtA[i:], m.Debounce)
 	return i, nil
 }
 
-func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
+func (m *GRPCSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2043,51 +2442,73 @@ func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *GRPCSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i += copy(dAtA[i:], m.Phase)
-	dAtA[i] = 0x12
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Token.Size()))
+		n18, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.ClientCommonNames) > 0 {
+		for _, s := range m.ClientCommonNames {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x18
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n31, err := m.StartedAt.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.AllowAnonymous {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i += n31
-	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
-	n32, err := m.CompletedAt.MarshalTo(dAtA[i:])
+	return i, nil
+}
+
+func (m *GitRefs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n32
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i += copy(dAtA[i:], m.Message)
-	if len(m.Nodes) > 0 {
-		keysForNodes := make([]string, 0, len(m.Nodes))
-		for k := range m.Nodes {
-			keysForNodes = append(keysForNodes, string(k))
+	return dAtA[:n], nil
+}
+
+func (m *GitRefs) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refs) > 0 {
+		keysForRefs := make([]string, 0, len(m.Refs))
+		for k := range m.Refs {
+			keysForRefs = append(keysForRefs, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-		for _, k := range keysForNodes {
-			dAtA[i] = 0x2a
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRefs)
+		for _, k := range keysForRefs {
+			dAtA[i] = 0xa
 			i++
-			v := m.Nodes[string(k)]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovGenerated(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + msgSize
+			v := m.Refs[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
 			dAtA[i] = 0xa
 			i++
@@ -2095,18 +2516,14 @@ func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], k)
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
-			n33, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n33
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
 	return i, nil
 }
 
-func (m *Signal) Marshal() (dAtA []byte, err error) {
+func (m *GitSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2116,80 +2533,103 @@ func (m *Signal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Signal) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Deadline))
-	if m.Stream != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Stream.Size()))
-		n34, err := m.Stream.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n34
-	}
-	if m.Artifact != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Artifact.Size()))
-		n35, err := m.Artifact.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
+	if len(m.Refs) > 0 {
+		for _, s := range m.Refs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n35
 	}
-	if m.Calendar != nil {
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i += copy(dAtA[i:], m.Interval)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	if m.Token != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Calendar.Size()))
-		n36, err := m.Calendar.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Token.Size()))
+		n19, err := m.Token.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n19
 	}
-	if m.Resource != nil {
+	if m.SSHKey != nil {
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Resource.Size()))
-		n37, err := m.Resource.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SSHKey.Size()))
+		n20, err := m.SSHKey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n20
 	}
-	if m.Webhook != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Webhook.Size()))
-		n38, err := m.Webhook.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
+	dAtA[i] = 0x38
+	i++
+	if m.InsecureIgnoreHostKey {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	dAtA[i] = 0x42
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Filters.Size()))
-	n39, err := m.Filters.MarshalTo(dAtA[i:])
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxCommits))
+	return i, nil
+}
+
+func (m *GroupVersionKind) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n39
+	return dAtA[:n], nil
+}
+
+func (m *GroupVersionKind) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
 	return i, nil
 }
 
-func (m *SignalFilter) Marshal() (dAtA []byte, err error) {
+func (m *HTTPBasicAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2199,47 +2639,35 @@ func (m *SignalFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPBasicAuth) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Time != nil {
+	if m.Username != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Time.Size()))
-		n40, err := m.Time.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Username.Size()))
+		n21, err := m.Username.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n21
 	}
-	if m.Context != nil {
+	if m.Password != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Context.Size()))
-		n41, err := m.Context.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Password.Size()))
+		n22, err := m.Password.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
-	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n22
 	}
 	return i, nil
 }
 
-func (m *Stream) Marshal() (dAtA []byte, err error) {
+func (m *HTTPPollSignal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2249,29 +2677,29 @@ func (m *Stream) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPPollSignal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i += copy(dAtA[i:], m.Type)
-	dAtA[i] = 0x12
-	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i += copy(dAtA[i:], m.URL)
-	if len(m.Attributes) > 0 {
-		keysForAttributes := make([]string, 0, len(m.Attributes))
-		for k := range m.Attributes {
-			keysForAttributes = append(keysForAttributes, string(k))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-		for _, k := range keysForAttributes {
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for _, k := range keysForHeaders {
 			dAtA[i] = 0x1a
 			i++
-			v := m.Attributes[string(k)]
+			v := m.Headers[string(k)]
 			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
 			dAtA[i] = 0xa
@@ -2284,48 +2712,58 @@ func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], v)
 		}
 	}
-	return i, nil
-}
-
-func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TimeFilter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Start != nil {
-		dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	if m.BasicAuth != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Start.Size()))
-		n42, err := m.Start.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.BasicAuth.Size()))
+		n23, err := m.BasicAuth.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n23
 	}
-	if m.Stop != nil {
-		dAtA[i] = 0x12
+	if m.BearerToken != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Stop.Size()))
-		n43, err := m.Stop.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.BearerToken.Size()))
+		n24, err := m.BearerToken.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n24
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i += copy(dAtA[i:], m.Interval)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i += copy(dAtA[i:], m.Timeout)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxBackoff)))
+	i += copy(dAtA[i:], m.MaxBackoff)
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *Trigger) Marshal() (dAtA []byte, err error) {
+func (m *ICalendarSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2335,49 +2773,142 @@ func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *ICalendarSource) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	if m.Resource != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Resource.Size()))
-		n44, err := m.Resource.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Location.Size()))
+	n25, err := m.Location.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i += copy(dAtA[i:], m.Mode)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RefreshInterval)))
+	i += copy(dAtA[i:], m.RefreshInterval)
+	return i, nil
+}
+
+func (m *KubeEventsInvolvedObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubeEventsInvolvedObject) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	return i, nil
+}
+
+func (m *KubeEventsSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubeEventsSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n44
 	}
-	if m.Message != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Message.Size()))
-		n45, err := m.Message.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n45
 	}
-	if m.RetryStrategy != nil {
+	if m.InvolvedObject != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.RetryStrategy.Size()))
-		n46, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.InvolvedObject.Size()))
+		n26, err := m.InvolvedObject.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n26
+	}
+	if len(m.SourceComponents) > 0 {
+		for _, s := range m.SourceComponents {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CollapseInterval)))
+	i += copy(dAtA[i:], m.CollapseInterval)
 	return i, nil
 }
 
-func (m *URI) Marshal() (dAtA []byte, err error) {
+func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2387,46 +2918,105 @@ func (m *URI) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *URI) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scheme)))
-	i += copy(dAtA[i:], m.Scheme)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
-	i += copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Stream.Size()))
+	n27, err := m.Stream.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	return i, nil
+}
+
+func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i += copy(dAtA[i:], m.ID)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
-	i += copy(dAtA[i:], m.Password)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i += copy(dAtA[i:], m.DisplayName)
 	dAtA[i] = 0x22
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
-	i += copy(dAtA[i:], m.Host)
-	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x2a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
 	dAtA[i] = 0x32
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
+	n28, err := m.StartedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
 	dAtA[i] = 0x3a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
-	i += copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
+	n29, err := m.CompletedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
 	dAtA[i] = 0x42
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fragment)))
-	i += copy(dAtA[i:], m.Fragment)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if m.LatestEvent != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LatestEvent.Size()))
+		n30, err := m.LatestEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.Aggregate != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Aggregate.Size()))
+		n31, err := m.Aggregate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
 	return i, nil
 }
 
-func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
+func (m *PostgresPosition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2436,27 +3026,25 @@ func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *URLArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostgresPosition) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LSN)))
+	i += copy(dAtA[i:], m.LSN)
 	dAtA[i] = 0x10
 	i++
-	if m.VerifyCert {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Row))
+	dAtA[i] = 0x18
 	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Rows))
 	return i, nil
 }
 
-func (m *WebhookSignal) Marshal() (dAtA []byte, err error) {
+func (m *PostgresReplication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2466,1064 +3054,9014 @@ func (m *WebhookSignal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookSignal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostgresReplication) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i += copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Slot)))
+	i += copy(dAtA[i:], m.Slot)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
-	i += copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Plugin)))
+	i += copy(dAtA[i:], m.Plugin)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Publication)))
+	i += copy(dAtA[i:], m.Publication)
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x28
+	i++
+	if m.CreateSlot {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PostgresSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ArtifactLocation) Size() (n int) {
+
+func (m *PostgresSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.S3 != nil {
-		l = m.S3.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.ConnectionString != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionString.Size()))
+		n32, err := m.ConnectionString.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	if m.Inline != nil {
-		l = len(*m.Inline)
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Replication != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Replication.Size()))
+		n33, err := m.Replication.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	if m.URL != nil {
-		l = m.URL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return i, nil
+}
+
+func (m *ResourceFieldChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArtifactSignal) Size() (n int) {
+func (m *ResourceFieldChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Target.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ArtifactLocation.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	return i, nil
 }
 
-func (m *CalendarSignal) Size() (n int) {
+func (m *ResourceFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Schedule)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Interval)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Recurrence) > 0 {
-		for _, s := range m.Recurrence {
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i += copy(dAtA[i:], m.Prefix)
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x12
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for _, k := range keysForAnnotations {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Annotations[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CreatedBy.Size()))
+	n34, err := m.CreatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			dAtA[i] = 0x2a
+			i++
 			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldSelector)))
+	i += copy(dAtA[i:], m.FieldSelector)
+	return i, nil
 }
 
-func (m *DataFilter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *ResourceObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *EscalationPolicy) Size() (n int) {
+func (m *ResourceObject) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Level)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Message.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GroupVersionKind.Size()))
+	n35, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n36, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	return i, nil
 }
 
-func (m *Event) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Context.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Data != nil {
-		l = len(m.Data)
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *ResourceParameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventContext) Size() (n int) {
+func (m *ResourceParameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EventType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.EventTypeVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CloudEventsVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Source != nil {
-		l = m.Source.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Src != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Src.Size()))
+		n37, err := m.Src.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
-	l = len(m.EventID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.EventTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SchemaURL != nil {
-		l = m.SchemaURL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ContentType)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Extensions) > 0 {
-		for k, v := range m.Extensions {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	return n
-}
-
-func (m *EventWrapper) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Event.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
-
-func (m *FileArtifact) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dest)))
+	i += copy(dAtA[i:], m.Dest)
+	return i, nil
 }
 
-func (m *GroupVersionKind) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *ResourceParameterSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Message) Size() (n int) {
+func (m *ResourceParameterSource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Body)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Stream.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Signal)))
+	i += copy(dAtA[i:], m.Signal)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	if m.Value != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
+		i += copy(dAtA[i:], *m.Value)
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.Aggregate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *NodeStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.CompletedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.LatestEvent != nil {
-		l = m.LatestEvent.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *ResourceSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResourceFilter) Size() (n int) {
+func (m *ResourceSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Prefix)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if m.Filter != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Filter.Size()))
+		n38, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n38
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GroupVersionKind.Size()))
+	n39, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.CreatedBy.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ResourceObject) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i += n39
+	dAtA[i] = 0x20
+	i++
+	if m.IncludeExisting {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.FieldChanges) > 0 {
+		for _, msg := range m.FieldChanges {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = m.GroupVersionKind.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Source.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NamespaceSelector)))
+	i += copy(dAtA[i:], m.NamespaceSelector)
+	dAtA[i] = 0x40
+	i++
+	if m.IncludeLabels {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x48
+	i++
+	if m.IncludeAnnotations {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *ResourceParameter) Size() (n int) {
-	var l int
-	_ = l
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Dest)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResourceParameterSource) Size() (n int) {
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signal)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Value != nil {
-		l = len(*m.Value)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *ResourceSignal) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.GroupVersionKind.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RetryStrategy) Size() (n int) {
+func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i += copy(dAtA[i:], m.Key)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Event)))
+	i += copy(dAtA[i:], m.Event)
+	if m.Filter != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Filter.Size()))
+		n40, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.S3Bucket.Size()))
+	n41, err := m.S3Bucket.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n41
+	return i, nil
 }
 
-func (m *S3Artifact) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Event)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.S3Bucket.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *S3Bucket) Size() (n int) {
+func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Endpoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Bucket)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Region)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = m.AccessKey.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SecretKey.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i += copy(dAtA[i:], m.Endpoint)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i += copy(dAtA[i:], m.Bucket)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i += copy(dAtA[i:], m.Region)
+	dAtA[i] = 0x20
+	i++
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AccessKey.Size()))
+	n42, err := m.AccessKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SecretKey.Size()))
+	n43, err := m.SecretKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n43
+	return i, nil
 }
 
-func (m *S3Filter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Prefix)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Suffix)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *S3Filter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Sensor) Size() (n int) {
+func (m *S3Filter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i += copy(dAtA[i:], m.Prefix)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Suffix)))
+	i += copy(dAtA[i:], m.Suffix)
+	return i, nil
 }
 
-func (m *SensorList) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *SFTPSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SensorSpec) Size() (n int) {
+func (m *SFTPSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i += copy(dAtA[i:], m.Address)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Directory)))
+	i += copy(dAtA[i:], m.Directory)
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Triggers) > 0 {
-		for _, e := range m.Triggers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	if m.Password != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Password.Size()))
+		n44, err := m.Password.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n44
 	}
-	if m.Escalation != nil {
-		l = m.Escalation.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.PrivateKey != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.PrivateKey.Size()))
+		n45, err := m.PrivateKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	n += 2
-	return n
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HostKey)))
+	i += copy(dAtA[i:], m.HostKey)
+	dAtA[i] = 0x40
+	i++
+	if m.InsecureIgnoreHostKey {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i += copy(dAtA[i:], m.Interval)
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArchiveDirectory)))
+	i += copy(dAtA[i:], m.ArchiveDirectory)
+	return i, nil
 }
 
-func (m *SensorStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.CompletedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Nodes) > 0 {
-		for k, v := range m.Nodes {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+func (m *SMTPSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Signal) Size() (n int) {
+func (m *SMTPSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Deadline))
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Artifact != nil {
-		l = m.Artifact.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.Calendar != nil {
-		l = m.Calendar.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Attachments != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Attachments.Size()))
+		n46, err := m.Attachments.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	if m.Webhook != nil {
-		l = m.Webhook.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return i, nil
+}
+
+func (m *Sensor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Filters.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalFilter) Size() (n int) {
+func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Time != nil {
-		l = m.Time.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n47, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i += n47
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
+	n48, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i += n48
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
+	n49, err := m.Status.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n49
+	return i, nil
 }
 
-func (m *Stream) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+func (m *SensorList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TimeFilter) Size() (n int) {
+func (m *SensorList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
+	n50, err := m.ListMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Stop != nil {
-		l = m.Stop.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i += n50
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *Trigger) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *URI) Size() (n int) {
+func (m *SensorSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Scheme)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.User)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Host)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Port))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Query)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Fragment)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if len(m.Signals) > 0 {
+		for _, msg := range m.Signals {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Triggers) > 0 {
+		for _, msg := range m.Triggers {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Escalation != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Escalation.Size()))
+		n51, err := m.Escalation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.Repeat {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *URLArtifact) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
+func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *WebhookSignal) Size() (n int) {
+func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Endpoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Method)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func sovGenerated(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ArtifactLocation) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ArtifactLocation{`,
-		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "S3Artifact", 1) + `,`,
-		`Inline:` + valueToStringGenerated(this.Inline) + `,`,
-		`File:` + strings.Replace(fmt.Sprintf("%v", this.File), "FileArtifact", "FileArtifact", 1) + `,`,
-		`URL:` + strings.Replace(fmt.Sprintf("%v", this.URL), "URLArtifact", "URLArtifact", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactSignal) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ArtifactSignal{`,
-		`Target:` + strings.Replace(strings.Replace(this.Target.String(), "Stream", "Stream", 1), `&`, ``, 1) + `,`,
-		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CalendarSignal) String() string {
-	if this == nil {
-		return "nil"
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
+	n52, err := m.StartedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	s := strings.Join([]string{`&CalendarSignal{`,
-		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
-		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
-		`Recurrence:` + fmt.Sprintf("%v", this.Recurrence) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DataFilter) String() string {
-	if this == nil {
-		return "nil"
+	i += n52
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
+	n53, err := m.CompletedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	s := strings.Join([]string{`&DataFilter{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EscalationPolicy) String() string {
-	if this == nil {
-		return "nil"
+	i += n53
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if len(m.Nodes) > 0 {
+		keysForNodes := make([]string, 0, len(m.Nodes))
+		for k := range m.Nodes {
+			keysForNodes = append(keysForNodes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+		for _, k := range keysForNodes {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Nodes[string(k)]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovGenerated(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + msgSize
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
+			n54, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n54
+		}
 	}
-	s := strings.Join([]string{`&EscalationPolicy{`,
-		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
-		`Message:` + strings.Replace(strings.Replace(this.Message.String(), "Message", "Message", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Event) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.LastEventTimes) > 0 {
+		keysForLastEventTimes := make([]string, 0, len(m.LastEventTimes))
+		for k := range m.LastEventTimes {
+			keysForLastEventTimes = append(keysForLastEventTimes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLastEventTimes)
+		for _, k := range keysForLastEventTimes {
+			dAtA[i] = 0x32
+			i++
+			v := m.LastEventTimes[string(k)]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovGenerated(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + msgSize
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
+			n55, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n55
+		}
 	}
-	s := strings.Join([]string{`&Event{`,
-		`Context:` + strings.Replace(strings.Replace(this.Context.String(), "EventContext", "EventContext", 1), `&`, ``, 1) + `,`,
-		`Data:` + valueToStringGenerated(this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EventContext) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ArtifactFingerprints) > 0 {
+		keysForArtifactFingerprints := make([]string, 0, len(m.ArtifactFingerprints))
+		for k := range m.ArtifactFingerprints {
+			keysForArtifactFingerprints = append(keysForArtifactFingerprints, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForArtifactFingerprints)
+		for _, k := range keysForArtifactFingerprints {
+			dAtA[i] = 0x3a
+			i++
+			v := m.ArtifactFingerprints[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	keysForExtensions := make([]string, 0, len(this.Extensions))
-	for k := range this.Extensions {
-		keysForExtensions = append(keysForExtensions, k)
+	if len(m.GitRefs) > 0 {
+		keysForGitRefs := make([]string, 0, len(m.GitRefs))
+		for k := range m.GitRefs {
+			keysForGitRefs = append(keysForGitRefs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForGitRefs)
+		for _, k := range keysForGitRefs {
+			dAtA[i] = 0x42
+			i++
+			v := m.GitRefs[string(k)]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovGenerated(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + msgSize
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
+			n56, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n56
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
-	mapStringForExtensions := "map[string]string{"
-	for _, k := range keysForExtensions {
-		mapStringForExtensions += fmt.Sprintf("%v: %v,", k, this.Extensions[k])
+	if len(m.PostgresPositions) > 0 {
+		keysForPostgresPositions := make([]string, 0, len(m.PostgresPositions))
+		for k := range m.PostgresPositions {
+			keysForPostgresPositions = append(keysForPostgresPositions, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPostgresPositions)
+		for _, k := range keysForPostgresPositions {
+			dAtA[i] = 0x4a
+			i++
+			v := m.PostgresPositions[string(k)]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovGenerated(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + msgSize
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
+			n57, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n57
+		}
 	}
-	mapStringForExtensions += "}"
-	s := strings.Join([]string{`&EventContext{`,
-		`EventType:` + fmt.Sprintf("%v", this.EventType) + `,`,
-		`EventTypeVersion:` + fmt.Sprintf("%v", this.EventTypeVersion) + `,`,
-		`CloudEventsVersion:` + fmt.Sprintf("%v", this.CloudEventsVersion) + `,`,
-		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "URI", "URI", 1) + `,`,
-		`EventID:` + fmt.Sprintf("%v", this.EventID) + `,`,
-		`EventTime:` + strings.Replace(strings.Replace(this.EventTime.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`SchemaURL:` + strings.Replace(fmt.Sprintf("%v", this.SchemaURL), "URI", "URI", 1) + `,`,
-		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
-		`Extensions:` + mapStringForExtensions + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *EventWrapper) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Signal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&EventWrapper{`,
-		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
-		`Seen:` + fmt.Sprintf("%v", this.Seen) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *FileArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Signal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Deadline))
+	if m.Stream != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Stream.Size()))
+		n58, err := m.Stream.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
 	}
-	s := strings.Join([]string{`&FileArtifact{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GroupVersionKind) String() string {
-	if this == nil {
-		return "nil"
+	if m.Artifact != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Artifact.Size()))
+		n59, err := m.Artifact.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
 	}
-	s := strings.Join([]string{`&GroupVersionKind{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Message) String() string {
-	if this == nil {
-		return "nil"
+	if m.Calendar != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Calendar.Size()))
+		n60, err := m.Calendar.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
 	}
-	s := strings.Join([]string{`&Message{`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`Stream:` + strings.Replace(strings.Replace(this.Stream.String(), "Stream", "Stream", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NodeStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.Resource != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Resource.Size()))
+		n61, err := m.Resource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
 	}
-	s := strings.Join([]string{`&NodeStatus{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`CompletedAt:` + strings.Replace(strings.Replace(this.CompletedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LatestEvent:` + strings.Replace(fmt.Sprintf("%v", this.LatestEvent), "EventWrapper", "EventWrapper", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceFilter) String() string {
-	if this == nil {
-		return "nil"
+	if m.Webhook != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Webhook.Size()))
+		n62, err := m.Webhook.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Filters.Size()))
+	n63, err := m.Filters.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	i += n63
+	if m.File != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.File.Size()))
+		n64, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if m.Git != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Git.Size()))
+		n65, err := m.Git.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.HTTPPoll != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HTTPPoll.Size()))
+		n66, err := m.HTTPPoll.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&ResourceFilter{`,
-		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`CreatedBy:` + strings.Replace(strings.Replace(this.CreatedBy.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceObject) String() string {
-	if this == nil {
-		return "nil"
+	if m.CloudEvents != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.CloudEvents.Size()))
+		n67, err := m.CloudEvents.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if m.KubeEvents != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.KubeEvents.Size()))
+		n68, err := m.KubeEvents.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Alertmanager != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Alertmanager.Size()))
+		n69, err := m.Alertmanager.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&ResourceObject{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "ResourceParameter", "ResourceParameter", 1), `&`, ``, 1) + `,`,
-		`GroupVersionKind:` + strings.Replace(strings.Replace(this.GroupVersionKind.String(), "GroupVersionKind", "GroupVersionKind", 1), `&`, ``, 1) + `,`,
-		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceParameter) String() string {
-	if this == nil {
-		return "nil"
+	if m.Postgres != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Postgres.Size()))
+		n70, err := m.Postgres.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
 	}
-	s := strings.Join([]string{`&ResourceParameter{`,
-		`Src:` + strings.Replace(fmt.Sprintf("%v", this.Src), "ResourceParameterSource", "ResourceParameterSource", 1) + `,`,
-		`Dest:` + fmt.Sprintf("%v", this.Dest) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceParameterSource) String() string {
-	if this == nil {
-		return "nil"
+	if m.GRPC != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.GRPC.Size()))
+		n71, err := m.GRPC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
 	}
-	s := strings.Join([]string{`&ResourceParameterSource{`,
-		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Value:` + valueToStringGenerated(this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceSignal) String() string {
-	if this == nil {
-		return "nil"
+	if m.Syslog != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Syslog.Size()))
+		n72, err := m.Syslog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
 	}
-	s := strings.Join([]string{`&ResourceSignal{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ResourceFilter", "ResourceFilter", 1) + `,`,
-		`GroupVersionKind:` + strings.Replace(strings.Replace(this.GroupVersionKind.String(), "GroupVersionKind", "GroupVersionKind", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RetryStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.SMTP != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SMTP.Size()))
+		n73, err := m.SMTP.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
-	s := strings.Join([]string{`&RetryStrategy{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *S3Artifact) String() string {
-	if this == nil {
-		return "nil"
+	if m.SFTP != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SFTP.Size()))
+		n74, err := m.SFTP.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
 	}
-	s := strings.Join([]string{`&S3Artifact{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "S3Filter", "S3Filter", 1) + `,`,
-		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *S3Bucket) String() string {
-	if this == nil {
-		return "nil"
+	if m.Aggregation != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Aggregation.Size()))
+		n75, err := m.Aggregation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
 	}
-	s := strings.Join([]string{`&S3Bucket{`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
-		`AccessKey:` + strings.Replace(strings.Replace(this.AccessKey.String(), "SecretKeySelector", "v11.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`SecretKey:` + strings.Replace(strings.Replace(this.SecretKey.String(), "SecretKeySelector", "v11.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *S3Filter) String() string {
-	if this == nil {
-		return "nil"
+	if m.State != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.State.Size()))
+		n76, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
 	}
-	s := strings.Join([]string{`&S3Filter{`,
-		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
-		`Suffix:` + fmt.Sprintf("%v", this.Suffix) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Sensor) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Sensor{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SensorList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SensorList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Sensor", "Sensor", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *SensorSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SignalFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&SensorSpec{`,
-		`Signals:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Signals), "Signal", "Signal", 1), `&`, ``, 1) + `,`,
-		`Triggers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Triggers), "Trigger", "Trigger", 1), `&`, ``, 1) + `,`,
-		`Escalation:` + strings.Replace(fmt.Sprintf("%v", this.Escalation), "EscalationPolicy", "EscalationPolicy", 1) + `,`,
-		`Repeat:` + fmt.Sprintf("%v", this.Repeat) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SensorStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SignalFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Time.Size()))
+		n77, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
 	}
-	keysForNodes := make([]string, 0, len(this.Nodes))
-	for k := range this.Nodes {
-		keysForNodes = append(keysForNodes, k)
+	if m.Context != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Context.Size()))
+		n78, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-	mapStringForNodes := "map[string]NodeStatus{"
-	for _, k := range keysForNodes {
-		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	mapStringForNodes += "}"
-	s := strings.Join([]string{`&SensorStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`CompletedAt:` + strings.Replace(strings.Replace(this.CompletedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Nodes:` + mapStringForNodes + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *Signal) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SignalState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&Signal{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
-		`Stream:` + strings.Replace(fmt.Sprintf("%v", this.Stream), "Stream", "Stream", 1) + `,`,
-		`Artifact:` + strings.Replace(fmt.Sprintf("%v", this.Artifact), "ArtifactSignal", "ArtifactSignal", 1) + `,`,
-		`Calendar:` + strings.Replace(fmt.Sprintf("%v", this.Calendar), "CalendarSignal", "CalendarSignal", 1) + `,`,
-		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "ResourceSignal", "ResourceSignal", 1) + `,`,
-		`Webhook:` + strings.Replace(fmt.Sprintf("%v", this.Webhook), "WebhookSignal", "WebhookSignal", 1) + `,`,
-		`Filters:` + strings.Replace(strings.Replace(this.Filters.String(), "SignalFilter", "SignalFilter", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SignalFilter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SignalState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastFired != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastFired.Size()))
+		n79, err := m.LastFired.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
-	s := strings.Join([]string{`&SignalFilter{`,
-		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "TimeFilter", "TimeFilter", 1) + `,`,
-		`Context:` + strings.Replace(fmt.Sprintf("%v", this.Context), "EventContext", "EventContext", 1) + `,`,
-		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "DataFilter", "DataFilter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Stream) String() string {
-	if this == nil {
-		return "nil"
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactFingerprint)))
+	i += copy(dAtA[i:], m.ArtifactFingerprint)
+	if len(m.GitRefs) > 0 {
+		keysForGitRefs := make([]string, 0, len(m.GitRefs))
+		for k := range m.GitRefs {
+			keysForGitRefs = append(keysForGitRefs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForGitRefs)
+		for _, k := range keysForGitRefs {
+			dAtA[i] = 0x1a
+			i++
+			v := m.GitRefs[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
+	if m.PostgresPosition != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.PostgresPosition.Size()))
+		n80, err := m.PostgresPosition.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-	mapStringForAttributes := "map[string]string{"
-	for _, k := range keysForAttributes {
-		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	return i, nil
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	mapStringForAttributes += "}"
-	s := strings.Join([]string{`&Stream{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Attributes:` + mapStringForAttributes + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *TimeFilter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
+	if len(m.Attributes) > 0 {
+		keysForAttributes := make([]string, 0, len(m.Attributes))
+		for k := range m.Attributes {
+			keysForAttributes = append(keysForAttributes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+		for _, k := range keysForAttributes {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Attributes[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	s := strings.Join([]string{`&TimeFilter{`,
-		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1) + `,`,
-		`Stop:` + strings.Replace(fmt.Sprintf("%v", this.Stop), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *Trigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyslogSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&Trigger{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "ResourceObject", "ResourceObject", 1) + `,`,
-		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Message", "Message", 1) + `,`,
-		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "RetryStrategy", "RetryStrategy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
+}
+
+func (m *SyslogSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severity)))
+	i += copy(dAtA[i:], m.Severity)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Contains)))
+	i += copy(dAtA[i:], m.Contains)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i += copy(dAtA[i:], m.Pattern)
+	return i, nil
+}
+
+func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Start != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Start.Size()))
+		n81, err := m.Start.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.Stop != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Stop.Size()))
+		n82, err := m.Stop.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	return i, nil
+}
+
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.Resource != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Resource.Size()))
+		n83, err := m.Resource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Message.Size()))
+		n84, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n85, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	return i, nil
+}
+
+func (m *URI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *URI) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scheme)))
+	i += copy(dAtA[i:], m.Scheme)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i += copy(dAtA[i:], m.User)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i += copy(dAtA[i:], m.Password)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i += copy(dAtA[i:], m.Host)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i += copy(dAtA[i:], m.Query)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fragment)))
+	i += copy(dAtA[i:], m.Fragment)
+	return i, nil
+}
+
+func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *URLArtifact) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x10
+	i++
+	if m.VerifyCert {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *WebhookSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookSignal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i += copy(dAtA[i:], m.Endpoint)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	return i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Aggregate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	l = m.WindowStart.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.WindowEnd.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AggregateSample) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	return n
+}
+
+func (m *Aggregation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Function)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.WindowType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Threshold)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AlertmanagerSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ArtifactLocation) Size() (n int) {
+	var l int
+	_ = l
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Inline != nil {
+		l = len(*m.Inline)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.URL != nil {
+		l = m.URL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ArtifactPoll) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *ArtifactSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Target.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ArtifactLocation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Poll != nil {
+		l = m.Poll.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.InlineContentLimit))
+	return n
+}
+
+func (m *CalendarSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Recurrence) > 0 {
+		for _, s := range m.Recurrence {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CatchUp != nil {
+		l = m.CatchUp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ICalendar != nil {
+		l = m.ICalendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CatchUpPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	return n
+}
+
+func (m *CloudEventsSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DataFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EscalationPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Level)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Message.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Context.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContext) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.EventType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTypeVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CloudEventsVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.EventID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.EventTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SchemaURL != nil {
+		l = m.SchemaURL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ContentType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Extensions) > 0 {
+		for k, v := range m.Extensions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *EventWrapper) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *FileArtifact) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FileSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Directory)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Debounce)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GRPCSignal) Size() (n int) {
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ClientCommonNames) > 0 {
+		for _, s := range m.ClientCommonNames {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *GitRefs) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Refs) > 0 {
+		for k, v := range m.Refs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GitSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Refs) > 0 {
+		for _, s := range m.Refs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SSHKey != nil {
+		l = m.SSHKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 1 + sovGenerated(uint64(m.MaxCommits))
+	return n
+}
+
+func (m *GroupVersionKind) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPBasicAuth) Size() (n int) {
+	var l int
+	_ = l
+	if m.Username != nil {
+		l = m.Username.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPPollSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerToken != nil {
+		l = m.BearerToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxBackoff)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ICalendarSource) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Location.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RefreshInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *KubeEventsInvolvedObject) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *KubeEventsSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.InvolvedObject != nil {
+		l = m.InvolvedObject.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SourceComponents) > 0 {
+		for _, s := range m.SourceComponents {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CollapseInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Stream.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NodeStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CompletedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LatestEvent != nil {
+		l = m.LatestEvent.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *PostgresPosition) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.LSN)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Row))
+	n += 1 + sovGenerated(uint64(m.Rows))
+	return n
+}
+
+func (m *PostgresReplication) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Slot)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Plugin)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Publication)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *PostgresSignal) Size() (n int) {
+	var l int
+	_ = l
+	if m.ConnectionString != nil {
+		l = m.ConnectionString.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceFieldChange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = m.CreatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.FieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceObject) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.GroupVersionKind.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceParameter) Size() (n int) {
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Dest)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceParameterSource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Signal)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ResourceSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.GroupVersionKind.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.FieldChanges) > 0 {
+		for _, e := range m.FieldChanges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.NamespaceSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *S3Artifact) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Event)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.S3Bucket.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *S3Bucket) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.AccessKey.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretKey.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *S3Filter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Suffix)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SFTPSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Directory)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PrivateKey != nil {
+		l = m.PrivateKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.HostKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArchiveDirectory)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SMTPSignal) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Attachments != nil {
+		l = m.Attachments.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Sensor) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SensorList) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SensorSpec) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Escalation != nil {
+		l = m.Escalation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *SensorStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CompletedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		for k, v := range m.Nodes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.LastEventTimes) > 0 {
+		for k, v := range m.LastEventTimes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ArtifactFingerprints) > 0 {
+		for k, v := range m.ArtifactFingerprints {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.GitRefs) > 0 {
+		for k, v := range m.GitRefs {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PostgresPositions) > 0 {
+		for k, v := range m.PostgresPositions {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Signal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Deadline))
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Calendar != nil {
+		l = m.Calendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Filters.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTPPoll != nil {
+		l = m.HTTPPoll.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CloudEvents != nil {
+		l = m.CloudEvents.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KubeEvents != nil {
+		l = m.KubeEvents.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Alertmanager != nil {
+		l = m.Alertmanager.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Postgres != nil {
+		l = m.Postgres.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Syslog != nil {
+		l = m.Syslog.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SMTP != nil {
+		l = m.SMTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SFTP != nil {
+		l = m.SFTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Aggregation != nil {
+		l = m.Aggregation.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SignalFilter) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignalState) Size() (n int) {
+	var l int
+	_ = l
+	if m.LastFired != nil {
+		l = m.LastFired.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ArtifactFingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.GitRefs) > 0 {
+		for k, v := range m.GitRefs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.PostgresPosition != nil {
+		l = m.PostgresPosition.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Stream) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SyslogSignal) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Severity)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Contains)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TimeFilter) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Stop != nil {
+		l = m.Stop.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *URI) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Port))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Fragment)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *URLArtifact) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *WebhookSignal) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Aggregate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Aggregate{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`WindowStart:` + strings.Replace(strings.Replace(this.WindowStart.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`WindowEnd:` + strings.Replace(strings.Replace(this.WindowEnd.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Samples:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Samples), "AggregateSample", "AggregateSample", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregateSample) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregateSample{`,
+		`Time:` + strings.Replace(strings.Replace(this.Time.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Aggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Aggregation{`,
+		`Function:` + fmt.Sprintf("%v", this.Function) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`WindowType:` + fmt.Sprintf("%v", this.WindowType) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Threshold:` + fmt.Sprintf("%v", this.Threshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertmanagerSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&AlertmanagerSignal{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactLocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactLocation{`,
+		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "S3Artifact", 1) + `,`,
+		`Inline:` + valueToStringGenerated(this.Inline) + `,`,
+		`File:` + strings.Replace(fmt.Sprintf("%v", this.File), "FileArtifact", "FileArtifact", 1) + `,`,
+		`URL:` + strings.Replace(fmt.Sprintf("%v", this.URL), "URLArtifact", "URLArtifact", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactPoll) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactPoll{`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`IncludeContent:` + fmt.Sprintf("%v", this.IncludeContent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactSignal{`,
+		`Target:` + strings.Replace(strings.Replace(this.Target.String(), "Stream", "Stream", 1), `&`, ``, 1) + `,`,
+		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Poll:` + strings.Replace(fmt.Sprintf("%v", this.Poll), "ArtifactPoll", "ArtifactPoll", 1) + `,`,
+		`InlineContentLimit:` + fmt.Sprintf("%v", this.InlineContentLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CalendarSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CalendarSignal{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Recurrence:` + fmt.Sprintf("%v", this.Recurrence) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`CatchUp:` + strings.Replace(fmt.Sprintf("%v", this.CatchUp), "CatchUpPolicy", "CatchUpPolicy", 1) + `,`,
+		`ICalendar:` + strings.Replace(fmt.Sprintf("%v", this.ICalendar), "ICalendarSource", "ICalendarSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CatchUpPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CatchUpPolicy{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloudEventsSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloudEventsSignal{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataFilter{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EscalationPolicy{`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Message:` + strings.Replace(strings.Replace(this.Message.String(), "Message", "Message", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Event{`,
+		`Context:` + strings.Replace(strings.Replace(this.Context.String(), "EventContext", "EventContext", 1), `&`, ``, 1) + `,`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventContext) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForExtensions := make([]string, 0, len(this.Extensions))
+	for k := range this.Extensions {
+		keysForExtensions = append(keysForExtensions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
+	mapStringForExtensions := "map[string]string{"
+	for _, k := range keysForExtensions {
+		mapStringForExtensions += fmt.Sprintf("%v: %v,", k, this.Extensions[k])
+	}
+	mapStringForExtensions += "}"
+	s := strings.Join([]string{`&EventContext{`,
+		`EventType:` + fmt.Sprintf("%v", this.EventType) + `,`,
+		`EventTypeVersion:` + fmt.Sprintf("%v", this.EventTypeVersion) + `,`,
+		`CloudEventsVersion:` + fmt.Sprintf("%v", this.CloudEventsVersion) + `,`,
+		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "URI", "URI", 1) + `,`,
+		`EventID:` + fmt.Sprintf("%v", this.EventID) + `,`,
+		`EventTime:` + strings.Replace(strings.Replace(this.EventTime.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`SchemaURL:` + strings.Replace(fmt.Sprintf("%v", this.SchemaURL), "URI", "URI", 1) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`Extensions:` + mapStringForExtensions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventWrapper) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventWrapper{`,
+		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
+		`Seen:` + fmt.Sprintf("%v", this.Seen) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileSignal{`,
+		`Directory:` + fmt.Sprintf("%v", this.Directory) + `,`,
+		`Recursive:` + fmt.Sprintf("%v", this.Recursive) + `,`,
+		`Operations:` + fmt.Sprintf("%v", this.Operations) + `,`,
+		`Patterns:` + fmt.Sprintf("%v", this.Patterns) + `,`,
+		`Debounce:` + fmt.Sprintf("%v", this.Debounce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GRPCSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCSignal{`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientCommonNames:` + fmt.Sprintf("%v", this.ClientCommonNames) + `,`,
+		`AllowAnonymous:` + fmt.Sprintf("%v", this.AllowAnonymous) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitRefs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRefs := make([]string, 0, len(this.Refs))
+	for k := range this.Refs {
+		keysForRefs = append(keysForRefs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRefs)
+	mapStringForRefs := "map[string]string{"
+	for _, k := range keysForRefs {
+		mapStringForRefs += fmt.Sprintf("%v: %v,", k, this.Refs[k])
+	}
+	mapStringForRefs += "}"
+	s := strings.Join([]string{`&GitRefs{`,
+		`Refs:` + mapStringForRefs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitSignal{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Refs:` + fmt.Sprintf("%v", this.Refs) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SSHKey:` + strings.Replace(fmt.Sprintf("%v", this.SSHKey), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
+		`MaxCommits:` + fmt.Sprintf("%v", this.MaxCommits) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupVersionKind) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupVersionKind{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPBasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPBasicAuth{`,
+		`Username:` + strings.Replace(fmt.Sprintf("%v", this.Username), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPPollSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPPollSignal{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`BasicAuth:` + strings.Replace(fmt.Sprintf("%v", this.BasicAuth), "HTTPBasicAuth", "HTTPBasicAuth", 1) + `,`,
+		`BearerToken:` + strings.Replace(fmt.Sprintf("%v", this.BearerToken), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`MaxBackoff:` + fmt.Sprintf("%v", this.MaxBackoff) + `,`,
+		`Conditions:` + strings.Replace(fmt.Sprintf("%v", this.Conditions), "DataFilter", "DataFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ICalendarSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ICalendarSource{`,
+		`Location:` + strings.Replace(strings.Replace(this.Location.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`RefreshInterval:` + fmt.Sprintf("%v", this.RefreshInterval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubeEventsInvolvedObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubeEventsInvolvedObject{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubeEventsSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubeEventsSignal{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`Types:` + fmt.Sprintf("%v", this.Types) + `,`,
+		`InvolvedObject:` + strings.Replace(fmt.Sprintf("%v", this.InvolvedObject), "KubeEventsInvolvedObject", "KubeEventsInvolvedObject", 1) + `,`,
+		`SourceComponents:` + fmt.Sprintf("%v", this.SourceComponents) + `,`,
+		`CollapseInterval:` + fmt.Sprintf("%v", this.CollapseInterval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message{`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Stream:` + strings.Replace(strings.Replace(this.Stream.String(), "Stream", "Stream", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeStatus{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`CompletedAt:` + strings.Replace(strings.Replace(this.CompletedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LatestEvent:` + strings.Replace(fmt.Sprintf("%v", this.LatestEvent), "EventWrapper", "EventWrapper", 1) + `,`,
+		`Aggregate:` + strings.Replace(fmt.Sprintf("%v", this.Aggregate), "Aggregate", "Aggregate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostgresPosition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostgresPosition{`,
+		`LSN:` + fmt.Sprintf("%v", this.LSN) + `,`,
+		`Row:` + fmt.Sprintf("%v", this.Row) + `,`,
+		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostgresReplication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostgresReplication{`,
+		`Slot:` + fmt.Sprintf("%v", this.Slot) + `,`,
+		`Plugin:` + fmt.Sprintf("%v", this.Plugin) + `,`,
+		`Publication:` + fmt.Sprintf("%v", this.Publication) + `,`,
+		`Tables:` + fmt.Sprintf("%v", this.Tables) + `,`,
+		`CreateSlot:` + fmt.Sprintf("%v", this.CreateSlot) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostgresSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostgresSignal{`,
+		`ConnectionString:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionString), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`Channels:` + fmt.Sprintf("%v", this.Channels) + `,`,
+		`Replication:` + strings.Replace(fmt.Sprintf("%v", this.Replication), "PostgresReplication", "PostgresReplication", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceFieldChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceFieldChange{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ResourceFilter{`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`CreatedBy:` + strings.Replace(strings.Replace(this.CreatedBy.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`FieldSelector:` + fmt.Sprintf("%v", this.FieldSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&ResourceObject{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "ResourceParameter", "ResourceParameter", 1), `&`, ``, 1) + `,`,
+		`GroupVersionKind:` + strings.Replace(strings.Replace(this.GroupVersionKind.String(), "GroupVersionKind", "GroupVersionKind", 1), `&`, ``, 1) + `,`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceParameter{`,
+		`Src:` + strings.Replace(fmt.Sprintf("%v", this.Src), "ResourceParameterSource", "ResourceParameterSource", 1) + `,`,
+		`Dest:` + fmt.Sprintf("%v", this.Dest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceParameterSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceParameterSource{`,
+		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`Aggregate:` + fmt.Sprintf("%v", this.Aggregate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceSignal{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ResourceFilter", "ResourceFilter", 1) + `,`,
+		`GroupVersionKind:` + strings.Replace(strings.Replace(this.GroupVersionKind.String(), "GroupVersionKind", "GroupVersionKind", 1), `&`, ``, 1) + `,`,
+		`IncludeExisting:` + fmt.Sprintf("%v", this.IncludeExisting) + `,`,
+		`FieldChanges:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldChanges), "ResourceFieldChange", "ResourceFieldChange", 1), `&`, ``, 1) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`NamespaceSelector:` + fmt.Sprintf("%v", this.NamespaceSelector) + `,`,
+		`IncludeLabels:` + fmt.Sprintf("%v", this.IncludeLabels) + `,`,
+		`IncludeAnnotations:` + fmt.Sprintf("%v", this.IncludeAnnotations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Artifact{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "S3Filter", "S3Filter", 1) + `,`,
+		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Bucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Bucket{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`AccessKey:` + strings.Replace(strings.Replace(this.AccessKey.String(), "SecretKeySelector", "v11.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`SecretKey:` + strings.Replace(strings.Replace(this.SecretKey.String(), "SecretKeySelector", "v11.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Filter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Filter{`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Suffix:` + fmt.Sprintf("%v", this.Suffix) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SFTPSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SFTPSignal{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Directory:` + fmt.Sprintf("%v", this.Directory) + `,`,
+		`Patterns:` + fmt.Sprintf("%v", this.Patterns) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PrivateKey:` + strings.Replace(fmt.Sprintf("%v", this.PrivateKey), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`HostKey:` + fmt.Sprintf("%v", this.HostKey) + `,`,
+		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`ArchiveDirectory:` + fmt.Sprintf("%v", this.ArchiveDirectory) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SMTPSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SMTPSignal{`,
+		`Recipients:` + fmt.Sprintf("%v", this.Recipients) + `,`,
+		`Senders:` + fmt.Sprintf("%v", this.Senders) + `,`,
+		`Attachments:` + strings.Replace(fmt.Sprintf("%v", this.Attachments), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sensor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sensor{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SensorList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Sensor", "Sensor", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SensorSpec{`,
+		`Signals:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Signals), "Signal", "Signal", 1), `&`, ``, 1) + `,`,
+		`Triggers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Triggers), "Trigger", "Trigger", 1), `&`, ``, 1) + `,`,
+		`Escalation:` + strings.Replace(fmt.Sprintf("%v", this.Escalation), "EscalationPolicy", "EscalationPolicy", 1) + `,`,
+		`Repeat:` + fmt.Sprintf("%v", this.Repeat) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNodes := make([]string, 0, len(this.Nodes))
+	for k := range this.Nodes {
+		keysForNodes = append(keysForNodes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+	mapStringForNodes := "map[string]NodeStatus{"
+	for _, k := range keysForNodes {
+		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	}
+	mapStringForNodes += "}"
+	keysForLastEventTimes := make([]string, 0, len(this.LastEventTimes))
+	for k := range this.LastEventTimes {
+		keysForLastEventTimes = append(keysForLastEventTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLastEventTimes)
+	mapStringForLastEventTimes := "map[string]v1.Time{"
+	for _, k := range keysForLastEventTimes {
+		mapStringForLastEventTimes += fmt.Sprintf("%v: %v,", k, this.LastEventTimes[k])
+	}
+	mapStringForLastEventTimes += "}"
+	keysForArtifactFingerprints := make([]string, 0, len(this.ArtifactFingerprints))
+	for k := range this.ArtifactFingerprints {
+		keysForArtifactFingerprints = append(keysForArtifactFingerprints, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForArtifactFingerprints)
+	mapStringForArtifactFingerprints := "map[string]string{"
+	for _, k := range keysForArtifactFingerprints {
+		mapStringForArtifactFingerprints += fmt.Sprintf("%v: %v,", k, this.ArtifactFingerprints[k])
+	}
+	mapStringForArtifactFingerprints += "}"
+	keysForGitRefs := make([]string, 0, len(this.GitRefs))
+	for k := range this.GitRefs {
+		keysForGitRefs = append(keysForGitRefs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGitRefs)
+	mapStringForGitRefs := "map[string]GitRefs{"
+	for _, k := range keysForGitRefs {
+		mapStringForGitRefs += fmt.Sprintf("%v: %v,", k, this.GitRefs[k])
+	}
+	mapStringForGitRefs += "}"
+	keysForPostgresPositions := make([]string, 0, len(this.PostgresPositions))
+	for k := range this.PostgresPositions {
+		keysForPostgresPositions = append(keysForPostgresPositions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPostgresPositions)
+	mapStringForPostgresPositions := "map[string]PostgresPosition{"
+	for _, k := range keysForPostgresPositions {
+		mapStringForPostgresPositions += fmt.Sprintf("%v: %v,", k, this.PostgresPositions[k])
+	}
+	mapStringForPostgresPositions += "}"
+	s := strings.Join([]string{`&SensorStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`CompletedAt:` + strings.Replace(strings.Replace(this.CompletedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Nodes:` + mapStringForNodes + `,`,
+		`LastEventTimes:` + mapStringForLastEventTimes + `,`,
+		`ArtifactFingerprints:` + mapStringForArtifactFingerprints + `,`,
+		`GitRefs:` + mapStringForGitRefs + `,`,
+		`PostgresPositions:` + mapStringForPostgresPositions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Signal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Signal{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`Stream:` + strings.Replace(fmt.Sprintf("%v", this.Stream), "Stream", "Stream", 1) + `,`,
+		`Artifact:` + strings.Replace(fmt.Sprintf("%v", this.Artifact), "ArtifactSignal", "ArtifactSignal", 1) + `,`,
+		`Calendar:` + strings.Replace(fmt.Sprintf("%v", this.Calendar), "CalendarSignal", "CalendarSignal", 1) + `,`,
+		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "ResourceSignal", "ResourceSignal", 1) + `,`,
+		`Webhook:` + strings.Replace(fmt.Sprintf("%v", this.Webhook), "WebhookSignal", "WebhookSignal", 1) + `,`,
+		`Filters:` + strings.Replace(strings.Replace(this.Filters.String(), "SignalFilter", "SignalFilter", 1), `&`, ``, 1) + `,`,
+		`File:` + strings.Replace(fmt.Sprintf("%v", this.File), "FileSignal", "FileSignal", 1) + `,`,
+		`Git:` + strings.Replace(fmt.Sprintf("%v", this.Git), "GitSignal", "GitSignal", 1) + `,`,
+		`HTTPPoll:` + strings.Replace(fmt.Sprintf("%v", this.HTTPPoll), "HTTPPollSignal", "HTTPPollSignal", 1) + `,`,
+		`CloudEvents:` + strings.Replace(fmt.Sprintf("%v", this.CloudEvents), "CloudEventsSignal", "CloudEventsSignal", 1) + `,`,
+		`KubeEvents:` + strings.Replace(fmt.Sprintf("%v", this.KubeEvents), "KubeEventsSignal", "KubeEventsSignal", 1) + `,`,
+		`Alertmanager:` + strings.Replace(fmt.Sprintf("%v", this.Alertmanager), "AlertmanagerSignal", "AlertmanagerSignal", 1) + `,`,
+		`Postgres:` + strings.Replace(fmt.Sprintf("%v", this.Postgres), "PostgresSignal", "PostgresSignal", 1) + `,`,
+		`GRPC:` + strings.Replace(fmt.Sprintf("%v", this.GRPC), "GRPCSignal", "GRPCSignal", 1) + `,`,
+		`Syslog:` + strings.Replace(fmt.Sprintf("%v", this.Syslog), "SyslogSignal", "SyslogSignal", 1) + `,`,
+		`SMTP:` + strings.Replace(fmt.Sprintf("%v", this.SMTP), "SMTPSignal", "SMTPSignal", 1) + `,`,
+		`SFTP:` + strings.Replace(fmt.Sprintf("%v", this.SFTP), "SFTPSignal", "SFTPSignal", 1) + `,`,
+		`Aggregation:` + strings.Replace(fmt.Sprintf("%v", this.Aggregation), "Aggregation", "Aggregation", 1) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "SignalState", "SignalState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalFilter{`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "TimeFilter", "TimeFilter", 1) + `,`,
+		`Context:` + strings.Replace(fmt.Sprintf("%v", this.Context), "EventContext", "EventContext", 1) + `,`,
+		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "DataFilter", "DataFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForGitRefs := make([]string, 0, len(this.GitRefs))
+	for k := range this.GitRefs {
+		keysForGitRefs = append(keysForGitRefs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGitRefs)
+	mapStringForGitRefs := "map[string]string{"
+	for _, k := range keysForGitRefs {
+		mapStringForGitRefs += fmt.Sprintf("%v: %v,", k, this.GitRefs[k])
+	}
+	mapStringForGitRefs += "}"
+	s := strings.Join([]string{`&SignalState{`,
+		`LastFired:` + strings.Replace(fmt.Sprintf("%v", this.LastFired), "Time", "v1.Time", 1) + `,`,
+		`ArtifactFingerprint:` + fmt.Sprintf("%v", this.ArtifactFingerprint) + `,`,
+		`GitRefs:` + mapStringForGitRefs + `,`,
+		`PostgresPosition:` + strings.Replace(fmt.Sprintf("%v", this.PostgresPosition), "PostgresPosition", "PostgresPosition", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Stream) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	s := strings.Join([]string{`&Stream{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyslogSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyslogSignal{`,
+		`Protocols:` + fmt.Sprintf("%v", this.Protocols) + `,`,
+		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
+		`Contains:` + fmt.Sprintf("%v", this.Contains) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeFilter{`,
+		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1) + `,`,
+		`Stop:` + strings.Replace(fmt.Sprintf("%v", this.Stop), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Trigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Trigger{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "ResourceObject", "ResourceObject", 1) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Message", "Message", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
 }
 func (this *URI) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&URI{`,
-		`Scheme:` + fmt.Sprintf("%v", this.Scheme) + `,`,
-		`User:` + fmt.Sprintf("%v", this.User) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
-		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
-		`Fragment:` + fmt.Sprintf("%v", this.Fragment) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := strings.Join([]string{`&URI{`,
+		`Scheme:` + fmt.Sprintf("%v", this.Scheme) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Fragment:` + fmt.Sprintf("%v", this.Fragment) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *URLArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&URLArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`VerifyCert:` + fmt.Sprintf("%v", this.VerifyCert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookSignal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookSignal{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, AggregateSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = AggregationFunction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowType = WindowType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = AggregationOperator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertmanagerSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertmanagerSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertmanagerSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Inline = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileArtifact{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.URL == nil {
+				m.URL = &URLArtifact{}
+			}
+			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactPoll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactPoll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactPoll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeContent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeContent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = ArtifactSignalMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Poll == nil {
+				m.Poll = &ArtifactPoll{}
+			}
+			if err := m.Poll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InlineContentLimit", wireType)
+			}
+			m.InlineContentLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InlineContentLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalendarSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = append(m.Recurrence, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CatchUp == nil {
+				m.CatchUp = &CatchUpPolicy{}
+			}
+			if err := m.CatchUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICalendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ICalendar == nil {
+				m.ICalendar = &ICalendarSource{}
+			}
+			if err := m.ICalendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CatchUpPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CatchUpPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CatchUpPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = CatchUpMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudEventsSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudEventsSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudEventsSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = JSONType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEventsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloudEventsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &URI{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaURL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchemaURL == nil {
+				m.SchemaURL = &URI{}
+			}
+			if err := m.SchemaURL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extensions == nil {
+				m.Extensions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Extensions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Directory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursive = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, FileOperation(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patterns = append(m.Patterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debounce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debounce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v11.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCommonNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCommonNames = append(m.ClientCommonNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAnonymous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAnonymous = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitRefs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitRefs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitRefs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refs == nil {
+				m.Refs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Refs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refs = append(m.Refs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v11.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHKey == nil {
+				m.SSHKey = &v11.SecretKeySelector{}
+			}
+			if err := m.SSHKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommits", wireType)
+			}
+			m.MaxCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommits |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupVersionKind: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupVersionKind: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPBasicAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPBasicAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v11.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v11.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPPollSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPPollSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPPollSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &HTTPBasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BearerToken == nil {
+				m.BearerToken = &v11.SecretKeySelector{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBackoff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, &DataFilter{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICalendarSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICalendarSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICalendarSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = ICalendarMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeEventsInvolvedObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeEventsInvolvedObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeEventsInvolvedObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeEventsSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeEventsSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeEventsSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvolvedObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InvolvedObject == nil {
+				m.InvolvedObject = &KubeEventsInvolvedObject{}
+			}
+			if err := m.InvolvedObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceComponents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceComponents = append(m.SourceComponents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollapseInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollapseInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestEvent == nil {
+				m.LatestEvent = &EventWrapper{}
+			}
+			if err := m.LatestEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregate == nil {
+				m.Aggregate = &Aggregate{}
+			}
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (this *URLArtifact) String() string {
-	if this == nil {
-		return "nil"
+func (m *PostgresPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostgresPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostgresPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LSN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LSN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&URLArtifact{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`VerifyCert:` + fmt.Sprintf("%v", this.VerifyCert) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (this *WebhookSignal) String() string {
-	if this == nil {
-		return "nil"
+func (m *PostgresReplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostgresReplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostgresReplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugin = PostgresReplicationPlugin(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publication = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSlot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateSlot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&WebhookSignal{`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *PostgresSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3546,15 +12084,15 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostgresSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostgresSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionString", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3578,16 +12116,128 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
+			if m.ConnectionString == nil {
+				m.ConnectionString = &v11.SecretKeySelector{}
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionString.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replication == nil {
+				m.Replication = &PostgresReplication{}
+			}
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceFieldChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceFieldChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceFieldChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3612,47 +12262,13 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Inline = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &FileArtifact{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3662,24 +12278,20 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.URL == nil {
-				m.URL = &URLArtifact{}
-			}
-			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3702,7 +12314,7 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactSignal) Unmarshal(dAtA []byte) error {
+func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3725,15 +12337,162 @@ func (m *ArtifactSignal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactSignal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3750,20 +12509,108 @@ func (m *ArtifactSignal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3787,92 +12634,13 @@ func (m *ArtifactSignal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CalendarSignal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CalendarSignal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CalendarSignal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3897,11 +12665,11 @@ func (m *CalendarSignal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = string(dAtA[iNdEx:postIndex])
+			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3926,7 +12694,7 @@ func (m *CalendarSignal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recurrence = append(m.Recurrence, string(dAtA[iNdEx:postIndex]))
+			m.FieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3949,7 +12717,7 @@ func (m *CalendarSignal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataFilter) Unmarshal(dAtA []byte) error {
+func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3972,15 +12740,15 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4005,13 +12773,13 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4021,26 +12789,115 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = JSONType(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4050,76 +12907,28 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Parameters = append(m.Parameters, ResourceParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EscalationPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EscalationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4129,24 +12938,25 @@ func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4170,7 +12980,7 @@ func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4195,7 +13005,7 @@ func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *ResourceParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4218,15 +13028,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4250,15 +13060,18 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Src == nil {
+				m.Src = &ResourceParameterSource{}
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4268,22 +13081,20 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Dest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4306,7 +13117,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContext) Unmarshal(dAtA []byte) error {
+func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4329,15 +13140,15 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceParameterSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4362,11 +13173,11 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			m.Signal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTypeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4391,11 +13202,11 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventTypeVersion = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudEventsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4420,13 +13231,14 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CloudEventsVersion = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4436,28 +13248,65 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Aggregate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &URI{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4482,11 +13331,44 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &ResourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4510,13 +13392,33 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExisting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeExisting = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4540,16 +13442,14 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchemaURL == nil {
-				m.SchemaURL = &URI{}
-			}
-			if err := m.SchemaURL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FieldChanges = append(m.FieldChanges, ResourceFieldChange{})
+			if err := m.FieldChanges[len(m.FieldChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4574,13 +13474,13 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4590,165 +13490,26 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extensions == nil {
-				m.Extensions = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Extensions[mapkey] = mapvalue
+			m.NamespaceSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventWrapper) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventWrapper: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLabels", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4758,25 +13519,15 @@ func (m *EventWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.IncludeLabels = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAnnotations", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4793,7 +13544,7 @@ func (m *EventWrapper) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Seen = bool(v != 0)
+			m.IncludeAnnotations = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4815,7 +13566,7 @@ func (m *EventWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileArtifact) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4834,45 +13585,16 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4894,7 +13616,7 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4917,15 +13639,15 @@ func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupVersionKind: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupVersionKind: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4950,11 +13672,11 @@ func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4979,13 +13701,13 @@ func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Event = github_com_minio_minio_go.NotificationEventType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4995,103 +13717,28 @@ func (m *GroupVersionKind) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Message) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Filter == nil {
+				m.Filter = &S3Filter{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5115,7 +13762,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5140,7 +13787,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5163,15 +13810,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5196,11 +13843,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5225,11 +13872,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5254,13 +13901,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5270,83 +13917,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5370,42 +13949,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CompletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5429,10 +13979,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestEvent == nil {
-				m.LatestEvent = &EventWrapper{}
-			}
-			if err := m.LatestEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5457,7 +14004,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
+func (m *S3Filter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5480,10 +14027,10 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Filter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5505,257 +14052,21 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Annotations[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5765,21 +14076,20 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5802,7 +14112,7 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceObject) Unmarshal(dAtA []byte) error {
+func (m *SFTPSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5825,15 +14135,15 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: SFTPSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SFTPSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5858,13 +14168,13 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5874,115 +14184,55 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Directory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patterns", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patterns = append(m.Patterns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5992,26 +14242,24 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, ResourceParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6035,13 +14283,16 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Password == nil {
+				m.Password = &v11.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6065,65 +14316,67 @@ func (m *ResourceObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PrivateKey == nil {
+				m.PrivateKey = &v11.SecretKeySelector{}
+			}
+			if err := m.PrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostKey", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.HostKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6133,28 +14386,24 @@ func (m *ResourceParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &ResourceParameterSource{}
-			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveDirectory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6179,7 +14428,7 @@ func (m *ResourceParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dest = string(dAtA[iNdEx:postIndex])
+			m.ArchiveDirectory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6202,7 +14451,7 @@ func (m *ResourceParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
+func (m *SMTPSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6225,15 +14474,15 @@ func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceParameterSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SMTPSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SMTPSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6258,11 +14507,11 @@ func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signal = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6287,13 +14536,13 @@ func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6303,21 +14552,24 @@ func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			if m.Attachments == nil {
+				m.Attachments = &ArtifactLocation{}
+			}
+			if err := m.Attachments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6340,7 +14592,7 @@ func (m *ResourceParameterSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
+func (m *Sensor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6363,17 +14615,17 @@ func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSignal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6383,24 +14635,25 @@ func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6424,16 +14677,13 @@ func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ResourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6457,7 +14707,7 @@ func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6482,7 +14732,7 @@ func (m *ResourceSignal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *SensorList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6505,12 +14755,73 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Sensor{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6532,7 +14843,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6555,17 +14866,17 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6575,26 +14886,28 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6604,24 +14917,26 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = github_com_minio_minio_go.NotificationEventType(dAtA[iNdEx:postIndex])
+			m.Triggers = append(m.Triggers, Trigger{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6645,18 +14960,18 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &S3Filter{}
+			if m.Escalation == nil {
+				m.Escalation = &EscalationPolicy{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6666,22 +14981,12 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Repeat = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6703,7 +15008,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6726,15 +15031,15 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6759,13 +15064,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6775,26 +15080,27 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6804,26 +15110,27 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if err := m.CompletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6833,15 +15140,24 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6865,13 +15181,106 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Nodes == nil {
+				m.Nodes = make(map[string]NodeStatus)
+			}
+			var mapkey string
+			mapvalue := &NodeStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Nodes[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTimes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6895,65 +15304,108 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *S3Filter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LastEventTimes == nil {
+				m.LastEventTimes = make(map[string]v1.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			mapvalue := &v1.Time{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.Time{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: S3Filter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Filter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.LastEventTimes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactFingerprints", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6963,26 +15415,115 @@ func (m *S3Filter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			if m.ArtifactFingerprints == nil {
+				m.ArtifactFingerprints = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ArtifactFingerprints[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRefs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6992,104 +15533,118 @@ func (m *S3Filter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Sensor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if m.GitRefs == nil {
+				m.GitRefs = make(map[string]GitRefs)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &GitRefs{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GitRefs{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GitRefs[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostgresPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7113,39 +15668,102 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if m.PostgresPositions == nil {
+				m.PostgresPositions = make(map[string]PostgresPosition)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &PostgresPosition{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PostgresPosition{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PostgresPositions[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7168,7 +15786,7 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorList) Unmarshal(dAtA []byte) error {
+func (m *Signal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7191,17 +15809,17 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Signal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Signal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7211,25 +15829,43 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7253,64 +15889,16 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Sensor{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Stream == nil {
+				m.Stream = &Stream{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SensorSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7334,14 +15922,16 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactSignal{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7365,14 +15955,16 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triggers = append(m.Triggers, Trigger{})
-			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Calendar == nil {
+				m.Calendar = &CalendarSignal{}
+			}
+			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escalation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7396,18 +15988,18 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Escalation == nil {
-				m.Escalation = &EscalationPolicy{}
+			if m.Resource == nil {
+				m.Resource = &ResourceSignal{}
 			}
-			if err := m.Escalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7417,67 +16009,30 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Repeat = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SensorStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Webhook == nil {
+				m.Webhook = &WebhookSignal{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7487,24 +16042,25 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7528,13 +16084,16 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.File == nil {
+				m.File = &FileSignal{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7558,15 +16117,18 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CompletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &GitSignal{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPPoll", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7576,24 +16138,28 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.HTTPPoll == nil {
+				m.HTTPPoll = &HTTPPollSignal{}
+			}
+			if err := m.HTTPPoll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7617,158 +16183,51 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nodes == nil {
-				m.Nodes = make(map[string]NodeStatus)
-			}
-			var mapkey string
-			mapvalue := &NodeStatus{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NodeStatus{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.CloudEvents == nil {
+				m.CloudEvents = &CloudEventsSignal{}
 			}
-			m.Nodes[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CloudEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeEvents", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Signal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.KubeEvents == nil {
+				m.KubeEvents = &KubeEventsSignal{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Signal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.KubeEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alertmanager", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7778,26 +16237,30 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Alertmanager == nil {
+				m.Alertmanager = &AlertmanagerSignal{}
+			}
+			if err := m.Alertmanager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postgres", wireType)
 			}
-			m.Deadline = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7807,14 +16270,28 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Postgres == nil {
+				m.Postgres = &PostgresSignal{}
+			}
+			if err := m.Postgres.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7838,16 +16315,16 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stream == nil {
-				m.Stream = &Stream{}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCSignal{}
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Syslog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7871,16 +16348,16 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifact == nil {
-				m.Artifact = &ArtifactSignal{}
+			if m.Syslog == nil {
+				m.Syslog = &SyslogSignal{}
 			}
-			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Syslog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7904,16 +16381,16 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Calendar == nil {
-				m.Calendar = &CalendarSignal{}
+			if m.SMTP == nil {
+				m.SMTP = &SMTPSignal{}
 			}
-			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SMTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SFTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7937,16 +16414,16 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceSignal{}
+			if m.SFTP == nil {
+				m.SFTP = &SFTPSignal{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SFTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7970,16 +16447,16 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookSignal{}
+			if m.Aggregation == nil {
+				m.Aggregation = &Aggregation{}
 			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Aggregation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8003,7 +16480,10 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &SignalState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8090,9 +16570,185 @@ func (m *SignalFilter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &EventContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &DataFilter{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFired == nil {
+				m.LastFired = &v1.Time{}
+			}
+			if err := m.LastFired.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8116,16 +16772,101 @@ func (m *SignalFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &EventContext{}
+			if m.GitRefs == nil {
+				m.GitRefs = make(map[string]string)
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.GitRefs[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostgresPosition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8149,8 +16890,10 @@ func (m *SignalFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &DataFilter{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PostgresPosition == nil {
+				m.PostgresPosition = &PostgresPosition{}
+			}
+			if err := m.PostgresPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8401,6 +17144,172 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyslogSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyslogSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyslogSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocols = append(m.Protocols, SyslogProtocol(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = SyslogSeverity(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9280,178 +18189,332 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1/generated.proto", fileDescriptor_generated_680506a6b2f9cb01)
-}
-
-var fileDescriptor_generated_680506a6b2f9cb01 = []byte{
-	// 2695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdd, 0x6f, 0x5c, 0x47,
-	0x15, 0xcf, 0xdd, 0x2f, 0xef, 0x9e, 0x75, 0x12, 0x77, 0x40, 0x62, 0x65, 0x51, 0x3b, 0xba, 0x15,
-	0x28, 0xa0, 0x74, 0xb7, 0x8d, 0x01, 0x15, 0xa4, 0x42, 0xbd, 0xb6, 0xd3, 0xb8, 0x76, 0x52, 0x67,
-	0x36, 0x71, 0x45, 0xa8, 0x44, 0xc7, 0x77, 0xc7, 0xbb, 0x37, 0xbe, 0x7b, 0xef, 0xcd, 0xcc, 0xac,
-	0x9b, 0x45, 0x08, 0x0a, 0xea, 0x13, 0xe2, 0xa3, 0x2f, 0x20, 0x1e, 0x78, 0x41, 0x15, 0x4f, 0xbc,
-	0xf3, 0x07, 0x20, 0x21, 0xf2, 0x58, 0xde, 0xfa, 0x00, 0x16, 0x31, 0x12, 0x7f, 0x44, 0x24, 0x24,
-	0x34, 0x1f, 0x77, 0xee, 0xdd, 0x5d, 0x9b, 0xc4, 0xbe, 0x5b, 0xf1, 0x62, 0xed, 0x3d, 0x67, 0xe6,
-	0x77, 0xce, 0xcc, 0x9c, 0x39, 0x5f, 0x63, 0xb8, 0xd9, 0xf3, 0x45, 0x7f, 0xb8, 0xd7, 0xf4, 0xa2,
-	0x41, 0x8b, 0xb0, 0x5e, 0x14, 0xb3, 0xe8, 0x81, 0xfa, 0xf1, 0x32, 0x3d, 0xa4, 0xa1, 0xe0, 0xad,
-	0xf8, 0xa0, 0xd7, 0x22, 0xb1, 0xcf, 0x5b, 0x9c, 0x86, 0x3c, 0x62, 0xad, 0xc3, 0x57, 0x49, 0x10,
-	0xf7, 0xc9, 0xab, 0xad, 0x1e, 0x0d, 0x29, 0x23, 0x82, 0x76, 0x9b, 0x31, 0x8b, 0x44, 0x84, 0x5e,
-	0x4b, 0x91, 0x9a, 0x09, 0x92, 0xfa, 0xf1, 0x7d, 0x8d, 0xd4, 0x8c, 0x0f, 0x7a, 0x4d, 0x89, 0xd4,
-	0xd4, 0x48, 0xcd, 0x04, 0x69, 0xf1, 0xe5, 0x8c, 0x0e, 0xbd, 0xa8, 0x17, 0xb5, 0x14, 0xe0, 0xde,
-	0x70, 0x5f, 0x7d, 0xa9, 0x0f, 0xf5, 0x4b, 0x0b, 0x5a, 0x74, 0x0f, 0x5e, 0xe3, 0x4d, 0x3f, 0x92,
-	0x5a, 0xb5, 0xbc, 0x88, 0xd1, 0xd6, 0xe1, 0x94, 0x32, 0x8b, 0x5f, 0x4b, 0xc7, 0x0c, 0x88, 0xd7,
-	0xf7, 0x43, 0xca, 0x46, 0xe9, 0x52, 0x06, 0x54, 0x90, 0x93, 0x66, 0xb5, 0x4e, 0x9b, 0xc5, 0x86,
-	0xa1, 0xf0, 0x07, 0x74, 0x6a, 0xc2, 0x37, 0x9e, 0x35, 0x81, 0x7b, 0x7d, 0x3a, 0x20, 0x53, 0xf3,
-	0x56, 0x4e, 0x9b, 0x37, 0x14, 0x7e, 0xd0, 0xf2, 0x43, 0xc1, 0x05, 0x9b, 0x9c, 0xe4, 0xfe, 0xbd,
-	0x00, 0x0b, 0xab, 0x4c, 0xf8, 0xfb, 0xc4, 0x13, 0xdb, 0x91, 0x47, 0x84, 0x1f, 0x85, 0xe8, 0x5d,
-	0x28, 0xf0, 0x95, 0x86, 0x73, 0xc5, 0xb9, 0x5a, 0xbf, 0xbe, 0xde, 0x3c, 0xef, 0x11, 0x34, 0x3b,
-	0x2b, 0x09, 0x72, 0xbb, 0x72, 0x7c, 0xb4, 0x5c, 0xe8, 0xac, 0xe0, 0x02, 0x5f, 0x41, 0x2e, 0x54,
-	0xfc, 0x30, 0xf0, 0x43, 0xda, 0x28, 0x5c, 0x71, 0xae, 0xd6, 0xda, 0x70, 0x7c, 0xb4, 0x5c, 0xd9,
-	0x54, 0x14, 0x6c, 0x38, 0xa8, 0x0b, 0xa5, 0x7d, 0x3f, 0xa0, 0x8d, 0xa2, 0xd2, 0xe1, 0xc6, 0xf9,
-	0x75, 0xb8, 0xe1, 0x07, 0xd4, 0x6a, 0x51, 0x3d, 0x3e, 0x5a, 0x2e, 0x49, 0x0a, 0x56, 0xe8, 0xe8,
-	0x3d, 0x28, 0x0e, 0x59, 0xd0, 0x28, 0x29, 0x21, 0x1b, 0xe7, 0x17, 0x72, 0x0f, 0x6f, 0x5b, 0x19,
-	0x73, 0xc7, 0x47, 0xcb, 0xc5, 0x7b, 0x78, 0x1b, 0x4b, 0x68, 0xf7, 0x17, 0x05, 0xb8, 0x94, 0xb0,
-	0x3a, 0x7e, 0x2f, 0x24, 0x01, 0xea, 0x43, 0x45, 0x10, 0xd6, 0xa3, 0xc2, 0x6c, 0xf0, 0x1b, 0x39,
-	0x36, 0x58, 0x30, 0x4a, 0x06, 0xed, 0x4b, 0x8f, 0x8f, 0x96, 0x2f, 0xc8, 0x4d, 0xbc, 0xab, 0x70,
-	0xb1, 0xc1, 0x47, 0x1f, 0x39, 0xb0, 0x40, 0x26, 0xce, 0x56, 0xed, 0x79, 0xfd, 0xfa, 0x5b, 0xe7,
-	0x17, 0x3a, 0x69, 0x2d, 0xed, 0x86, 0x11, 0x3f, 0x65, 0x47, 0x78, 0x4a, 0xba, 0xfb, 0x3b, 0x07,
-	0x2e, 0xad, 0x91, 0x80, 0x86, 0x5d, 0xc2, 0xcc, 0x7e, 0x5c, 0x83, 0xaa, 0x34, 0xe8, 0xee, 0x30,
-	0xa0, 0x6a, 0x47, 0x6a, 0xed, 0x05, 0x03, 0x58, 0xed, 0x18, 0x3a, 0xb6, 0x23, 0xe4, 0x68, 0x3f,
-	0x14, 0x94, 0x1d, 0x92, 0xc0, 0x98, 0x8f, 0x1d, 0xbd, 0x69, 0xe8, 0xd8, 0x8e, 0x40, 0x4d, 0x00,
-	0x46, 0xbd, 0x21, 0x63, 0x34, 0xf4, 0xa4, 0x31, 0x15, 0xaf, 0xd6, 0xda, 0x97, 0x8e, 0x8f, 0x96,
-	0x01, 0x5b, 0x2a, 0xce, 0x8c, 0x70, 0x7f, 0xe2, 0x00, 0xac, 0x13, 0x41, 0x6e, 0xf8, 0x81, 0xa0,
-	0x0c, 0x5d, 0x81, 0x52, 0x4c, 0x44, 0xdf, 0xa8, 0x35, 0x6f, 0x04, 0x95, 0x76, 0x88, 0xe8, 0x63,
-	0xc5, 0x41, 0xd7, 0xa0, 0x24, 0x46, 0x71, 0x62, 0xc9, 0xc9, 0x4e, 0x94, 0xee, 0x8e, 0x62, 0xfa,
-	0xf4, 0x68, 0xb9, 0xfa, 0x56, 0xe7, 0xed, 0xdb, 0xf2, 0x37, 0x56, 0xa3, 0xd0, 0x4b, 0x50, 0x3e,
-	0x24, 0xc1, 0x50, 0x9b, 0x75, 0xad, 0x7d, 0xd1, 0x0c, 0x2f, 0xef, 0x4a, 0x22, 0xd6, 0x3c, 0xf7,
-	0x0f, 0x0e, 0x2c, 0x6c, 0x70, 0x8f, 0x04, 0x6a, 0xc7, 0x76, 0xa2, 0xc0, 0xf7, 0x46, 0x72, 0x66,
-	0x40, 0x0f, 0x69, 0x60, 0x54, 0xb1, 0x33, 0xb7, 0x25, 0x11, 0x6b, 0x1e, 0x0a, 0x60, 0x6e, 0x40,
-	0x39, 0x27, 0x3d, 0x6a, 0x4e, 0x79, 0xf5, 0xfc, 0xa7, 0x7c, 0x4b, 0x03, 0xb5, 0x2f, 0x1b, 0x49,
-	0x73, 0x86, 0x80, 0x13, 0x11, 0xee, 0x6f, 0x1d, 0x28, 0x6f, 0x48, 0x14, 0xf4, 0x10, 0xe6, 0xbc,
-	0x28, 0x14, 0xf4, 0x51, 0x62, 0xd2, 0x39, 0xee, 0xab, 0x42, 0x5c, 0xd3, 0x68, 0xa9, 0x70, 0x43,
-	0xc0, 0x89, 0x1c, 0xf4, 0x45, 0x28, 0x75, 0x89, 0x20, 0x6a, 0x9d, 0xf3, 0xfa, 0x5e, 0xcb, 0x73,
-	0xc3, 0x8a, 0xea, 0xfe, 0xb1, 0x02, 0xf3, 0x59, 0x20, 0xd4, 0x82, 0x9a, 0x12, 0x2c, 0xcf, 0xc2,
-	0x6c, 0xe1, 0x0b, 0x06, 0xbb, 0xb6, 0x91, 0x30, 0x70, 0x3a, 0x06, 0xad, 0xc3, 0x82, 0xfd, 0xd8,
-	0xa5, 0x8c, 0x27, 0x37, 0x27, 0x3d, 0xe3, 0x85, 0x8d, 0x09, 0x3e, 0x9e, 0x9a, 0x81, 0xde, 0x02,
-	0xe4, 0x05, 0xd1, 0xb0, 0xab, 0x86, 0xf2, 0x04, 0x47, 0x1f, 0xfe, 0xa2, 0xc1, 0x41, 0x6b, 0x53,
-	0x23, 0xf0, 0x09, 0xb3, 0x10, 0x81, 0x0a, 0x8f, 0x86, 0xcc, 0xa3, 0xc6, 0x5d, 0xbd, 0x9e, 0xc7,
-	0x5d, 0x6d, 0x6a, 0xa7, 0xdb, 0x51, 0x80, 0xd8, 0x00, 0xa3, 0xaf, 0xc0, 0x9c, 0x9a, 0xba, 0xb9,
-	0xde, 0x28, 0x2b, 0x1d, 0xed, 0xfe, 0x6f, 0x68, 0x32, 0x4e, 0xf8, 0xe8, 0x7b, 0xc9, 0x86, 0xfa,
-	0x03, 0xda, 0xa8, 0x28, 0x85, 0xbe, 0xda, 0xd4, 0xf1, 0xa7, 0x99, 0x8d, 0x3f, 0xa9, 0x12, 0x32,
-	0x3c, 0x36, 0x0f, 0x5f, 0x6d, 0xca, 0x19, 0x93, 0x9b, 0xef, 0x0f, 0xec, 0xe6, 0xfb, 0x03, 0x8a,
-	0x1e, 0x40, 0x4d, 0x87, 0xb8, 0x7b, 0x78, 0xbb, 0x31, 0x37, 0x8b, 0xd5, 0x5e, 0x94, 0xb2, 0x3a,
-	0x09, 0x26, 0x4e, 0xe1, 0xd1, 0xd7, 0xa1, 0xae, 0x6c, 0xca, 0xd8, 0x46, 0x55, 0xad, 0xfb, 0x73,
-	0x46, 0xbd, 0xfa, 0x5a, 0xca, 0xc2, 0xd9, 0x71, 0xe8, 0x67, 0x0e, 0x00, 0x7d, 0x24, 0x68, 0x28,
-	0xcf, 0x86, 0x37, 0x6a, 0x57, 0x8a, 0x57, 0xeb, 0xd7, 0x77, 0x67, 0x63, 0xf6, 0xcd, 0x0d, 0x0b,
-	0xbc, 0x11, 0x0a, 0x36, 0x6a, 0x23, 0xa3, 0x0e, 0xa4, 0x0c, 0x9c, 0x91, 0xbe, 0xf8, 0x3a, 0x5c,
-	0x9e, 0x98, 0x82, 0x16, 0xa0, 0x78, 0x40, 0x47, 0xda, 0xd4, 0xb1, 0xfc, 0x89, 0x3e, 0x9f, 0xf8,
-	0x1e, 0x65, 0xc6, 0xc6, 0xd9, 0x7c, 0xab, 0xf0, 0x9a, 0xe3, 0xfe, 0xc6, 0x31, 0xb7, 0xe5, 0x1d,
-	0x46, 0xe2, 0x98, 0x32, 0xd4, 0x85, 0xb2, 0xd2, 0xd7, 0xdc, 0xe6, 0xef, 0xe4, 0x5c, 0x56, 0xea,
-	0xad, 0xd4, 0x27, 0xd6, 0xe0, 0xd2, 0xb9, 0x72, 0x4a, 0xf5, 0xb5, 0xaa, 0xa6, 0xce, 0xb5, 0x43,
-	0x69, 0x88, 0x15, 0xc7, 0x7d, 0x05, 0xe6, 0xb3, 0xe1, 0xfb, 0xd9, 0xee, 0xd8, 0xfd, 0xd0, 0x81,
-	0x85, 0x37, 0x59, 0x34, 0x8c, 0xcd, 0xad, 0xd9, 0xf2, 0xc3, 0xae, 0xf4, 0x9d, 0x3d, 0x49, 0x9b,
-	0xf4, 0x9d, 0x6a, 0x20, 0xd6, 0x3c, 0x69, 0xfb, 0x87, 0x63, 0xf7, 0xdc, 0xda, 0x7e, 0x72, 0x29,
-	0x13, 0xbe, 0x54, 0xe3, 0xc0, 0x0f, 0xbb, 0xe6, 0x1e, 0x5b, 0x35, 0xa4, 0x2c, 0xac, 0x38, 0xee,
-	0xaf, 0x1d, 0x48, 0xfc, 0xa5, 0x1c, 0xbd, 0x17, 0x75, 0x47, 0x93, 0x4a, 0xb7, 0xa3, 0xee, 0x08,
-	0x2b, 0x8e, 0x4c, 0x08, 0xb8, 0x0a, 0xe4, 0xc6, 0x6b, 0xcf, 0x30, 0x21, 0xd0, 0xdf, 0xd8, 0xe0,
-	0xbb, 0x7f, 0x2d, 0x01, 0xdc, 0x8e, 0xba, 0xb4, 0x23, 0x88, 0x18, 0x72, 0xb4, 0x08, 0x05, 0xbf,
-	0x6b, 0x14, 0x03, 0x33, 0xa5, 0xb0, 0xb9, 0x8e, 0x0b, 0x7e, 0x57, 0xaa, 0x1d, 0x92, 0x41, 0x12,
-	0xd8, 0xac, 0xda, 0xb7, 0xc9, 0x80, 0x62, 0xc5, 0x91, 0x37, 0xa7, 0xeb, 0xf3, 0x38, 0x20, 0x23,
-	0x49, 0x34, 0xbb, 0x61, 0x6f, 0xce, 0x7a, 0xca, 0xc2, 0xd9, 0x71, 0x36, 0x62, 0x96, 0x4e, 0x8e,
-	0x98, 0x52, 0xbd, 0x4c, 0xc4, 0x7c, 0x05, 0xca, 0x71, 0x9f, 0x70, 0x6a, 0x1c, 0x52, 0xe2, 0x34,
-	0xcb, 0x3b, 0x92, 0xf8, 0xf4, 0x68, 0xb9, 0x26, 0xc7, 0xab, 0x0f, 0xac, 0x07, 0x4a, 0xcf, 0xc4,
-	0x05, 0x61, 0x82, 0x76, 0x57, 0x45, 0x1e, 0xcf, 0xd4, 0x49, 0x40, 0x70, 0x8a, 0x87, 0x88, 0xf4,
-	0x16, 0x83, 0x38, 0xa0, 0x1a, 0x7e, 0xee, 0xcc, 0xf0, 0x19, 0xcf, 0x62, 0x61, 0x70, 0x16, 0x53,
-	0x1a, 0x62, 0x12, 0xc4, 0xab, 0xe3, 0x86, 0x38, 0x19, 0x81, 0xd1, 0x08, 0xea, 0x01, 0x11, 0x94,
-	0x0b, 0x75, 0xaf, 0x1a, 0xb5, 0x99, 0xc4, 0x5e, 0xe3, 0x04, 0xda, 0x97, 0xa5, 0x96, 0xdb, 0x29,
-	0x3c, 0xce, 0xca, 0x72, 0x7f, 0x5f, 0x82, 0x4b, 0x98, 0xea, 0xb8, 0x61, 0x92, 0xa5, 0x2f, 0x43,
-	0x25, 0x66, 0x74, 0xdf, 0x7f, 0x64, 0x2c, 0xca, 0x1a, 0xe1, 0x8e, 0xa2, 0x62, 0xc3, 0x45, 0x3f,
-	0x84, 0x4a, 0x40, 0xf6, 0x68, 0xc0, 0x1b, 0x05, 0xe5, 0x35, 0xef, 0x9e, 0x5f, 0xe1, 0x71, 0x0d,
-	0x9a, 0xdb, 0x0a, 0x56, 0xfb, 0x4c, 0x2b, 0x5d, 0x13, 0xb1, 0x91, 0x29, 0x73, 0xe2, 0x3a, 0x09,
-	0xc3, 0x48, 0xa8, 0xec, 0x8a, 0xab, 0x9c, 0xb0, 0x7e, 0xfd, 0xbb, 0x33, 0xd3, 0x61, 0x35, 0xc5,
-	0xd6, 0x8a, 0xd8, 0x13, 0xcf, 0x70, 0x70, 0x56, 0x05, 0x69, 0xb1, 0x1e, 0xa3, 0xb2, 0x26, 0x6b,
-	0x8f, 0x4c, 0x70, 0x3f, 0x97, 0xc5, 0xae, 0x25, 0x20, 0x38, 0xc5, 0x5b, 0xfc, 0x26, 0xd4, 0x33,
-	0xdb, 0x72, 0x96, 0xb8, 0xb0, 0xf8, 0x6d, 0x58, 0x98, 0x5c, 0xcd, 0x99, 0xe2, 0xca, 0x4f, 0xcb,
-	0xa9, 0x8d, 0xbc, 0xbd, 0xf7, 0x80, 0x7a, 0x2a, 0x0f, 0x93, 0xbe, 0x83, 0xc7, 0xc4, 0x9b, 0xca,
-	0xc3, 0x6e, 0x27, 0x0c, 0x9c, 0x8e, 0xc9, 0x18, 0x4b, 0x71, 0x56, 0xc6, 0xa2, 0x55, 0x79, 0x2e,
-	0x63, 0xf9, 0x31, 0x40, 0x4c, 0x18, 0x19, 0x50, 0x41, 0x19, 0x6f, 0x94, 0x94, 0x06, 0x5b, 0xf9,
-	0x35, 0xd8, 0x49, 0x30, 0xd3, 0xc8, 0x6e, 0x49, 0x1c, 0x67, 0x44, 0xaa, 0x0a, 0xae, 0x37, 0x11,
-	0xcf, 0x94, 0x2b, 0xcc, 0x55, 0xc1, 0x4d, 0x46, 0xc8, 0x34, 0xa7, 0x9d, 0xe4, 0xe0, 0x29, 0xe9,
-	0x88, 0xd9, 0x3c, 0xb4, 0x32, 0xf3, 0x4a, 0x32, 0x8d, 0x5b, 0x63, 0x89, 0x69, 0x0e, 0x23, 0x76,
-	0x3f, 0x76, 0xe0, 0x85, 0xa9, 0x7d, 0x47, 0x01, 0x14, 0x39, 0xf3, 0x4c, 0x7e, 0x73, 0x67, 0x86,
-	0x27, 0xaa, 0x15, 0xd7, 0x4d, 0x80, 0x0e, 0xf3, 0xb0, 0x14, 0x23, 0x63, 0x69, 0x97, 0x72, 0x31,
-	0x19, 0x4b, 0xd7, 0x29, 0x17, 0x58, 0x71, 0x64, 0xde, 0xf2, 0x85, 0x53, 0xb0, 0xa4, 0x5f, 0xe5,
-	0xaa, 0x52, 0x9e, 0xf4, 0xab, 0xba, 0x7e, 0xc6, 0x86, 0x6b, 0xb3, 0xa3, 0xc2, 0xa9, 0xc5, 0xea,
-	0xf2, 0x78, 0xf9, 0x59, 0x9b, 0x2a, 0x3d, 0xff, 0x5c, 0x48, 0x6f, 0xac, 0xa9, 0xce, 0xcf, 0x7c,
-	0x63, 0x03, 0xa8, 0xec, 0x2b, 0x57, 0x68, 0xb2, 0x99, 0x9b, 0xb3, 0x72, 0xad, 0xba, 0x64, 0xd1,
-	0xbf, 0xb1, 0x91, 0x71, 0xf2, 0x05, 0x29, 0xfe, 0x3f, 0x2f, 0x88, 0x7b, 0x19, 0x2e, 0x62, 0x2a,
-	0xd8, 0xa8, 0x23, 0x18, 0x11, 0xb4, 0x37, 0x72, 0xff, 0x51, 0x00, 0x48, 0x5b, 0x61, 0xe8, 0xc5,
-	0x8c, 0xf5, 0xb6, 0xeb, 0x06, 0xb8, 0xb8, 0x45, 0x47, 0xda, 0x94, 0x77, 0x93, 0xe4, 0x5b, 0x9f,
-	0xe3, 0x1b, 0x63, 0xb9, 0xf3, 0xd3, 0xa3, 0xe5, 0x56, 0xa6, 0xaf, 0x39, 0xf0, 0x43, 0x3f, 0xd2,
-	0x7f, 0x5f, 0xee, 0x45, 0xcd, 0xdb, 0x91, 0xf0, 0xf7, 0x7d, 0x7d, 0x97, 0xd2, 0xaa, 0xd6, 0xa4,
-	0xdb, 0xfb, 0xf6, 0x5c, 0xf4, 0xf6, 0xb4, 0xf3, 0xf4, 0xf5, 0xfe, 0xc7, 0x89, 0xc4, 0x50, 0xe5,
-	0x2b, 0xed, 0xa1, 0x77, 0x40, 0x85, 0x09, 0x66, 0xb9, 0x24, 0x69, 0xa4, 0x4c, 0x4b, 0xc8, 0x50,
-	0xb0, 0x95, 0xe2, 0xfe, 0xbb, 0x00, 0x96, 0x8c, 0xae, 0x41, 0x95, 0x86, 0xdd, 0x38, 0xf2, 0x4d,
-	0xf9, 0x92, 0xe9, 0x0f, 0x6d, 0x18, 0x3a, 0xb6, 0x23, 0xe4, 0xdd, 0xda, 0xd3, 0xaa, 0x16, 0xc6,
-	0xef, 0x96, 0x11, 0x62, 0xb8, 0x72, 0x1c, 0xa3, 0xbd, 0xb4, 0x78, 0xb7, 0xe3, 0xb0, 0xa2, 0x62,
-	0xc3, 0xd5, 0xdd, 0x29, 0x4e, 0xbd, 0x21, 0xd3, 0x09, 0x6e, 0x35, 0xdb, 0x9d, 0xd2, 0x74, 0x6c,
-	0x47, 0xa0, 0x5d, 0xa8, 0x11, 0xcf, 0xa3, 0x9c, 0x6f, 0xd1, 0x91, 0xf1, 0xea, 0x5f, 0xca, 0x04,
-	0xfe, 0xa6, 0x17, 0x31, 0x2a, 0xc3, 0x7c, 0x87, 0x7a, 0x8c, 0x8a, 0x2d, 0x3a, 0xea, 0xd0, 0x80,
-	0x7a, 0x22, 0x62, 0xe9, 0x15, 0x5c, 0x4d, 0xe6, 0xe3, 0x14, 0x4a, 0xe2, 0xf2, 0x64, 0x8a, 0xf1,
-	0xd2, 0x67, 0xc5, 0xb5, 0x2c, 0x9c, 0x42, 0xb9, 0xf7, 0xe5, 0x3e, 0x9f, 0x31, 0xdb, 0x93, 0xde,
-	0x6b, 0xb8, 0x2f, 0xc7, 0x4d, 0xec, 0x70, 0x47, 0x51, 0xb1, 0xe1, 0x4a, 0xd7, 0x53, 0xe9, 0xa8,
-	0xd3, 0x47, 0xef, 0x41, 0x55, 0x26, 0x38, 0xaa, 0xbf, 0xa3, 0x3d, 0xf4, 0x2b, 0xcf, 0x97, 0x0e,
-	0xe9, 0xc8, 0x7e, 0x8b, 0x0a, 0x92, 0x06, 0xd6, 0x94, 0x86, 0x2d, 0x2a, 0xda, 0x87, 0x12, 0x8f,
-	0xa9, 0x67, 0x3c, 0x54, 0x9e, 0x0e, 0xb7, 0xfa, 0xee, 0xc4, 0xd4, 0xcb, 0x14, 0xb0, 0x31, 0xf5,
-	0xb0, 0xc2, 0x47, 0xa1, 0xac, 0xec, 0x64, 0xa9, 0x95, 0xbf, 0x8f, 0x6d, 0x24, 0x29, 0xb4, 0x6c,
-	0x7d, 0x27, 0xbf, 0xb1, 0x91, 0xe2, 0xfe, 0xcd, 0x01, 0xd0, 0x03, 0xb7, 0x7d, 0x2e, 0xd0, 0xbb,
-	0x53, 0x1b, 0xd9, 0x7c, 0xbe, 0x8d, 0x94, 0xb3, 0xd5, 0x36, 0x5a, 0xeb, 0x4d, 0x28, 0x99, 0x4d,
-	0xa4, 0x50, 0xf6, 0x05, 0x1d, 0x24, 0x69, 0xfc, 0x1b, 0x79, 0xd7, 0x96, 0x16, 0xe6, 0x9b, 0x12,
-	0x16, 0x6b, 0x74, 0xf7, 0x97, 0xc5, 0x64, 0x4d, 0x72, 0x63, 0xd1, 0x01, 0xcc, 0xe9, 0x78, 0xc7,
-	0x1b, 0x4e, 0x6e, 0xb9, 0x0a, 0x28, 0x2d, 0xb0, 0xf4, 0x37, 0xc7, 0x89, 0x04, 0x14, 0x41, 0x55,
-	0x30, 0xbf, 0xd7, 0x93, 0xd9, 0x9f, 0x5e, 0x65, 0x8e, 0x8e, 0xea, 0x5d, 0x8d, 0x94, 0xee, 0xa9,
-	0x21, 0x70, 0x6c, 0x85, 0xa0, 0x1f, 0x00, 0x50, 0xdb, 0xfa, 0xcd, 0x1f, 0xc7, 0x26, 0xdb, 0xc8,
-	0xba, 0xf7, 0x9d, 0x52, 0x71, 0x46, 0x9a, 0xf6, 0x71, 0x31, 0x25, 0xc2, 0x78, 0xae, 0x8c, 0x8f,
-	0x93, 0x54, 0x6c, 0xb8, 0xee, 0xc7, 0x25, 0x98, 0xcf, 0x5a, 0x63, 0x5a, 0xa3, 0x3b, 0xe7, 0xaa,
-	0xd1, 0x0b, 0x9f, 0x6d, 0x8d, 0x5e, 0xfc, 0x6c, 0x6b, 0xf4, 0xd2, 0x33, 0x6a, 0xf4, 0x43, 0x28,
-	0x87, 0x51, 0x97, 0xf2, 0x46, 0x59, 0xd9, 0xcf, 0x9d, 0xd9, 0x78, 0x80, 0xa6, 0xdc, 0x52, 0x53,
-	0xbc, 0xd8, 0x6b, 0xa3, 0x68, 0x58, 0x8b, 0x5b, 0xfc, 0x91, 0xee, 0xf4, 0x9c, 0x9a, 0x31, 0xdf,
-	0xcf, 0x66, 0xcc, 0xb9, 0x7c, 0x60, 0xda, 0x50, 0xca, 0xe6, 0xdd, 0xff, 0x29, 0x83, 0x49, 0x50,
-	0x6d, 0x2b, 0xc9, 0x39, 0xb5, 0x95, 0x74, 0x0d, 0xaa, 0x5d, 0x4a, 0xba, 0xf6, 0x4d, 0xb0, 0x98,
-	0x5e, 0x92, 0x75, 0x43, 0xc7, 0x76, 0x04, 0xea, 0xda, 0x7e, 0x59, 0x71, 0x46, 0xfd, 0x32, 0x98,
-	0xee, 0x95, 0x21, 0x06, 0xd5, 0xe4, 0xf5, 0xca, 0xe4, 0x31, 0x37, 0xf3, 0x57, 0x3a, 0xc6, 0xe3,
-	0xcc, 0xcb, 0x95, 0x25, 0x34, 0x6c, 0xe5, 0x48, 0x99, 0x9e, 0x79, 0x1c, 0x33, 0xf9, 0x40, 0x0e,
-	0x99, 0xe3, 0xcf, 0x6c, 0x5a, 0x66, 0x42, 0xc3, 0x56, 0x8e, 0x94, 0xc9, 0xe8, 0x58, 0x45, 0x37,
-	0x83, 0x8c, 0x3d, 0x2b, 0x33, 0xa1, 0x61, 0x2b, 0x07, 0x85, 0x30, 0xf7, 0x3e, 0xdd, 0xeb, 0x47,
-	0xd1, 0x81, 0x69, 0xa1, 0xbd, 0x79, 0x7e, 0x91, 0xef, 0x68, 0x20, 0x23, 0xb1, 0x2e, 0x2f, 0xa1,
-	0x21, 0xe1, 0x44, 0x08, 0x7a, 0x08, 0x73, 0x3a, 0x3b, 0xe5, 0xaa, 0xa7, 0x96, 0x2f, 0x10, 0x2b,
-	0x41, 0x26, 0x01, 0xb6, 0xf7, 0x5e, 0x7f, 0x73, 0x9c, 0xc8, 0x71, 0xff, 0x52, 0x80, 0xf9, 0xec,
-	0x50, 0xb4, 0x07, 0x25, 0xe1, 0x9b, 0x5b, 0x90, 0xeb, 0xbe, 0x49, 0x1f, 0x65, 0xc4, 0xab, 0x77,
-	0x2f, 0xf5, 0x7a, 0xa2, 0xb0, 0xd1, 0x20, 0x7d, 0x88, 0x2b, 0xcc, 0xf4, 0x21, 0xae, 0x7e, 0xe2,
-	0x23, 0xdc, 0x9e, 0x79, 0x84, 0xd3, 0xad, 0x99, 0x1c, 0x4b, 0x4a, 0x9f, 0x5c, 0xa7, 0x9e, 0xf2,
-	0x7e, 0x25, 0xf3, 0x42, 0x7d, 0x23, 0xaf, 0x98, 0xce, 0xf1, 0x84, 0x1f, 0xc9, 0x74, 0x8b, 0x5f,
-	0xd4, 0xef, 0xf9, 0x85, 0xf1, 0xd2, 0x2a, 0x79, 0x8c, 0x47, 0x1f, 0x3a, 0x00, 0x44, 0x08, 0xe6,
-	0xef, 0x0d, 0x05, 0x4d, 0x3a, 0x4a, 0x3b, 0x79, 0xbd, 0x47, 0x73, 0xd5, 0x42, 0x4e, 0x3c, 0xd7,
-	0xa4, 0x0c, 0x9c, 0x91, 0xbb, 0xf8, 0x3a, 0x5c, 0x9e, 0x98, 0x72, 0xd6, 0x8e, 0x06, 0xa4, 0x36,
-	0x80, 0xb6, 0xa0, 0xac, 0x62, 0x9f, 0x31, 0xac, 0xb3, 0x04, 0x3a, 0xd5, 0x00, 0x50, 0x31, 0x14,
-	0x6b, 0x0c, 0x74, 0x13, 0x4a, 0x5c, 0x44, 0xf1, 0x39, 0x62, 0xb2, 0x3a, 0xb7, 0x8e, 0x88, 0x62,
-	0xac, 0x10, 0xdc, 0x9f, 0x17, 0x61, 0xce, 0x24, 0x38, 0xcf, 0x11, 0x00, 0xb2, 0x4e, 0x68, 0x66,
-	0x6d, 0x03, 0x9d, 0xfa, 0x9f, 0xea, 0x84, 0xfa, 0x69, 0x10, 0x2f, 0xce, 0xea, 0xb5, 0xbc, 0x7e,
-	0x62, 0x0e, 0xf0, 0x81, 0x03, 0x17, 0x19, 0x8d, 0x03, 0xdb, 0x12, 0x30, 0x01, 0xe5, 0xcd, 0x3c,
-	0x6b, 0xcc, 0x74, 0x18, 0xda, 0x2f, 0x1c, 0x1f, 0x2d, 0x8f, 0x37, 0x1d, 0xf0, 0xb8, 0x40, 0xf7,
-	0x4f, 0x05, 0x28, 0xde, 0xc3, 0x9b, 0xaa, 0x1c, 0xf3, 0xfa, 0xd4, 0x1e, 0x46, 0x5a, 0x49, 0x28,
-	0x2a, 0x36, 0x5c, 0x79, 0x64, 0x43, 0x6e, 0x7a, 0x38, 0x99, 0x23, 0xbb, 0xc7, 0x29, 0xc3, 0x8a,
-	0x23, 0x63, 0x76, 0x4c, 0x38, 0x7f, 0x3f, 0x62, 0xc9, 0x4b, 0x98, 0x8d, 0xd9, 0x3b, 0x86, 0x8e,
-	0xed, 0x08, 0x89, 0xd7, 0x8f, 0xb8, 0x30, 0xe9, 0x92, 0xc5, 0xbb, 0x19, 0x71, 0x81, 0x15, 0x47,
-	0xb5, 0xaf, 0x22, 0x26, 0x54, 0xdc, 0x2b, 0x67, 0xda, 0x57, 0x11, 0x13, 0x58, 0x71, 0x6c, 0x83,
-	0xab, 0x72, 0x6a, 0x83, 0xeb, 0x25, 0x28, 0x3f, 0x1c, 0x52, 0x36, 0x52, 0x51, 0x25, 0xf3, 0xd2,
-	0x77, 0x47, 0x12, 0xb1, 0xe6, 0x49, 0xc5, 0xf7, 0x19, 0xe9, 0x0d, 0x68, 0x28, 0xcc, 0x0b, 0x8b,
-	0x55, 0xfc, 0x86, 0xa1, 0x63, 0x3b, 0xc2, 0xf5, 0xa0, 0x9e, 0xf9, 0xef, 0x9e, 0xe7, 0xf8, 0x8f,
-	0x90, 0xeb, 0x00, 0x87, 0x94, 0xf9, 0xfb, 0x23, 0x8f, 0x32, 0x61, 0x1e, 0x37, 0xad, 0x47, 0xd8,
-	0x55, 0x9c, 0x35, 0xca, 0x04, 0xce, 0x8c, 0x72, 0x29, 0x5c, 0x1c, 0x0b, 0x63, 0x67, 0xef, 0x62,
-	0x0c, 0xa8, 0xe8, 0x47, 0xdd, 0xc9, 0x1a, 0xfb, 0x96, 0xa2, 0x62, 0xc3, 0x6d, 0x37, 0x1f, 0x3f,
-	0x59, 0xba, 0xf0, 0xc9, 0x93, 0xa5, 0x0b, 0x9f, 0x3e, 0x59, 0xba, 0xf0, 0xc1, 0xf1, 0x92, 0xf3,
-	0xf8, 0x78, 0xc9, 0xf9, 0xe4, 0x78, 0xc9, 0xf9, 0xf4, 0x78, 0xc9, 0xf9, 0xe7, 0xf1, 0x92, 0xf3,
-	0xd1, 0xbf, 0x96, 0x2e, 0xdc, 0xaf, 0x26, 0x46, 0xf6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1,
-	0x88, 0xc8, 0x89, 0xc7, 0x27, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1/generated.proto", fileDescriptor_generated_eecc52000541b0f8)
+}
+
+var fileDescriptor_generated_eecc52000541b0f8 = []byte{
+	// 5164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0xdd, 0x6f, 0x1c, 0xd7,
+	0x75, 0xb8, 0x67, 0x97, 0xbb, 0xdc, 0x3d, 0x4b, 0x49, 0xe4, 0x95, 0x9c, 0x4c, 0xf4, 0x8b, 0x45,
+	0x61, 0x8c, 0xf8, 0x67, 0xb7, 0xf6, 0xd2, 0x96, 0x9a, 0xd4, 0x4d, 0x61, 0x47, 0xdc, 0x15, 0x29,
+	0xd1, 0xa2, 0x24, 0xfa, 0x2c, 0x25, 0xa5, 0xae, 0xdb, 0x7a, 0xb8, 0x7b, 0xb9, 0x1c, 0x6b, 0x76,
+	0x66, 0x32, 0x73, 0x97, 0x12, 0x83, 0xd8, 0x75, 0x82, 0x00, 0x01, 0xea, 0x20, 0x71, 0x1f, 0x5a,
+	0x14, 0x7d, 0x4d, 0x1a, 0xa0, 0xe8, 0x53, 0x03, 0xb4, 0x40, 0xdf, 0x8a, 0x02, 0x05, 0xfc, 0x98,
+	0x02, 0x7d, 0x48, 0x81, 0x54, 0xa8, 0x55, 0x20, 0xe8, 0x7f, 0x50, 0x40, 0x2f, 0x2d, 0xee, 0xe7,
+	0x7c, 0xec, 0x32, 0x22, 0x39, 0x1b, 0xf4, 0x45, 0xe2, 0x9c, 0x73, 0xee, 0x39, 0x77, 0xee, 0x3d,
+	0xf7, 0xdc, 0xf3, 0x35, 0x0b, 0xd7, 0x87, 0x1e, 0xdb, 0x1b, 0xef, 0xb4, 0xfb, 0xe1, 0x68, 0xc5,
+	0x8d, 0x87, 0x61, 0x14, 0x87, 0xef, 0x8b, 0x3f, 0x5e, 0xa1, 0xfb, 0x34, 0x60, 0xc9, 0x4a, 0x74,
+	0x7f, 0xb8, 0xe2, 0x46, 0x5e, 0xb2, 0x92, 0xd0, 0x20, 0x09, 0xe3, 0x95, 0xfd, 0xd7, 0x5c, 0x3f,
+	0xda, 0x73, 0x5f, 0x5b, 0x19, 0xd2, 0x80, 0xc6, 0x2e, 0xa3, 0x83, 0x76, 0x14, 0x87, 0x2c, 0x24,
+	0xaf, 0xa7, 0x9c, 0xda, 0x9a, 0x93, 0xf8, 0xe3, 0x8f, 0x24, 0xa7, 0x76, 0x74, 0x7f, 0xd8, 0xe6,
+	0x9c, 0xda, 0x92, 0x53, 0x5b, 0x73, 0x3a, 0xff, 0x4a, 0x66, 0x0e, 0xc3, 0x70, 0x18, 0xae, 0x08,
+	0x86, 0x3b, 0xe3, 0x5d, 0xf1, 0x24, 0x1e, 0xc4, 0x5f, 0x52, 0xd0, 0x79, 0xe7, 0xfe, 0xeb, 0x49,
+	0xdb, 0x0b, 0xf9, 0xac, 0x56, 0xfa, 0x61, 0x4c, 0x57, 0xf6, 0x27, 0x26, 0x73, 0xfe, 0xb7, 0x52,
+	0x9a, 0x91, 0xdb, 0xdf, 0xf3, 0x02, 0x1a, 0x1f, 0xa4, 0xaf, 0x32, 0xa2, 0xcc, 0x9d, 0x36, 0x6a,
+	0xe5, 0xb0, 0x51, 0xf1, 0x38, 0x60, 0xde, 0x88, 0x4e, 0x0c, 0xf8, 0xca, 0xd3, 0x06, 0x24, 0xfd,
+	0x3d, 0x3a, 0x72, 0x27, 0xc6, 0x5d, 0x3e, 0x6c, 0xdc, 0x98, 0x79, 0xfe, 0x8a, 0x17, 0xb0, 0x84,
+	0xc5, 0xc5, 0x41, 0xce, 0xc7, 0x55, 0x68, 0xae, 0x0e, 0x87, 0x31, 0x1d, 0xba, 0x8c, 0x92, 0xe7,
+	0xa1, 0xb6, 0xef, 0xfa, 0x63, 0x6a, 0x5b, 0x17, 0xad, 0x17, 0x9b, 0x9d, 0x53, 0x9f, 0x3e, 0x5a,
+	0x7e, 0xe6, 0xf1, 0xa3, 0xe5, 0xda, 0x5d, 0x0e, 0x44, 0x89, 0xe3, 0x44, 0xfd, 0x70, 0x1c, 0x30,
+	0xbb, 0x72, 0xd1, 0x7a, 0xb1, 0x96, 0x12, 0x75, 0x39, 0x10, 0x25, 0x8e, 0xb8, 0xd0, 0x7a, 0xe0,
+	0x05, 0x83, 0xf0, 0x41, 0x8f, 0xb9, 0x31, 0xb3, 0xab, 0x17, 0xad, 0x17, 0x5b, 0x97, 0x7e, 0xa3,
+	0x2d, 0xa7, 0xd8, 0xce, 0x4e, 0x31, 0xdd, 0x42, 0xbe, 0x82, 0xed, 0xfd, 0xd7, 0xda, 0xdb, 0xde,
+	0x88, 0x76, 0xce, 0x2a, 0xb6, 0xad, 0x7b, 0x29, 0x1b, 0xcc, 0xf2, 0x24, 0xbf, 0x0f, 0x4d, 0xf9,
+	0xb8, 0x16, 0x0c, 0xec, 0xb9, 0x63, 0x0b, 0x58, 0x52, 0x02, 0x9a, 0xf7, 0x34, 0x13, 0x4c, 0xf9,
+	0x11, 0x06, 0xf3, 0x89, 0x3b, 0x8a, 0x7c, 0x9a, 0xd8, 0xb5, 0x8b, 0xd5, 0x17, 0x5b, 0x97, 0x36,
+	0xda, 0x27, 0x55, 0xc5, 0xb6, 0x59, 0xdf, 0x9e, 0xe0, 0xd8, 0x39, 0xa3, 0x24, 0xcf, 0xcb, 0xe7,
+	0x04, 0xb5, 0x28, 0xe7, 0xaf, 0x2d, 0x38, 0x53, 0xa0, 0x26, 0x9b, 0x30, 0xc7, 0x77, 0xdd, 0xb6,
+	0x8e, 0xfd, 0x86, 0x0b, 0x4a, 0xce, 0x1c, 0x7f, 0x42, 0xc1, 0x25, 0xdd, 0xe1, 0xca, 0x51, 0x76,
+	0xb8, 0x7a, 0xf8, 0x0e, 0x3b, 0xff, 0x5a, 0x81, 0x96, 0x9e, 0xab, 0x17, 0x06, 0xa4, 0x0b, 0x8d,
+	0xdd, 0x71, 0xd0, 0xe7, 0x7f, 0x2b, 0xf5, 0xf9, 0xff, 0x6a, 0x5c, 0x63, 0x5d, 0xc1, 0x9f, 0x3c,
+	0x5a, 0x3e, 0x9b, 0x19, 0xa2, 0xc1, 0x68, 0x06, 0x92, 0x8b, 0x30, 0x17, 0xb9, 0x6c, 0x4f, 0xcd,
+	0xce, 0xbc, 0xc0, 0x96, 0xcb, 0xf6, 0x50, 0x60, 0xc8, 0x0b, 0x50, 0x97, 0xbb, 0x24, 0x26, 0xd7,
+	0xec, 0x9c, 0x56, 0x34, 0x75, 0xb9, 0x8d, 0xa8, 0xb0, 0xe4, 0x0a, 0x80, 0xfc, 0x6b, 0xfb, 0x20,
+	0xa2, 0x42, 0x3d, 0x9a, 0x9d, 0x8b, 0x8a, 0x16, 0xee, 0x19, 0xcc, 0x93, 0xdc, 0x13, 0x66, 0xc6,
+	0xf0, 0x17, 0x0a, 0x23, 0x7e, 0x58, 0xc2, 0xd8, 0xae, 0xe5, 0x5f, 0xe8, 0xb6, 0x82, 0x17, 0x5e,
+	0x48, 0x83, 0xd1, 0x0c, 0x24, 0x2b, 0xd0, 0x64, 0x7b, 0x31, 0x4d, 0xf6, 0x42, 0x7f, 0x60, 0xd7,
+	0x05, 0x17, 0xa3, 0x78, 0xdb, 0x1a, 0x81, 0x29, 0x8d, 0xf3, 0x51, 0x05, 0xc8, 0xaa, 0x4f, 0x63,
+	0x36, 0x72, 0x03, 0x77, 0x48, 0xe3, 0x9e, 0x37, 0x0c, 0x5c, 0x9f, 0xbc, 0x0c, 0x0d, 0x1a, 0x0c,
+	0xa2, 0xd0, 0x0b, 0x98, 0x5a, 0xdd, 0x45, 0x3d, 0x99, 0x35, 0x05, 0x47, 0x43, 0x41, 0x3e, 0xb2,
+	0xa0, 0xee, 0xbb, 0x3b, 0xd4, 0x4f, 0xec, 0x8a, 0xd0, 0xde, 0xaf, 0x97, 0xd0, 0xde, 0x89, 0xc9,
+	0xb4, 0x37, 0x05, 0xeb, 0xb5, 0x80, 0xc5, 0x07, 0xe9, 0xfa, 0x4b, 0x20, 0x2a, 0xb9, 0xe7, 0x7f,
+	0x07, 0x5a, 0x19, 0x32, 0xb2, 0x08, 0xd5, 0xfb, 0xf4, 0x40, 0x4e, 0x1d, 0xf9, 0x9f, 0xe4, 0x5c,
+	0x4e, 0x13, 0x95, 0xea, 0x7d, 0xb5, 0xf2, 0xba, 0xe5, 0xfc, 0xa2, 0x02, 0x8b, 0xab, 0x31, 0xf3,
+	0x76, 0xdd, 0x3e, 0xdb, 0x0c, 0xfb, 0x52, 0xbd, 0xde, 0x85, 0x4a, 0x72, 0x59, 0x1d, 0x82, 0xab,
+	0x27, 0x7f, 0x9b, 0xde, 0x65, 0xcd, 0xb9, 0x53, 0x7f, 0xfc, 0x68, 0xb9, 0xd2, 0xbb, 0x8c, 0x95,
+	0xe4, 0x32, 0x71, 0xa0, 0xee, 0x05, 0xbe, 0x17, 0xe8, 0x73, 0x01, 0xfc, 0x8d, 0x36, 0x04, 0x04,
+	0x15, 0x86, 0x0c, 0x60, 0x6e, 0xd7, 0xf3, 0xa9, 0xb2, 0x65, 0xeb, 0x27, 0x9f, 0xc3, 0xba, 0xe7,
+	0x53, 0x33, 0x8b, 0x06, 0xd7, 0x6f, 0x0e, 0x41, 0xc1, 0x9d, 0xbc, 0x07, 0xd5, 0x71, 0xec, 0x2b,
+	0x7b, 0xb6, 0x76, 0x72, 0x21, 0x77, 0x70, 0xd3, 0xc8, 0x98, 0x7f, 0xfc, 0x68, 0xb9, 0x7a, 0x07,
+	0x37, 0x91, 0xb3, 0x76, 0xbe, 0x05, 0x0b, 0x1a, 0xb3, 0x15, 0xfa, 0x42, 0xb5, 0xbc, 0x80, 0xd1,
+	0x78, 0xdf, 0xf5, 0x8b, 0xaa, 0xb5, 0xa1, 0xe0, 0x68, 0x28, 0xc8, 0x9b, 0x70, 0xda, 0x0b, 0xfa,
+	0xfe, 0x78, 0x40, 0xbb, 0x61, 0xc0, 0xa8, 0xba, 0x06, 0x1a, 0x9d, 0xcf, 0xa9, 0x31, 0xa7, 0x37,
+	0x72, 0x58, 0x2c, 0x50, 0x3b, 0xff, 0x5d, 0x85, 0xd3, 0x5a, 0xbc, 0xd2, 0xed, 0x3d, 0xa8, 0x33,
+	0x37, 0x1e, 0x52, 0xa6, 0xb6, 0xf7, 0x4a, 0x89, 0xed, 0x65, 0x31, 0x75, 0x47, 0xa9, 0x52, 0x6e,
+	0x0b, 0xbe, 0xa8, 0xf8, 0x93, 0x4f, 0x2c, 0x58, 0x74, 0x0b, 0x9a, 0x25, 0xe6, 0xdf, 0xba, 0xf4,
+	0x56, 0x89, 0x13, 0x52, 0xe0, 0xd8, 0xb1, 0x95, 0xf8, 0x09, 0x2d, 0xc6, 0x09, 0xe9, 0xe4, 0x2b,
+	0x30, 0x37, 0x0a, 0x07, 0x54, 0x59, 0x33, 0x47, 0x5b, 0xbc, 0x9b, 0xe1, 0x80, 0xdb, 0x26, 0x92,
+	0x5f, 0x2a, 0x0e, 0x45, 0x41, 0xcf, 0xb5, 0x31, 0x0a, 0x7d, 0xad, 0x28, 0xeb, 0xe5, 0x67, 0xcf,
+	0x75, 0x41, 0x6a, 0x23, 0xff, 0x0b, 0x05, 0x77, 0xf2, 0x16, 0x10, 0xa9, 0xfd, 0x6a, 0xfb, 0x36,
+	0xbd, 0x91, 0xc7, 0x84, 0x35, 0xac, 0x76, 0xce, 0xab, 0xb9, 0x92, 0x8d, 0x09, 0x0a, 0x9c, 0x32,
+	0xca, 0xf9, 0x69, 0x15, 0x4e, 0x77, 0x5d, 0x9f, 0x06, 0x03, 0x37, 0x63, 0xd5, 0xb8, 0x33, 0x33,
+	0x18, 0xfb, 0xb4, 0xa8, 0x7a, 0x3d, 0x05, 0x47, 0x43, 0x91, 0x53, 0xd4, 0xca, 0x53, 0x15, 0xb5,
+	0x0d, 0x10, 0xd3, 0xfe, 0x38, 0x8e, 0x69, 0xd0, 0xe7, 0xcb, 0x5b, 0xe5, 0x97, 0x05, 0x37, 0xfe,
+	0x68, 0xa0, 0x98, 0xa1, 0xe0, 0xdc, 0xf9, 0x0d, 0xf9, 0xcd, 0x30, 0xd0, 0xd7, 0x85, 0xe1, 0xbe,
+	0xad, 0xe0, 0x68, 0x28, 0x48, 0x00, 0xf3, 0x7d, 0x97, 0xf5, 0xf7, 0xee, 0x44, 0x62, 0x35, 0x5a,
+	0x97, 0xae, 0x9d, 0x7c, 0x07, 0xba, 0x92, 0xd1, 0x56, 0xe8, 0x7b, 0xfd, 0x83, 0x4e, 0x8b, 0x7b,
+	0x06, 0x0a, 0x84, 0x5a, 0x08, 0xd9, 0x87, 0xa6, 0xd7, 0x57, 0x8b, 0x67, 0xcf, 0x5f, 0xb4, 0xca,
+	0x79, 0x24, 0x1b, 0x66, 0x1f, 0xc2, 0x71, 0xdc, 0xa7, 0x9d, 0x53, 0xfc, 0x3a, 0x32, 0x40, 0x4c,
+	0x45, 0x39, 0x14, 0x4e, 0xe5, 0xa6, 0x47, 0x56, 0x94, 0xbe, 0xca, 0xed, 0xfa, 0x7f, 0x05, 0x7d,
+	0x6d, 0x29, 0xe2, 0x8c, 0xa2, 0x3e, 0x0f, 0x35, 0x5f, 0x68, 0x4d, 0xc1, 0x5d, 0x94, 0x8a, 0x22,
+	0x71, 0xce, 0x2a, 0x2c, 0x75, 0xfd, 0x70, 0x3c, 0x58, 0x13, 0x13, 0x3f, 0xc9, 0x9d, 0xe7, 0x7c,
+	0xdb, 0x02, 0xb8, 0xea, 0x32, 0x77, 0xdd, 0xf3, 0x19, 0x8d, 0x8d, 0x27, 0x61, 0x1d, 0xea, 0x49,
+	0xbc, 0x0c, 0x73, 0x8c, 0xfb, 0x06, 0x52, 0x95, 0x6c, 0xe3, 0x2c, 0x49, 0xaf, 0xa0, 0xf1, 0x56,
+	0xef, 0xf6, 0x2d, 0xfe, 0x37, 0x0a, 0xaa, 0xd4, 0x71, 0xaa, 0x1e, 0xee, 0x38, 0x39, 0x7f, 0x65,
+	0xc1, 0xe2, 0x5a, 0xd2, 0x77, 0x7d, 0x71, 0xb6, 0xd5, 0x8a, 0xf1, 0x05, 0xa0, 0xfb, 0xd4, 0x2f,
+	0x3a, 0xd5, 0x9b, 0x1c, 0x88, 0x12, 0x47, 0x7c, 0x98, 0x1f, 0xd1, 0x24, 0x71, 0x87, 0x54, 0xd9,
+	0xa3, 0xd5, 0x93, 0xef, 0xee, 0x4d, 0xc9, 0x28, 0xf5, 0x33, 0x15, 0x00, 0xb5, 0x08, 0xe7, 0x2f,
+	0x2c, 0xa8, 0x89, 0xa5, 0x26, 0xdf, 0x80, 0xf9, 0x3e, 0x3f, 0xa4, 0x0f, 0xb5, 0xf1, 0x2d, 0x61,
+	0x49, 0x04, 0xc7, 0xae, 0xe4, 0x96, 0x0a, 0x57, 0x00, 0xd4, 0x72, 0xc8, 0x17, 0x61, 0x6e, 0xe0,
+	0x32, 0x57, 0xbc, 0xe7, 0x82, 0xb4, 0x38, 0x7c, 0xdf, 0x50, 0x40, 0x9d, 0xbf, 0xa9, 0xc3, 0x42,
+	0x96, 0x11, 0xf7, 0xa0, 0x84, 0x60, 0xe1, 0xc7, 0x59, 0x79, 0x0f, 0x6a, 0x4d, 0x23, 0x30, 0xa5,
+	0x21, 0x57, 0x61, 0xd1, 0x3c, 0xdc, 0xa5, 0x71, 0xa2, 0x6d, 0x7c, 0xba, 0xc7, 0x8b, 0x6b, 0x05,
+	0x3c, 0x4e, 0x8c, 0xe0, 0x96, 0xaf, 0x9f, 0x6a, 0xa4, 0xe6, 0x23, 0x37, 0xdf, 0x58, 0xbe, 0xee,
+	0x04, 0x05, 0x4e, 0x19, 0x45, 0x5c, 0xa8, 0x27, 0xe2, 0xa0, 0x29, 0x6b, 0xfd, 0x46, 0x99, 0x6b,
+	0x7d, 0x43, 0x3a, 0x27, 0xf2, 0xe4, 0xa2, 0x62, 0x4c, 0x5e, 0x82, 0x79, 0x31, 0x74, 0xe3, 0xaa,
+	0xf2, 0x55, 0xcd, 0xfa, 0xaf, 0x49, 0x30, 0x6a, 0x3c, 0x8f, 0x9b, 0xe4, 0xdb, 0xf2, 0xa8, 0xa2,
+	0x7e, 0xf2, 0xb8, 0x69, 0x4d, 0x33, 0xc1, 0x94, 0x1f, 0x79, 0x1f, 0x9a, 0x32, 0x3c, 0xbd, 0x83,
+	0x9b, 0xf6, 0xfc, 0x2c, 0xde, 0x56, 0xd8, 0xa6, 0x9e, 0xe6, 0x89, 0x29, 0x7b, 0xf2, 0x65, 0x68,
+	0xf5, 0xe5, 0x05, 0x23, 0x74, 0xa3, 0x21, 0xde, 0xdb, 0xc4, 0x8d, 0xdd, 0x14, 0x85, 0x59, 0x3a,
+	0xf2, 0x27, 0x16, 0x00, 0x7d, 0xc8, 0x68, 0xc0, 0xf7, 0x26, 0xb1, 0x9b, 0xc2, 0x41, 0xbe, 0x3b,
+	0x1b, 0xb5, 0x6f, 0xaf, 0x19, 0xc6, 0xd2, 0x3d, 0x26, 0x3a, 0xe4, 0x48, 0x11, 0x98, 0x91, 0x7e,
+	0xfe, 0x0d, 0x38, 0x53, 0x18, 0x72, 0x2c, 0x57, 0xf9, 0xcf, 0x2d, 0x75, 0x5a, 0xee, 0xc5, 0x6e,
+	0x14, 0xd1, 0x98, 0x0c, 0xa0, 0x26, 0xe6, 0xab, 0x4e, 0xf3, 0xd7, 0x4a, 0xbe, 0x56, 0x6a, 0xad,
+	0xc4, 0x23, 0x4a, 0xe6, 0xdc, 0xb8, 0x26, 0x94, 0x06, 0xca, 0xf5, 0x33, 0xc6, 0xb5, 0x47, 0x69,
+	0x80, 0x02, 0xe3, 0xbc, 0x0a, 0x0b, 0x59, 0x37, 0xf7, 0xe9, 0xe6, 0xd8, 0xf9, 0x5e, 0x05, 0x80,
+	0x0f, 0x51, 0xc6, 0x7f, 0x05, 0x9a, 0x03, 0x2f, 0xa6, 0x7d, 0x16, 0xc6, 0x07, 0xc5, 0x63, 0x7f,
+	0x55, 0x23, 0x30, 0xa5, 0xe1, 0x03, 0xc4, 0x6d, 0x9e, 0x78, 0xfb, 0x54, 0x4d, 0xcc, 0x0c, 0x40,
+	0x8d, 0xc0, 0x94, 0x86, 0x7c, 0x0d, 0x40, 0x86, 0x69, 0x42, 0x0d, 0xa4, 0x83, 0xb0, 0xcc, 0xb7,
+	0xea, 0xb6, 0x81, 0x3e, 0x79, 0xb4, 0x7c, 0x8a, 0xcf, 0xc9, 0x40, 0x30, 0x33, 0x84, 0xbc, 0x08,
+	0x8d, 0xc8, 0x65, 0x8c, 0xc6, 0x41, 0x62, 0xcf, 0x89, 0xe1, 0x0b, 0xfc, 0x6e, 0xda, 0x52, 0x30,
+	0x34, 0x58, 0x7e, 0x93, 0x0d, 0xe8, 0x4e, 0x38, 0xe6, 0x9e, 0x48, 0x2d, 0x7f, 0x93, 0x5d, 0x55,
+	0x70, 0x34, 0x14, 0xce, 0xbf, 0x59, 0x00, 0xd7, 0x70, 0xab, 0xab, 0x56, 0x62, 0x1d, 0x6a, 0x2c,
+	0xbc, 0x4f, 0x03, 0xb5, 0xa5, 0x5f, 0xca, 0x9c, 0xd5, 0x36, 0x4f, 0x55, 0xf1, 0x93, 0xd9, 0xa3,
+	0xfd, 0x98, 0xb2, 0x1b, 0xf4, 0xa0, 0x47, 0x7d, 0xb1, 0x1e, 0x9d, 0x26, 0xdf, 0xb4, 0x6d, 0x3e,
+	0x0e, 0xe5, 0x70, 0xd2, 0x85, 0xa5, 0xbe, 0xef, 0x09, 0x5d, 0x1d, 0x8d, 0xc2, 0xe0, 0x96, 0x3b,
+	0xa2, 0x32, 0x3c, 0x6c, 0x76, 0x9e, 0x7d, 0xfc, 0x68, 0x79, 0xa9, 0x5b, 0x44, 0xe2, 0x24, 0x3d,
+	0x77, 0xff, 0x5d, 0xdf, 0x0f, 0x1f, 0xac, 0x06, 0x61, 0x70, 0x30, 0x0a, 0xc7, 0x89, 0x5d, 0xcd,
+	0xbb, 0xff, 0xab, 0x39, 0x2c, 0x16, 0xa8, 0x9d, 0xbf, 0xb5, 0x60, 0xfe, 0x9a, 0xc7, 0x90, 0xee,
+	0x26, 0x64, 0x04, 0x73, 0x31, 0xdd, 0x4d, 0x6c, 0x4b, 0x9c, 0xc0, 0x1b, 0x27, 0x57, 0x55, 0xc5,
+	0xb0, 0xcd, 0xff, 0x91, 0xc7, 0xce, 0x28, 0x18, 0x07, 0xa1, 0x10, 0x73, 0xfe, 0xb7, 0xa1, 0x69,
+	0x08, 0x8e, 0x75, 0xc8, 0xfe, 0xb1, 0x0a, 0xcd, 0x6b, 0x9e, 0x8e, 0x56, 0x9e, 0x93, 0x01, 0x9a,
+	0x54, 0xc9, 0x96, 0x92, 0x63, 0xa2, 0x2b, 0x7e, 0xbb, 0x89, 0x97, 0x92, 0x0b, 0xdb, 0xc8, 0xcf,
+	0x21, 0xe7, 0xc2, 0x56, 0x9f, 0xea, 0xc2, 0xbe, 0x0c, 0x8d, 0x71, 0x42, 0xe3, 0xc0, 0x1d, 0x4d,
+	0xb8, 0xa4, 0x77, 0x14, 0x1c, 0x0d, 0x45, 0xaa, 0x27, 0xb5, 0x72, 0x7a, 0xb2, 0x01, 0xf5, 0x24,
+	0xd9, 0xbb, 0x41, 0x0f, 0xec, 0xfa, 0x71, 0x18, 0xc9, 0x5b, 0xa9, 0x77, 0xfd, 0x06, 0x3d, 0x40,
+	0xc5, 0x80, 0xf4, 0xe0, 0x59, 0x2f, 0x48, 0xf8, 0x89, 0xa3, 0x1b, 0xc3, 0x20, 0x8c, 0xe9, 0xf5,
+	0x30, 0xe1, 0x83, 0xc4, 0xcd, 0xd0, 0xe8, 0x3c, 0xa7, 0xde, 0xe6, 0xd9, 0x8d, 0x69, 0x44, 0x38,
+	0x7d, 0x2c, 0xb9, 0x04, 0x30, 0x72, 0x1f, 0x72, 0xa5, 0xf4, 0x58, 0x22, 0xac, 0x7e, 0x2d, 0x35,
+	0xb3, 0x37, 0x0d, 0x06, 0x33, 0x54, 0xce, 0x77, 0x2d, 0x58, 0xbc, 0x16, 0x87, 0xe3, 0x48, 0x5d,
+	0xc9, 0x37, 0xbc, 0x60, 0xc0, 0x1d, 0xb3, 0x21, 0x87, 0x15, 0x1d, 0x33, 0x41, 0x88, 0x12, 0xc7,
+	0x2f, 0xd6, 0xfd, 0x9c, 0x13, 0x61, 0x2e, 0x56, 0x7d, 0xe3, 0x6b, 0x3c, 0xb7, 0x71, 0xf7, 0xbd,
+	0x60, 0xa0, 0x36, 0xd6, 0xa8, 0x20, 0x97, 0x85, 0x02, 0xc3, 0xb5, 0xff, 0xd4, 0xf5, 0xed, 0xed,
+	0xad, 0x8e, 0x9b, 0x78, 0xfd, 0xd5, 0x31, 0xdb, 0x23, 0xb7, 0x33, 0x5b, 0x7c, 0xac, 0xf3, 0xbd,
+	0x70, 0x88, 0x16, 0xdc, 0xe6, 0x46, 0x29, 0x49, 0x1e, 0x84, 0xf1, 0xc0, 0xae, 0x1c, 0x9b, 0xe1,
+	0x96, 0x1a, 0x8a, 0x86, 0x89, 0xf3, 0x71, 0x1d, 0x4e, 0xf3, 0x39, 0xf3, 0xa8, 0xf0, 0x68, 0x47,
+	0xe0, 0x05, 0xa8, 0x8f, 0x28, 0xdb, 0x0b, 0x07, 0x6a, 0xc5, 0x4c, 0x34, 0x7e, 0x53, 0x40, 0x51,
+	0x61, 0x79, 0x96, 0x6a, 0x7e, 0x8f, 0xba, 0x03, 0x1a, 0x4b, 0xf3, 0xdb, 0xba, 0x74, 0xe7, 0xe4,
+	0x36, 0x20, 0x3f, 0xc5, 0xf6, 0x75, 0xc9, 0x57, 0x5a, 0x03, 0xb3, 0x65, 0x0a, 0x8a, 0x5a, 0x2c,
+	0xdf, 0xb2, 0x9d, 0x70, 0x70, 0x60, 0xcf, 0xe5, 0xb7, 0xac, 0x13, 0x0e, 0x0e, 0x50, 0x60, 0x08,
+	0x83, 0xe6, 0x8e, 0xde, 0xad, 0xf2, 0xa1, 0x5e, 0x6e, 0xf3, 0xa5, 0x6b, 0x63, 0x1e, 0x31, 0x15,
+	0x44, 0xbe, 0x0e, 0xad, 0x1d, 0xea, 0xc6, 0x34, 0x16, 0x27, 0xf3, 0x78, 0x07, 0xf1, 0x0c, 0xf7,
+	0x7e, 0x3a, 0xe9, 0x68, 0xcc, 0xb2, 0xca, 0x59, 0xa0, 0xf9, 0xa7, 0x5a, 0xa0, 0x97, 0x60, 0x9e,
+	0x87, 0xbc, 0xe1, 0x98, 0x29, 0xf7, 0xca, 0x2c, 0xe5, 0xb6, 0x04, 0xa3, 0xc6, 0xab, 0x63, 0xd9,
+	0x71, 0xfb, 0xf7, 0xc3, 0xdd, 0x5d, 0xbb, 0x29, 0xa8, 0xb3, 0xc7, 0x52, 0x61, 0x30, 0x43, 0x45,
+	0x18, 0x40, 0x3f, 0x0c, 0x06, 0x9e, 0xbc, 0x82, 0xe1, 0x62, 0xb5, 0x5c, 0x72, 0x2f, 0x0d, 0xff,
+	0x64, 0xa4, 0xdf, 0x35, 0xbc, 0x31, 0x23, 0xe7, 0xfc, 0x57, 0x61, 0x21, 0xab, 0x1e, 0xc7, 0xba,
+	0x0b, 0xbe, 0x5d, 0x81, 0x33, 0x85, 0xe8, 0x99, 0x3c, 0x84, 0x86, 0xaf, 0x93, 0x49, 0xd6, 0xcc,
+	0x93, 0x49, 0x66, 0x7b, 0x34, 0x04, 0x8d, 0x34, 0xf2, 0x9a, 0x0a, 0xc6, 0xe5, 0x39, 0x7b, 0xae,
+	0x10, 0x8c, 0x9f, 0x32, 0x13, 0xcd, 0x84, 0xe3, 0xab, 0x70, 0x26, 0xa6, 0xbb, 0x3c, 0xdb, 0xbc,
+	0x91, 0xbf, 0x88, 0x3e, 0xaf, 0x46, 0x9f, 0xc1, 0x3c, 0x1a, 0x8b, 0xf4, 0xce, 0x0f, 0x2d, 0xb0,
+	0x6f, 0x8c, 0x77, 0xa8, 0x0c, 0x72, 0x36, 0x82, 0xfd, 0xd0, 0xdf, 0xa7, 0x83, 0xdb, 0x3b, 0xef,
+	0x53, 0xe9, 0xe8, 0x09, 0x23, 0x68, 0x1d, 0x66, 0x04, 0x39, 0x85, 0x30, 0x77, 0x85, 0x1c, 0x3f,
+	0xf7, 0x2f, 0x50, 0x60, 0xb8, 0x2b, 0xc7, 0xff, 0x4f, 0x22, 0xb7, 0xaf, 0xe3, 0x6d, 0xe3, 0xca,
+	0xdd, 0xd2, 0x08, 0x4c, 0x69, 0x9c, 0x1f, 0x57, 0x61, 0x31, 0x9d, 0x51, 0xea, 0x41, 0xa6, 0x5c,
+	0xac, 0xa7, 0x73, 0x21, 0x5f, 0x82, 0xf9, 0x98, 0xba, 0x49, 0x18, 0xe8, 0xdb, 0x5b, 0xa4, 0x62,
+	0x50, 0x82, 0x50, 0xe3, 0xc8, 0x32, 0xd4, 0x78, 0x46, 0x40, 0xbb, 0x8c, 0xf2, 0x02, 0xe5, 0x00,
+	0x94, 0x70, 0xf2, 0x03, 0x8b, 0xe7, 0x48, 0xb3, 0xab, 0xa2, 0xe2, 0x3e, 0x3c, 0xb9, 0x5a, 0x1c,
+	0xb6, 0xde, 0x1d, 0x22, 0x73, 0xae, 0x59, 0x18, 0x16, 0xa4, 0x93, 0x2b, 0xb0, 0x28, 0xc3, 0xc4,
+	0x6e, 0x38, 0x8a, 0xc2, 0x80, 0x73, 0x11, 0x55, 0xad, 0x66, 0xe7, 0x1c, 0x8f, 0x86, 0x7b, 0x05,
+	0x1c, 0x4e, 0x50, 0xf3, 0x98, 0xba, 0x1f, 0xfa, 0xbe, 0x1b, 0x25, 0xd4, 0xa8, 0x4d, 0x3d, 0x1f,
+	0x53, 0x77, 0x0b, 0x78, 0x9c, 0x18, 0xe1, 0xfc, 0x99, 0x05, 0x3a, 0x17, 0x61, 0x2c, 0xaf, 0x75,
+	0xa8, 0xe5, 0xdd, 0x83, 0x7a, 0x22, 0xd2, 0xb9, 0x76, 0x65, 0xd6, 0x69, 0x61, 0xf9, 0x8c, 0x8a,
+	0xbf, 0xf3, 0x0f, 0x35, 0x80, 0x5b, 0xe1, 0x80, 0xf6, 0x98, 0xcb, 0xc6, 0x09, 0x39, 0x0f, 0x15,
+	0x4f, 0x2b, 0x30, 0xa8, 0x21, 0x95, 0x8d, 0xab, 0x58, 0xf1, 0x8e, 0xa2, 0xbc, 0x5f, 0x86, 0xd6,
+	0xc0, 0x4b, 0x22, 0xdf, 0x3d, 0xe0, 0x40, 0xbb, 0x9a, 0x8f, 0x4a, 0xaf, 0xa6, 0x28, 0xcc, 0xd2,
+	0x99, 0x6c, 0xd4, 0xdc, 0xf4, 0x6c, 0x14, 0x9f, 0x5e, 0x26, 0x1b, 0xf5, 0x2a, 0xd4, 0xa2, 0x3d,
+	0x37, 0xd1, 0xd1, 0x84, 0x4e, 0x48, 0xd4, 0xb6, 0x38, 0xf0, 0x09, 0x57, 0xf0, 0x70, 0x40, 0xc5,
+	0x03, 0x4a, 0x42, 0x1e, 0xf5, 0x27, 0xcc, 0x8d, 0x19, 0x1d, 0xac, 0xb2, 0x32, 0x51, 0x7f, 0x4f,
+	0x33, 0xc1, 0x94, 0x1f, 0xaf, 0xf6, 0xf6, 0x43, 0x5e, 0xad, 0x94, 0xec, 0xe7, 0x4f, 0x5e, 0xed,
+	0xed, 0xa6, 0x6c, 0x30, 0xcb, 0x93, 0xdf, 0x44, 0x3a, 0x41, 0x56, 0xb8, 0x89, 0x8a, 0xd9, 0x2d,
+	0x72, 0x00, 0x2d, 0xdf, 0x65, 0x34, 0x61, 0xe2, 0xc0, 0xd8, 0xcd, 0x99, 0xe4, 0xb5, 0x54, 0x80,
+	0x2d, 0x6f, 0xd7, 0xcd, 0x94, 0x3d, 0x66, 0x65, 0x91, 0x08, 0x9a, 0xae, 0xae, 0xdf, 0xda, 0x20,
+	0x04, 0x77, 0x67, 0x50, 0x38, 0x96, 0x9e, 0x82, 0x79, 0xc4, 0x54, 0x88, 0x13, 0xc3, 0xe2, 0x56,
+	0x98, 0xb0, 0x61, 0x4c, 0x93, 0xad, 0x30, 0x11, 0x37, 0x1c, 0xf7, 0xcf, 0xfc, 0x24, 0x28, 0xfa,
+	0x67, 0x9b, 0xbd, 0x5b, 0xc8, 0xe1, 0x1c, 0x1d, 0x87, 0x0f, 0x54, 0x3e, 0xd6, 0xa0, 0x31, 0x7c,
+	0x80, 0x1c, 0xce, 0x55, 0x3c, 0x0e, 0x1f, 0x24, 0xaa, 0xf8, 0x9b, 0x46, 0x52, 0xe1, 0x03, 0x1e,
+	0xc5, 0x84, 0x0f, 0x12, 0xe7, 0xe3, 0x0a, 0x9c, 0xd5, 0x42, 0x91, 0x46, 0xbe, 0xa7, 0xae, 0x23,
+	0x9e, 0x16, 0xf0, 0x43, 0x56, 0x3c, 0xd3, 0x3d, 0x3f, 0x64, 0x28, 0x30, 0xa4, 0x0b, 0xf5, 0xc8,
+	0x1f, 0x0f, 0x3d, 0xed, 0x4c, 0xff, 0xa6, 0x3e, 0x91, 0x5b, 0x02, 0xfa, 0xe4, 0xd1, 0xf2, 0x17,
+	0xa6, 0x30, 0x96, 0x48, 0x54, 0x43, 0xf9, 0x09, 0x8b, 0xc6, 0x3b, 0x1a, 0x59, 0x3c, 0x61, 0x5b,
+	0x29, 0x0a, 0xb3, 0x74, 0xbc, 0xc6, 0xc7, 0xdc, 0x1d, 0x9f, 0xea, 0x60, 0x1d, 0x64, 0x81, 0x88,
+	0x43, 0x50, 0x61, 0xb8, 0x13, 0xd3, 0x8f, 0x29, 0x2f, 0xbe, 0xf3, 0xf7, 0xa8, 0x89, 0x28, 0xc5,
+	0x38, 0x31, 0x5d, 0x83, 0xc1, 0x0c, 0x95, 0xf3, 0x93, 0x0a, 0x9c, 0xd6, 0x93, 0x56, 0x57, 0xcf,
+	0x90, 0x9b, 0xcb, 0x20, 0xa0, 0xa2, 0xa8, 0xdd, 0x63, 0xb1, 0x17, 0x0c, 0x8f, 0xe7, 0xdd, 0x9f,
+	0x93, 0x16, 0x35, 0xcf, 0x02, 0x27, 0x98, 0xf2, 0x14, 0x44, 0x7f, 0xcf, 0x0d, 0x02, 0x5d, 0xe9,
+	0x55, 0x29, 0x88, 0xae, 0x82, 0xa1, 0xc1, 0x72, 0x67, 0xbb, 0x15, 0xd3, 0x28, 0xb7, 0x6a, 0xad,
+	0x4b, 0x37, 0x4f, 0xae, 0x9c, 0x53, 0xf6, 0x49, 0x1e, 0x8e, 0x0c, 0x00, 0xb3, 0x22, 0x9d, 0x77,
+	0xe1, 0x2c, 0x52, 0x79, 0xb5, 0xac, 0x7b, 0xd4, 0x1f, 0xf0, 0x59, 0xca, 0x9b, 0xe0, 0x29, 0x99,
+	0xfa, 0xa3, 0x34, 0x2d, 0x38, 0x3f, 0xaa, 0xc1, 0xe9, 0x94, 0xbd, 0xa8, 0x01, 0xbc, 0x00, 0xf5,
+	0x28, 0xa6, 0xbb, 0xde, 0x43, 0xc5, 0xdb, 0xd8, 0xff, 0x2d, 0x01, 0x45, 0x85, 0x25, 0xdf, 0x2a,
+	0x54, 0xcb, 0xb7, 0x4f, 0xbe, 0x2a, 0xf9, 0x19, 0x1c, 0xa5, 0x52, 0xce, 0x8b, 0x92, 0x2d, 0x37,
+	0x08, 0x42, 0x96, 0xc9, 0x44, 0xb5, 0x2e, 0xfd, 0xde, 0xcc, 0xe6, 0xb0, 0x9a, 0xf2, 0x96, 0x13,
+	0x31, 0x47, 0x25, 0x83, 0xc1, 0xec, 0x14, 0xf8, 0x65, 0x21, 0x15, 0x7c, 0xd0, 0x39, 0x28, 0xd3,
+	0x5a, 0xd3, 0xd5, 0x4c, 0x30, 0xe5, 0x47, 0xba, 0x00, 0x26, 0xdb, 0xae, 0xfd, 0x90, 0xe7, 0x45,
+	0x8a, 0xd4, 0x40, 0x9f, 0x3c, 0x5a, 0x5e, 0xd2, 0x6f, 0x61, 0xa0, 0x98, 0x19, 0x46, 0x7e, 0x17,
+	0x4e, 0xed, 0x72, 0x1d, 0xd2, 0x27, 0x46, 0x79, 0x23, 0xcf, 0x2a, 0xc9, 0xa7, 0xd6, 0xb3, 0x48,
+	0xcc, 0xd3, 0x96, 0xe8, 0x4d, 0x38, 0xff, 0x26, 0x2c, 0x16, 0xd7, 0xf3, 0x58, 0xf1, 0xc3, 0x77,
+	0x32, 0x5a, 0xaa, 0xbc, 0xb3, 0x63, 0xfb, 0xa9, 0xa9, 0xba, 0x56, 0x67, 0xa5, 0xae, 0x72, 0x2a,
+	0x47, 0x52, 0xd7, 0x3f, 0x06, 0x88, 0xdc, 0xd8, 0x1d, 0x51, 0x46, 0x63, 0x69, 0x4a, 0x4b, 0xe5,
+	0xee, 0xf4, 0x0c, 0xb6, 0x34, 0xcf, 0xd4, 0xde, 0x1a, 0x50, 0x82, 0x19, 0x91, 0xa2, 0x88, 0x3f,
+	0x2c, 0xe4, 0x72, 0xec, 0x5a, 0xd9, 0xb8, 0xab, 0x98, 0x1d, 0x4a, 0x1d, 0xdb, 0x22, 0x06, 0x27,
+	0xa4, 0x93, 0xd8, 0x14, 0x78, 0xea, 0x33, 0x8f, 0xff, 0x52, 0xa7, 0x35, 0x57, 0xf1, 0x29, 0xd3,
+	0x60, 0xf3, 0x23, 0x0b, 0x96, 0x26, 0xd6, 0x9d, 0xf8, 0x50, 0x4d, 0xe2, 0xbe, 0xba, 0xa7, 0xde,
+	0x9e, 0xe1, 0x8e, 0xaa, 0x22, 0xb3, 0xe8, 0x42, 0xe9, 0xc5, 0x7d, 0xe4, 0x62, 0xb8, 0xd5, 0x1f,
+	0xd0, 0x84, 0x15, 0x1d, 0xe9, 0xab, 0x34, 0x61, 0x28, 0x30, 0xce, 0xdf, 0x59, 0xf0, 0xf9, 0x43,
+	0x78, 0x71, 0xcb, 0x9e, 0x88, 0xab, 0xb6, 0x68, 0xd9, 0xe5, 0x05, 0x8c, 0x0a, 0x7b, 0x84, 0x7e,
+	0xb2, 0xe5, 0x7c, 0x5d, 0xb7, 0x39, 0xd1, 0x0c, 0xb7, 0x92, 0x75, 0xe9, 0xe6, 0xf2, 0x75, 0x85,
+	0xa9, 0x1e, 0xd9, 0x9f, 0xd6, 0xd3, 0x23, 0x7e, 0xd2, 0x50, 0xd4, 0x87, 0xfa, 0xae, 0xb0, 0xde,
+	0x2a, 0xf6, 0xb9, 0x3e, 0xab, 0xdb, 0x40, 0x7a, 0x3d, 0xf2, 0x6f, 0x54, 0x32, 0xa6, 0x9f, 0xa8,
+	0xea, 0xff, 0xe9, 0x89, 0x5a, 0x85, 0x33, 0xaa, 0x71, 0x68, 0xed, 0xa1, 0x97, 0x30, 0xee, 0x40,
+	0xc9, 0xb5, 0x37, 0x69, 0x8a, 0x8d, 0x3c, 0x1a, 0x8b, 0xf4, 0xe4, 0x7b, 0x16, 0x2c, 0xec, 0xa6,
+	0x7e, 0x86, 0x6e, 0xe4, 0xbc, 0x39, 0x8b, 0xa5, 0x34, 0x5c, 0x3b, 0xe7, 0xd4, 0x7c, 0x16, 0x32,
+	0xc0, 0x04, 0x73, 0x82, 0x79, 0x2b, 0x8a, 0xd9, 0xda, 0xc4, 0xae, 0xa7, 0xad, 0x28, 0x66, 0xef,
+	0x13, 0xcc, 0x50, 0x90, 0x6b, 0xb0, 0x64, 0x9e, 0xcc, 0x05, 0x27, 0x93, 0x75, 0x5f, 0x50, 0xe2,
+	0x96, 0x6e, 0x15, 0x09, 0x70, 0x72, 0x0c, 0xbf, 0x25, 0xd5, 0xaa, 0x48, 0x53, 0x21, 0x42, 0xa7,
+	0x46, 0x7a, 0x4b, 0x6e, 0x64, 0x91, 0x98, 0xa7, 0x95, 0xbd, 0x3f, 0x02, 0x90, 0xb9, 0xf1, 0x44,
+	0x34, 0xd5, 0xc8, 0xf6, 0xfe, 0x14, 0x29, 0x70, 0xca, 0x28, 0xe7, 0x0c, 0x9c, 0x42, 0xca, 0xe2,
+	0x83, 0x1e, 0x8b, 0x5d, 0x46, 0x87, 0x07, 0xce, 0xbf, 0x57, 0x00, 0xd2, 0x5e, 0x3c, 0xf2, 0x5c,
+	0xc6, 0x7a, 0xa5, 0x21, 0x09, 0x2f, 0x02, 0x70, 0x38, 0xb9, 0xab, 0xab, 0x9a, 0xf2, 0x1c, 0x5f,
+	0xc9, 0x15, 0x25, 0x9f, 0x3c, 0x5a, 0x5e, 0xc9, 0x34, 0x7b, 0x8f, 0xbc, 0xc0, 0x0b, 0xe5, 0xbf,
+	0xaf, 0x0c, 0xc3, 0xf6, 0xad, 0x90, 0x79, 0xbb, 0xca, 0x03, 0x4d, 0x5d, 0x09, 0xc9, 0x8e, 0xec,
+	0x9a, 0x63, 0x26, 0xb5, 0xbd, 0x53, 0xa6, 0xb1, 0xf0, 0x57, 0x1c, 0xb0, 0x08, 0x1a, 0xc9, 0xe5,
+	0xce, 0xb8, 0x7f, 0x9f, 0xea, 0x54, 0x50, 0x29, 0x49, 0x92, 0x53, 0xa6, 0x57, 0x4a, 0x41, 0xd0,
+	0x48, 0x71, 0x7e, 0x59, 0x01, 0x03, 0x3e, 0x66, 0xf3, 0xe8, 0x0b, 0x50, 0xdf, 0x91, 0x53, 0x2d,
+	0xa4, 0xef, 0x95, 0x10, 0x85, 0xe5, 0x74, 0x31, 0x1d, 0xa6, 0x11, 0x98, 0xa1, 0x43, 0x01, 0x45,
+	0x85, 0x95, 0x19, 0x67, 0x59, 0xc8, 0x51, 0x67, 0x38, 0x93, 0x71, 0x96, 0x70, 0x34, 0x14, 0xe4,
+	0x2e, 0x34, 0xdd, 0x7e, 0x9f, 0x26, 0x09, 0x2f, 0x13, 0x1d, 0xab, 0x92, 0x95, 0x5a, 0x65, 0x3d,
+	0x1e, 0x53, 0x56, 0x9c, 0x6f, 0xa2, 0x87, 0xd8, 0xf5, 0x13, 0xf1, 0x35, 0x28, 0x4c, 0x59, 0x39,
+	0xef, 0xf0, 0x75, 0x3e, 0x66, 0xbc, 0xc1, 0x6f, 0xaf, 0xf1, 0x2e, 0xa7, 0x2b, 0xac, 0x70, 0x4f,
+	0x40, 0x51, 0x61, 0x9d, 0x5f, 0xcc, 0x01, 0xf4, 0xd6, 0xb7, 0xb7, 0xd4, 0x2d, 0xf2, 0x12, 0xcc,
+	0xbb, 0x83, 0x41, 0x4c, 0x93, 0xc4, 0xb6, 0xf2, 0x29, 0x90, 0x55, 0x09, 0x46, 0x8d, 0xcf, 0x57,
+	0xcf, 0x2b, 0x47, 0xa8, 0x9e, 0x67, 0x6b, 0xd9, 0xd5, 0xa7, 0xd5, 0xb2, 0x8f, 0x51, 0x94, 0xcc,
+	0x96, 0xa3, 0x6a, 0x33, 0x28, 0x47, 0x91, 0x3b, 0x00, 0x51, 0xec, 0xed, 0xbb, 0x8c, 0x1e, 0x7b,
+	0x23, 0x85, 0xc9, 0xdd, 0x32, 0x83, 0x31, 0xc3, 0x88, 0xaf, 0xed, 0x5e, 0xa6, 0x36, 0x99, 0x59,
+	0x5b, 0x5d, 0x8d, 0xd4, 0xf8, 0xc3, 0x8b, 0x9a, 0x8d, 0x12, 0x45, 0xcd, 0x6c, 0x59, 0xa6, 0xf9,
+	0xd4, 0xb2, 0xcc, 0x55, 0xde, 0xc6, 0xda, 0xdf, 0xf3, 0xf6, 0xa9, 0xd9, 0x4c, 0x1b, 0x72, 0x89,
+	0xc3, 0xc5, 0xd5, 0x02, 0x1e, 0x27, 0x46, 0x38, 0x8f, 0x2d, 0x80, 0xde, 0x4d, 0xa3, 0x5e, 0xb2,
+	0x61, 0xd2, 0x8b, 0x3c, 0x91, 0x1f, 0xb6, 0x72, 0x0d, 0x93, 0x0a, 0x8a, 0x19, 0x0a, 0x9e, 0x2e,
+	0x4f, 0x68, 0x20, 0xaa, 0x77, 0x99, 0x74, 0x79, 0x4f, 0x82, 0x50, 0xe3, 0xc8, 0x07, 0xd0, 0x72,
+	0x19, 0x73, 0xfb, 0x7b, 0x23, 0xc1, 0xb7, 0x3a, 0x73, 0x07, 0x59, 0x24, 0x1d, 0x56, 0x53, 0x11,
+	0x98, 0x95, 0xe7, 0xfc, 0x53, 0x05, 0xea, 0x3d, 0xc1, 0x82, 0xbc, 0x07, 0x0d, 0x1e, 0xa6, 0x8a,
+	0xe6, 0x33, 0xe9, 0xe5, 0xbe, 0x7a, 0xb4, 0xa0, 0x56, 0x46, 0x47, 0x37, 0x29, 0x73, 0xd3, 0xe0,
+	0x24, 0x85, 0xa1, 0xe1, 0x4a, 0x76, 0x61, 0x2e, 0x89, 0x68, 0x5f, 0x39, 0x6d, 0x65, 0xda, 0xd4,
+	0xc5, 0x73, 0x2f, 0xa2, 0xfd, 0x4c, 0x1a, 0x2d, 0xa2, 0x7d, 0x14, 0xfc, 0x49, 0xc0, 0x53, 0xe3,
+	0x3c, 0x57, 0x5d, 0xbe, 0x19, 0x5d, 0x49, 0x12, 0xdc, 0xb2, 0x09, 0x72, 0xfe, 0x8c, 0x4a, 0x8a,
+	0xf3, 0x2f, 0x5c, 0x53, 0x04, 0xe1, 0xa6, 0x97, 0x30, 0xf2, 0xee, 0xc4, 0x42, 0xb6, 0x8f, 0xb6,
+	0x90, 0x7c, 0xb4, 0x58, 0xc6, 0xb4, 0xa8, 0xe5, 0x25, 0xc5, 0x45, 0xa4, 0x50, 0xf3, 0x18, 0x1d,
+	0xe9, 0x64, 0xcc, 0x95, 0xb2, 0xef, 0x96, 0xe6, 0x8b, 0x36, 0x38, 0x5b, 0x94, 0xdc, 0x9d, 0x1f,
+	0x54, 0xf5, 0x3b, 0xf1, 0x85, 0x25, 0xf7, 0x61, 0x5e, 0xc6, 0x0c, 0xba, 0x1f, 0xa5, 0x8c, 0x5c,
+	0xc1, 0x28, 0xf3, 0x9d, 0x8f, 0x64, 0x8c, 0x5a, 0x02, 0x09, 0xa1, 0xc1, 0x62, 0x6f, 0x38, 0xd4,
+	0x67, 0xa7, 0x54, 0xbb, 0xe7, 0xb6, 0xe4, 0x94, 0x69, 0x57, 0x56, 0xac, 0xd1, 0x08, 0x21, 0xdf,
+	0x04, 0xa0, 0xa6, 0x2f, 0xb5, 0xfc, 0x19, 0x2c, 0xf6, 0xb8, 0x4a, 0x3b, 0x91, 0x42, 0x31, 0x23,
+	0x4d, 0xfa, 0x09, 0x11, 0x75, 0x99, 0xba, 0xfd, 0x33, 0x7e, 0x02, 0x87, 0xa2, 0xc2, 0x3a, 0xff,
+	0xb5, 0x00, 0x0b, 0x59, 0x6d, 0x4c, 0x8b, 0x1c, 0xd6, 0x89, 0x8a, 0x1c, 0x95, 0x5f, 0x6f, 0x91,
+	0xa3, 0xfa, 0xeb, 0x2d, 0x72, 0xcc, 0x3d, 0xa5, 0xc8, 0xb1, 0x0f, 0xb5, 0x20, 0x1c, 0x98, 0xa8,
+	0xe6, 0xed, 0xd9, 0x58, 0x80, 0x36, 0x5f, 0x52, 0x95, 0x00, 0x32, 0xc7, 0x46, 0xc0, 0x50, 0x8a,
+	0x23, 0x7f, 0x69, 0xc1, 0x69, 0xdf, 0x55, 0xf5, 0x0e, 0xfe, 0x5a, 0x32, 0xa0, 0x69, 0x5d, 0x7a,
+	0x67, 0x46, 0x33, 0xd8, 0xcc, 0x31, 0x97, 0x53, 0x31, 0xdd, 0x65, 0x79, 0x24, 0x16, 0x66, 0x42,
+	0x7e, 0x6a, 0xc1, 0x39, 0xfd, 0x85, 0xc5, 0xba, 0x17, 0x0c, 0x69, 0x1c, 0xc5, 0x1e, 0xbf, 0x75,
+	0xe6, 0xc5, 0x14, 0xdf, 0x9b, 0xd1, 0x14, 0x57, 0xa7, 0x88, 0x90, 0x13, 0xfd, 0xa2, 0x9a, 0xe8,
+	0xb9, 0x69, 0x24, 0x38, 0x75, 0x6e, 0xe4, 0x43, 0x98, 0x1f, 0xca, 0x06, 0x36, 0xbb, 0x21, 0xa6,
+	0xd9, 0x9b, 0xd1, 0x34, 0x55, 0x5b, 0x5c, 0xa1, 0x07, 0x46, 0x41, 0x51, 0x0b, 0x25, 0x3f, 0xb1,
+	0x60, 0x29, 0x2a, 0x94, 0x90, 0x74, 0x5b, 0xec, 0x1f, 0xcc, 0x68, 0x2a, 0xc5, 0x12, 0x95, 0x9a,
+	0x94, 0x89, 0x66, 0x27, 0xf0, 0x38, 0x39, 0xa5, 0xf3, 0x1f, 0xca, 0x2a, 0xed, 0xa1, 0x09, 0xaf,
+	0x77, 0xb2, 0x09, 0xaf, 0x52, 0xd7, 0x6f, 0x5a, 0x0c, 0xce, 0xe6, 0x7e, 0x47, 0x70, 0x76, 0x8a,
+	0x72, 0x4e, 0x99, 0xc8, 0x95, 0xfc, 0x44, 0x8e, 0x61, 0x23, 0xb2, 0xe2, 0xae, 0xc1, 0x17, 0x0e,
+	0x55, 0xb4, 0x63, 0xe5, 0xac, 0x3f, 0x80, 0x85, 0xac, 0x2a, 0x4c, 0x19, 0x7b, 0x2f, 0x3f, 0xe1,
+	0xd5, 0xd2, 0xad, 0x98, 0x59, 0xf1, 0x9f, 0x58, 0xf0, 0xb9, 0xe9, 0xfb, 0x3f, 0x65, 0x26, 0xef,
+	0xe5, 0x67, 0xf2, 0x56, 0xf9, 0xfa, 0x94, 0x16, 0x99, 0x4d, 0x80, 0xfe, 0x78, 0x09, 0xea, 0x3d,
+	0x93, 0x21, 0x34, 0xcd, 0x77, 0xd3, 0x0b, 0xfa, 0xa2, 0x79, 0xd7, 0x1d, 0x98, 0xaf, 0x03, 0xab,
+	0xd9, 0xe6, 0x5d, 0x09, 0x47, 0x43, 0x41, 0x06, 0xa6, 0x6b, 0xa1, 0x3a, 0xa3, 0xae, 0x05, 0x98,
+	0xec, 0x58, 0x20, 0x31, 0x34, 0xb4, 0x2d, 0xb1, 0xe7, 0xca, 0x66, 0x08, 0xf3, 0xdf, 0x98, 0xc9,
+	0xc8, 0x4b, 0xc3, 0xd0, 0xc8, 0xe1, 0x32, 0xcd, 0x17, 0x48, 0xb5, 0xb2, 0x32, 0xf3, 0x1f, 0x82,
+	0xa9, 0xaa, 0xa5, 0x82, 0xa1, 0x91, 0xc3, 0x65, 0xc6, 0x34, 0x97, 0x5a, 0x9f, 0x41, 0x26, 0x34,
+	0x2b, 0x53, 0xc3, 0xd0, 0xc8, 0xe1, 0x9f, 0x76, 0x3d, 0xa0, 0x3b, 0x7b, 0x61, 0x78, 0x5f, 0x35,
+	0x32, 0x94, 0xe8, 0xf7, 0xbb, 0x27, 0x19, 0x29, 0x89, 0x22, 0x40, 0x52, 0x20, 0xd4, 0x42, 0xf8,
+	0x27, 0x38, 0x32, 0x4d, 0x24, 0xd3, 0x73, 0xe5, 0xbc, 0x79, 0x21, 0x48, 0x65, 0xa2, 0x8c, 0xc9,
+	0x97, 0xcf, 0x09, 0x6a, 0x39, 0x64, 0x47, 0x7d, 0xca, 0xda, 0x2c, 0x6b, 0x28, 0xd3, 0x86, 0xfd,
+	0x89, 0x0f, 0x59, 0xff, 0x10, 0xaa, 0x43, 0x8f, 0x95, 0x6f, 0x82, 0x30, 0x9d, 0xd7, 0xb2, 0x80,
+	0xc0, 0x0d, 0x0c, 0x67, 0xcc, 0x55, 0x63, 0x8f, 0x31, 0xfe, 0x59, 0x9a, 0x6f, 0xb7, 0xca, 0xaa,
+	0x46, 0xbe, 0x7b, 0x54, 0xaa, 0x86, 0x86, 0xa1, 0x91, 0x43, 0x3e, 0x84, 0x56, 0xe6, 0xf3, 0x1e,
+	0x7b, 0xe1, 0xa2, 0x55, 0xae, 0xf8, 0x35, 0xf1, 0xcd, 0x9b, 0x0c, 0x66, 0x33, 0x60, 0xcc, 0x0a,
+	0xe4, 0x6e, 0xfc, 0x7d, 0xd3, 0x08, 0x66, 0x9f, 0x2a, 0x6b, 0x22, 0x8b, 0x2d, 0x73, 0xd2, 0x8d,
+	0x4f, 0xa1, 0x98, 0x91, 0x46, 0xbe, 0x63, 0xc1, 0x82, 0x9b, 0xf9, 0x16, 0xdc, 0x3e, 0x2d, 0xc4,
+	0x6f, 0xce, 0xf2, 0xcb, 0xf2, 0xce, 0x22, 0xcf, 0xa4, 0x67, 0xe1, 0x98, 0x93, 0xc9, 0x37, 0x5d,
+	0xfb, 0x05, 0xf6, 0x99, 0xb2, 0x9b, 0x9e, 0x6f, 0xda, 0x50, 0x19, 0x27, 0x05, 0x43, 0x23, 0x87,
+	0x1f, 0x96, 0x61, 0x1c, 0xf5, 0xed, 0xc5, 0xb2, 0x87, 0x25, 0xfd, 0xa6, 0x43, 0x1e, 0x16, 0xfe,
+	0x8c, 0x82, 0x37, 0x79, 0x1f, 0xea, 0xc9, 0x41, 0xe2, 0x87, 0x43, 0x7b, 0xa9, 0xb4, 0x09, 0x10,
+	0x7c, 0x94, 0x1c, 0x79, 0x77, 0x08, 0x08, 0x2a, 0x09, 0xfc, 0x7d, 0x92, 0x11, 0x8b, 0x6c, 0x52,
+	0x3a, 0x49, 0x61, 0x72, 0x47, 0xf2, 0x7d, 0xf8, 0x33, 0x0a, 0xde, 0x42, 0xc6, 0x2e, 0x8b, 0xec,
+	0xb3, 0xa5, 0x65, 0xac, 0x17, 0x64, 0xac, 0x0b, 0x19, 0xbb, 0x2c, 0x22, 0x0f, 0xa1, 0xe5, 0xa6,
+	0xbf, 0xbd, 0x60, 0x9f, 0x2b, 0xfb, 0xc5, 0x7c, 0xe6, 0x87, 0x1c, 0x54, 0x4e, 0x29, 0x05, 0x60,
+	0x56, 0x14, 0xd9, 0x85, 0x5a, 0xc2, 0x5c, 0x46, 0xed, 0x67, 0xcb, 0xca, 0x94, 0xaf, 0xc6, 0x5d,
+	0x4d, 0x2a, 0x4b, 0x8f, 0xe2, 0x4f, 0x94, 0xec, 0x9d, 0x7f, 0xae, 0xc0, 0x42, 0xd6, 0xa2, 0xf3,
+	0x65, 0xcd, 0xfc, 0x16, 0x48, 0x89, 0x65, 0xe5, 0xbe, 0xa6, 0xba, 0x25, 0x1a, 0x85, 0x5f, 0x08,
+	0x19, 0xa5, 0x5f, 0x84, 0x56, 0x66, 0xfa, 0x45, 0x68, 0x6b, 0xea, 0xd7, 0xa0, 0x3b, 0xea, 0x6b,
+	0xd0, 0xea, 0x0c, 0x9b, 0xbf, 0x8b, 0xdf, 0x94, 0xfe, 0x4f, 0x15, 0x5a, 0x99, 0x95, 0x26, 0xf7,
+	0xa0, 0xc9, 0x03, 0xc7, 0x75, 0x2f, 0xa6, 0x83, 0x13, 0xfc, 0xae, 0x8a, 0x68, 0xc6, 0xdb, 0xd4,
+	0x0c, 0x30, 0xe5, 0x45, 0x6e, 0xc2, 0xd9, 0x29, 0x21, 0x9e, 0x5d, 0xc9, 0x7d, 0x2b, 0x7d, 0x76,
+	0x8a, 0x57, 0x8f, 0xd3, 0xc6, 0x91, 0x0f, 0xd2, 0xc8, 0x50, 0x2e, 0x0f, 0xce, 0x44, 0xd3, 0x8e,
+	0x1a, 0x18, 0x7e, 0xdf, 0x82, 0xc5, 0x62, 0x14, 0x66, 0xcf, 0x95, 0xbd, 0x74, 0x8a, 0x7e, 0xb9,
+	0xec, 0x75, 0x2b, 0x42, 0x71, 0x42, 0x32, 0x6f, 0xdb, 0x7f, 0x4a, 0x18, 0x73, 0x78, 0xc7, 0xc3,
+	0x0f, 0x79, 0x16, 0x58, 0xba, 0xce, 0x17, 0x55, 0xa3, 0x6d, 0xc1, 0xe1, 0xcf, 0x34, 0xd7, 0xaa,
+	0xcf, 0x5b, 0x2a, 0x87, 0x7c, 0xde, 0xf2, 0x5d, 0x0b, 0xc0, 0x65, 0x2c, 0xf6, 0x76, 0xc6, 0x8c,
+	0xea, 0x9d, 0xd9, 0x2a, 0xeb, 0xe6, 0xb7, 0x57, 0x0d, 0xcb, 0xc2, 0x97, 0xa3, 0x29, 0x02, 0x33,
+	0x72, 0xf9, 0x97, 0xa3, 0x85, 0x21, 0xc7, 0x5a, 0x91, 0x5f, 0x5a, 0xb0, 0x90, 0xbd, 0x2a, 0xc8,
+	0x1b, 0xd0, 0x14, 0x3f, 0x09, 0xd5, 0x0f, 0x7d, 0x9d, 0xfd, 0xe7, 0x5f, 0x43, 0x36, 0xb7, 0x34,
+	0xf0, 0xc9, 0xa3, 0xe5, 0xd3, 0x72, 0x84, 0x06, 0x61, 0x3a, 0x82, 0xbc, 0x09, 0x8d, 0x84, 0xee,
+	0xd3, 0xd8, 0x63, 0xba, 0xe0, 0xe4, 0x98, 0xf2, 0xa4, 0x82, 0xa7, 0x0c, 0x34, 0x04, 0xcd, 0x18,
+	0x1e, 0x65, 0x71, 0x93, 0xe0, 0x7a, 0x41, 0x52, 0xfc, 0x32, 0xae, 0xab, 0xe0, 0x68, 0x28, 0x78,
+	0xa2, 0x4c, 0x15, 0xa4, 0x8a, 0x89, 0x32, 0x55, 0xb1, 0x42, 0x8d, 0xe7, 0xcd, 0x2e, 0x90, 0x9a,
+	0x3b, 0x72, 0x43, 0xd8, 0xee, 0x98, 0x9d, 0xe0, 0xdc, 0x6b, 0x03, 0x1d, 0x33, 0x94, 0x3c, 0xc8,
+	0x75, 0x98, 0x4b, 0x58, 0x18, 0x9d, 0x20, 0xd5, 0x28, 0x2f, 0x33, 0x16, 0x46, 0x28, 0x38, 0x38,
+	0xdf, 0xaf, 0xc2, 0xbc, 0xca, 0xdb, 0x1e, 0x21, 0x24, 0xcd, 0x86, 0x45, 0x33, 0x6b, 0x10, 0x51,
+	0x1f, 0x14, 0x1c, 0x16, 0x16, 0xed, 0xa5, 0xb9, 0xc9, 0xea, 0xac, 0x7e, 0xa1, 0xa0, 0x35, 0x35,
+	0xb5, 0xf9, 0x91, 0x05, 0xa7, 0x62, 0x1a, 0xf9, 0xa6, 0x5b, 0xc0, 0x9e, 0x2b, 0x1b, 0x87, 0xe5,
+	0x9a, 0x0f, 0x3a, 0x4b, 0xbc, 0xf7, 0x21, 0x07, 0xc2, 0xbc, 0x40, 0xe7, 0xef, 0x2b, 0x50, 0xbd,
+	0x83, 0x1b, 0xa2, 0x52, 0xcb, 0xbf, 0x37, 0xa7, 0x13, 0x7d, 0x46, 0x02, 0x8a, 0x0a, 0xcb, 0xb7,
+	0x8c, 0x97, 0x3c, 0x8b, 0x7d, 0x46, 0xbc, 0x20, 0x8a, 0x02, 0xc3, 0xf5, 0xdb, 0x14, 0x42, 0x0b,
+	0xfa, 0x3d, 0xa5, 0xca, 0x79, 0x11, 0xe6, 0x78, 0xb9, 0xb1, 0xf8, 0x5d, 0x1a, 0xaf, 0x16, 0xa2,
+	0xc0, 0x70, 0x8a, 0x28, 0x8c, 0x65, 0x8f, 0x72, 0xa6, 0x4b, 0x7b, 0x2b, 0x8c, 0x19, 0x0a, 0x8c,
+	0xe9, 0x7d, 0xaa, 0xff, 0xaa, 0xbe, 0xda, 0x6f, 0x8c, 0x69, 0xac, 0x4b, 0x9e, 0x26, 0xe1, 0xfb,
+	0x36, 0x07, 0xa2, 0xc4, 0xf1, 0x89, 0xef, 0xc6, 0xee, 0x90, 0x57, 0xd3, 0xec, 0x46, 0x7e, 0xe2,
+	0xeb, 0x0a, 0x8e, 0x86, 0xc2, 0xe9, 0x43, 0x2b, 0xf3, 0xcb, 0x43, 0x47, 0xe8, 0xed, 0xbd, 0x04,
+	0xc0, 0x2d, 0xc0, 0xee, 0x41, 0x9f, 0xc6, 0xfa, 0xb7, 0x84, 0x8c, 0xe9, 0xbb, 0x2b, 0x30, 0x5d,
+	0x1a, 0x33, 0xcc, 0x50, 0xf1, 0x1f, 0x25, 0xc9, 0x05, 0xd6, 0xc7, 0x6f, 0x70, 0x38, 0xca, 0xf7,
+	0x89, 0x9d, 0xf6, 0xa7, 0x9f, 0x5d, 0x78, 0xe6, 0x67, 0x9f, 0x5d, 0x78, 0xe6, 0xe7, 0x9f, 0x5d,
+	0x78, 0xe6, 0xa3, 0xc7, 0x17, 0xac, 0x4f, 0x1f, 0x5f, 0xb0, 0x7e, 0xf6, 0xf8, 0x82, 0xf5, 0xf3,
+	0xc7, 0x17, 0xac, 0xff, 0x78, 0x7c, 0xc1, 0xfa, 0xe4, 0x3f, 0x2f, 0x3c, 0xf3, 0x4e, 0x43, 0x2b,
+	0xd9, 0xff, 0x0e, 0x00, 0xc1, 0x7c, 0x23, 0x47, 0xf7, 0x50, 0x00, 0x00,
 }
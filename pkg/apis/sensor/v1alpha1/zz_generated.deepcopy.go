@@ -20,9 +20,92 @@ limitations under the License.
 package v1alpha1
 
 import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Aggregate) DeepCopyInto(out *Aggregate) {
+	*out = *in
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+	in.WindowEnd.DeepCopyInto(&out.WindowEnd)
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]AggregateSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Aggregate.
+func (in *Aggregate) DeepCopy() *Aggregate {
+	if in == nil {
+		return nil
+	}
+	out := new(Aggregate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregateSample) DeepCopyInto(out *AggregateSample) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregateSample.
+func (in *AggregateSample) DeepCopy() *AggregateSample {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregateSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Aggregation) DeepCopyInto(out *Aggregation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Aggregation.
+func (in *Aggregation) DeepCopy() *Aggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(Aggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSignal) DeepCopyInto(out *AlertmanagerSignal) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSignal.
+func (in *AlertmanagerSignal) DeepCopy() *AlertmanagerSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -59,11 +142,32 @@ func (in *ArtifactLocation) DeepCopy() *ArtifactLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactPoll) DeepCopyInto(out *ArtifactPoll) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactPoll.
+func (in *ArtifactPoll) DeepCopy() *ArtifactPoll {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPoll)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactSignal) DeepCopyInto(out *ArtifactSignal) {
 	*out = *in
 	in.ArtifactLocation.DeepCopyInto(&out.ArtifactLocation)
 	in.Target.DeepCopyInto(&out.Target)
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(ArtifactPoll)
+		**out = **in
+	}
 	return
 }
 
@@ -85,6 +189,16 @@ func (in *CalendarSignal) DeepCopyInto(out *CalendarSignal) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CatchUp != nil {
+		in, out := &in.CatchUp, &out.CatchUp
+		*out = new(CatchUpPolicy)
+		**out = **in
+	}
+	if in.ICalendar != nil {
+		in, out := &in.ICalendar, &out.ICalendar
+		*out = new(ICalendarSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -98,6 +212,38 @@ func (in *CalendarSignal) DeepCopy() *CalendarSignal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatchUpPolicy) DeepCopyInto(out *CatchUpPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatchUpPolicy.
+func (in *CatchUpPolicy) DeepCopy() *CatchUpPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CatchUpPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsSignal) DeepCopyInto(out *CloudEventsSignal) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsSignal.
+func (in *CloudEventsSignal) DeepCopy() *CloudEventsSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataFilter) DeepCopyInto(out *DataFilter) {
 	*out = *in
@@ -220,6 +366,112 @@ func (in *FileArtifact) DeepCopy() *FileArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSignal) DeepCopyInto(out *FileSignal) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]FileOperation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSignal.
+func (in *FileSignal) DeepCopy() *FileSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCSignal) DeepCopyInto(out *GRPCSignal) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCommonNames != nil {
+		in, out := &in.ClientCommonNames, &out.ClientCommonNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCSignal.
+func (in *GRPCSignal) DeepCopy() *GRPCSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRefs) DeepCopyInto(out *GitRefs) {
+	*out = *in
+	if in.Refs != nil {
+		in, out := &in.Refs, &out.Refs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRefs.
+func (in *GitRefs) DeepCopy() *GitRefs {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRefs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSignal) DeepCopyInto(out *GitSignal) {
+	*out = *in
+	if in.Refs != nil {
+		in, out := &in.Refs, &out.Refs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKey != nil {
+		in, out := &in.SSHKey, &out.SSHKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSignal.
+func (in *GitSignal) DeepCopy() *GitSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupVersionKind) DeepCopyInto(out *GroupVersionKind) {
 	*out = *in
@@ -236,6 +488,145 @@ func (in *GroupVersionKind) DeepCopy() *GroupVersionKind {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBasicAuth) DeepCopyInto(out *HTTPBasicAuth) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBasicAuth.
+func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPollSignal) DeepCopyInto(out *HTTPPollSignal) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(HTTPBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*DataFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DataFilter)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPollSignal.
+func (in *HTTPPollSignal) DeepCopy() *HTTPPollSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPollSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICalendarSource) DeepCopyInto(out *ICalendarSource) {
+	*out = *in
+	in.Location.DeepCopyInto(&out.Location)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICalendarSource.
+func (in *ICalendarSource) DeepCopy() *ICalendarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ICalendarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeEventsInvolvedObject) DeepCopyInto(out *KubeEventsInvolvedObject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeEventsInvolvedObject.
+func (in *KubeEventsInvolvedObject) DeepCopy() *KubeEventsInvolvedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeEventsInvolvedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeEventsSignal) DeepCopyInto(out *KubeEventsSignal) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvolvedObject != nil {
+		in, out := &in.InvolvedObject, &out.InvolvedObject
+		*out = new(KubeEventsInvolvedObject)
+		**out = **in
+	}
+	if in.SourceComponents != nil {
+		in, out := &in.SourceComponents, &out.SourceComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeEventsSignal.
+func (in *KubeEventsSignal) DeepCopy() *KubeEventsSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeEventsSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Message) DeepCopyInto(out *Message) {
 	*out = *in
@@ -263,6 +654,11 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(EventWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Aggregate != nil {
+		in, out := &in.Aggregate, &out.Aggregate
+		*out = new(Aggregate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -276,6 +672,90 @@ func (in *NodeStatus) DeepCopy() *NodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresPosition) DeepCopyInto(out *PostgresPosition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresPosition.
+func (in *PostgresPosition) DeepCopy() *PostgresPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresReplication) DeepCopyInto(out *PostgresReplication) {
+	*out = *in
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresReplication.
+func (in *PostgresReplication) DeepCopy() *PostgresReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresSignal) DeepCopyInto(out *PostgresSignal) {
+	*out = *in
+	if in.ConnectionString != nil {
+		in, out := &in.ConnectionString, &out.ConnectionString
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(PostgresReplication)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresSignal.
+func (in *PostgresSignal) DeepCopy() *PostgresSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFieldChange) DeepCopyInto(out *ResourceFieldChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFieldChange.
+func (in *ResourceFieldChange) DeepCopy() *ResourceFieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceFilter) DeepCopyInto(out *ResourceFilter) {
 	*out = *in
@@ -294,6 +774,11 @@ func (in *ResourceFilter) DeepCopyInto(out *ResourceFilter) {
 		}
 	}
 	in.CreatedBy.DeepCopyInto(&out.CreatedBy)
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]ResourceEventType, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -390,6 +875,16 @@ func (in *ResourceSignal) DeepCopyInto(out *ResourceSignal) {
 		*out = new(ResourceFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FieldChanges != nil {
+		in, out := &in.FieldChanges, &out.FieldChanges
+		*out = make([]ResourceFieldChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -475,6 +970,68 @@ func (in *S3Filter) DeepCopy() *S3Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SFTPSignal) DeepCopyInto(out *SFTPSignal) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SFTPSignal.
+func (in *SFTPSignal) DeepCopy() *SFTPSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(SFTPSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMTPSignal) DeepCopyInto(out *SMTPSignal) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Senders != nil {
+		in, out := &in.Senders, &out.Senders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = new(ArtifactLocation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPSignal.
+func (in *SMTPSignal) DeepCopy() *SMTPSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sensor) DeepCopyInto(out *Sensor) {
 	*out = *in
@@ -583,6 +1140,34 @@ func (in *SensorStatus) DeepCopyInto(out *SensorStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.LastEventTimes != nil {
+		in, out := &in.LastEventTimes, &out.LastEventTimes
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ArtifactFingerprints != nil {
+		in, out := &in.ArtifactFingerprints, &out.ArtifactFingerprints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GitRefs != nil {
+		in, out := &in.GitRefs, &out.GitRefs
+		*out = make(map[string]GitRefs, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.PostgresPositions != nil {
+		in, out := &in.PostgresPositions, &out.PostgresPositions
+		*out = make(map[string]PostgresPosition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -625,6 +1210,71 @@ func (in *Signal) DeepCopyInto(out *Signal) {
 		**out = **in
 	}
 	in.Filters.DeepCopyInto(&out.Filters)
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPPoll != nil {
+		in, out := &in.HTTPPoll, &out.HTTPPoll
+		*out = new(HTTPPollSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudEvents != nil {
+		in, out := &in.CloudEvents, &out.CloudEvents
+		*out = new(CloudEventsSignal)
+		**out = **in
+	}
+	if in.KubeEvents != nil {
+		in, out := &in.KubeEvents, &out.KubeEvents
+		*out = new(KubeEventsSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alertmanager != nil {
+		in, out := &in.Alertmanager, &out.Alertmanager
+		*out = new(AlertmanagerSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Postgres != nil {
+		in, out := &in.Postgres, &out.Postgres
+		*out = new(PostgresSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(SMTPSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SFTP != nil {
+		in, out := &in.SFTP, &out.SFTP
+		*out = new(SFTPSignal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(Aggregation)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(SignalState)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -675,6 +1325,38 @@ func (in *SignalFilter) DeepCopy() *SignalFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignalState) DeepCopyInto(out *SignalState) {
+	*out = *in
+	if in.LastFired != nil {
+		in, out := &in.LastFired, &out.LastFired
+		*out = (*in).DeepCopy()
+	}
+	if in.GitRefs != nil {
+		in, out := &in.GitRefs, &out.GitRefs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PostgresPosition != nil {
+		in, out := &in.PostgresPosition, &out.PostgresPosition
+		*out = new(PostgresPosition)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignalState.
+func (in *SignalState) DeepCopy() *SignalState {
+	if in == nil {
+		return nil
+	}
+	out := new(SignalState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stream) DeepCopyInto(out *Stream) {
 	*out = *in
@@ -698,6 +1380,27 @@ func (in *Stream) DeepCopy() *Stream {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogSignal) DeepCopyInto(out *SyslogSignal) {
+	*out = *in
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]SyslogProtocol, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogSignal.
+func (in *SyslogSignal) DeepCopy() *SyslogSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeFilter) DeepCopyInto(out *TimeFilter) {
 	*out = *in
@@ -28,6 +28,23 @@ const (
 	CloudEventsKey           key    = 0
 	CloudEventsVersion       string = "v1.0"
 	ContextExtensionErrorKey string = "error"
+
+	// ContextExtensionFingerprintKey is the event context extension key for the fingerprint of a polled artifact
+	// the sensor controller persists the fingerprint of the latest accepted event in the sensor status.
+	ContextExtensionFingerprintKey string = "fingerprint"
+
+	// ContextExtensionGitRefKey and ContextExtensionGitCommitKey are the event context extension keys for the ref and
+	// the commit of a git event. The sensor controller persists the commit of the latest accepted event of each ref.
+	ContextExtensionGitRefKey    string = "ref"
+	ContextExtensionGitCommitKey string = "commit"
+
+	// ContextExtensionPostgresLSNKey, ContextExtensionPostgresRowKey and ContextExtensionPostgresRowsKey are the event
+	// context extension keys for the log sequence number of the transaction of a postgres row change, the index of the
+	// row change in the transaction and the number of row changes of the transaction.
+	// The sensor controller persists the position of the latest accepted event.
+	ContextExtensionPostgresLSNKey  string = "lsn"
+	ContextExtensionPostgresRowKey  string = "row"
+	ContextExtensionPostgresRowsKey string = "rows"
 )
 
 const (
@@ -46,6 +63,14 @@ type Listener interface {
 	Listen(*v1alpha1.Signal, <-chan struct{}) (<-chan *v1alpha1.Event, error)
 }
 
+// StatefulListener is implemented by the listeners which are notified of the state of their signal while they listen,
+// e.g. to acknowledge the accepted events to their source. The sensor controller sends the state of the signal
+// on the states channel once it persisted the state of an accepted event.
+type StatefulListener interface {
+	Listener
+	ListenWithState(signal *v1alpha1.Signal, states <-chan *v1alpha1.SignalState, done <-chan struct{}) (<-chan *v1alpha1.Event, error)
+}
+
 // SignalClient is the interface for signal clients
 type SignalClient interface {
 	Ping(context.Context) error
@@ -56,5 +81,5 @@ type SignalClient interface {
 // SignalServer is the interface for signal servers
 type SignalServer interface {
 	SignalServiceHandler
-	handshake(SignalService_ListenStream, <-chan struct{}) (<-chan *v1alpha1.Event, error)
+	handshake(SignalService_ListenStream, <-chan *v1alpha1.SignalState, <-chan struct{}) (<-chan *v1alpha1.Event, error)
 }
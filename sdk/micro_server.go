@@ -29,9 +29,11 @@ func (m *microSignalServer) Ping(ctx context.Context, in *google_protobuf.Empty,
 // Listen implements the SignalServiceHandler interface
 func (m *microSignalServer) Listen(ctx context.Context, stream SignalService_ListenStream) error {
 	done := make(chan struct{})
+	// the states are only received by stateful listeners, which only need the latest one
+	states := make(chan *v1alpha1.SignalState, 1)
 
 	// perform the initial handshake
-	events, err := m.handshake(stream, done)
+	events, err := m.handshake(stream, states, done)
 	if err != nil {
 		return err
 	}
@@ -50,6 +52,9 @@ func (m *microSignalServer) Listen(ctx context.Context, stream SignalService_Lis
 			if sigCtx.Done {
 				close(done)
 			}
+			if sigCtx.State != nil {
+				sendState(states, sigCtx.State)
+			}
 		}
 	}()
 	for {
@@ -73,7 +78,7 @@ func (m *microSignalServer) Listen(ctx context.Context, stream SignalService_Lis
 
 // Handshake performs the initial handshake for the signal server.
 // This blocks until the initial receive and send have completed or an error is encountered.
-func (m *microSignalServer) handshake(stream SignalService_ListenStream, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+func (m *microSignalServer) handshake(stream SignalService_ListenStream, states <-chan *v1alpha1.SignalState, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
 	sigCtx, err := stream.Recv()
 	if err != nil {
 		return nil, err
@@ -81,7 +86,12 @@ func (m *microSignalServer) handshake(stream SignalService_ListenStream, done <-
 	if sigCtx.Done {
 		return nil, errors.New("signal context done before started")
 	}
-	events, err := m.impl.Listen(sigCtx.Signal.DeepCopy(), done)
+	var events <-chan *v1alpha1.Event
+	if lis, ok := m.impl.(StatefulListener); ok {
+		events, err = lis.ListenWithState(sigCtx.Signal.DeepCopy(), states, done)
+	} else {
+		events, err = m.impl.Listen(sigCtx.Signal.DeepCopy(), done)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -91,3 +101,17 @@ func (m *microSignalServer) handshake(stream SignalService_ListenStream, done <-
 	}
 	return events, nil
 }
+
+// sendState sends the state on the channel without blocking, replacing the previous state if it was not received yet
+// the receive goroutine is the only sender, so the channel has room once the previous state is removed.
+func sendState(states chan *v1alpha1.SignalState, state *v1alpha1.SignalState) {
+	select {
+	case states <- state:
+	default:
+		select {
+		case <-states:
+		default:
+		}
+		states <- state
+	}
+}
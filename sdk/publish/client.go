@@ -0,0 +1,147 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publish
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// AuthorizationMetadataKey is the key of the metadata of the bearer tokens of the callers
+const AuthorizationMetadataKey = "authorization"
+
+// Client publishes events to the gRPC signals
+type Client struct {
+	conn   *grpc.ClientConn
+	client PublishServiceClient
+}
+
+// Option configures the connection of a client
+type Option func(*options)
+
+type options struct {
+	tlsConfig *tls.Config
+	token     string
+	dialOpts  []grpc.DialOption
+}
+
+// WithTLS secures the connection with TLS, the config contains the certificate of the client for mutual TLS
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithToken authenticates the client with the token of the signals
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// WithDialOptions adds options to the gRPC connection, e.g. to block until the connection is established
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOpts = append(o.dialOpts, opts...)
+	}
+}
+
+// NewClient creates a new client of the gRPC signal service at the target, e.g. grpc.default:7073
+// the connection is insecure unless a TLS config is specified.
+func NewClient(target string, opts ...Option) (*Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	dialOpts := o.dialOpts
+	if o.tlsConfig != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(o.tlsConfig)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	if o.token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(&tokenCredentials{token: o.token, secure: o.tlsConfig != nil}))
+	}
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, client: NewPublishServiceClient(conn)}, nil
+}
+
+// Publish publishes the event to the signal
+// the event is accepted once it is sent to the sensor listening to the signal.
+func (c *Client) Publish(ctx context.Context, signal string, event *v1alpha1.Event) error {
+	_, err := c.client.Publish(ctx, &PublishRequest{Signal: signal, Event: event})
+	return err
+}
+
+// NewStream opens a stream to publish events to the signals
+func (c *Client) NewStream(ctx context.Context) (*Stream, error) {
+	stream, err := c.client.PublishStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Stream{stream: stream}, nil
+}
+
+// Close closes the connection of the client
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Stream publishes events to the signals over a single gRPC stream
+type Stream struct {
+	stream PublishService_PublishStreamClient
+}
+
+// Publish publishes the event to the signal
+// the events are sent without waiting for their acceptance. Once an event is not accepted, the stream fails
+// and io.EOF is returned, the error of the signal is then returned by Close.
+func (s *Stream) Publish(signal string, event *v1alpha1.Event) error {
+	return s.stream.Send(&PublishRequest{Signal: signal, Event: event})
+}
+
+// Close closes the stream and returns the number of accepted events
+// if an event was not accepted, the stream failed and the error is returned.
+func (s *Stream) Close() (int32, error) {
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	return resp.Accepted, nil
+}
+
+// tokenCredentials sends the token as a bearer token in the authorization metadata of the calls
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{AuthorizationMetadataKey: "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity only requires TLS if the client is configured with TLS,
+// e.g. the token can be sent over an insecure connection within the cluster.
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
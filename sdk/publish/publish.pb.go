@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sdk/publish/publish.proto
+
+package publish // import "github.com/argoproj/argo-events/sdk/publish"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import v1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type PublishRequest struct {
+	// signal is the name of the gRPC signal the event is routed to
+	Signal               string          `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	Event                *v1alpha1.Event `protobuf:"bytes,2,opt,name=event" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PublishRequest) Reset()         { *m = PublishRequest{} }
+func (m *PublishRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishRequest) ProtoMessage()    {}
+func (*PublishRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_publish_68217fa90c8c4447, []int{0}
+}
+func (m *PublishRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PublishRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishRequest.Merge(dst, src)
+}
+func (m *PublishRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishRequest proto.InternalMessageInfo
+
+func (m *PublishRequest) GetSignal() string {
+	if m != nil {
+		return m.Signal
+	}
+	return ""
+}
+
+func (m *PublishRequest) GetEvent() *v1alpha1.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type PublishResponse struct {
+	// accepted is the number of events accepted by the signals
+	Accepted             int32    `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
+func (m *PublishResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishResponse) ProtoMessage()    {}
+func (*PublishResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_publish_68217fa90c8c4447, []int{1}
+}
+func (m *PublishResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PublishResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishResponse.Merge(dst, src)
+}
+func (m *PublishResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishResponse proto.InternalMessageInfo
+
+func (m *PublishResponse) GetAccepted() int32 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PublishRequest)(nil), "publish.PublishRequest")
+	proto.RegisterType((*PublishResponse)(nil), "publish.PublishResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PublishService service
+
+type PublishServiceClient interface {
+	// Publish an event to a signal.
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
+	// PublishStream publishes a stream of events, which may be routed to different signals.
+	// The response is sent once the client closes the stream, or the stream fails on the first
+	// event which is not accepted.
+	PublishStream(ctx context.Context, opts ...grpc.CallOption) (PublishService_PublishStreamClient, error)
+}
+
+type publishServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPublishServiceClient(cc *grpc.ClientConn) PublishServiceClient {
+	return &publishServiceClient{cc}
+}
+
+func (c *publishServiceClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, "/publish.PublishService/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publishServiceClient) PublishStream(ctx context.Context, opts ...grpc.CallOption) (PublishService_PublishStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PublishService_serviceDesc.Streams[0], "/publish.PublishService/PublishStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publishServicePublishStreamClient{stream}
+	return x, nil
+}
+
+type PublishService_PublishStreamClient interface {
+	Send(*PublishRequest) error
+	CloseAndRecv() (*PublishResponse, error)
+	grpc.ClientStream
+}
+
+type publishServicePublishStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *publishServicePublishStreamClient) Send(m *PublishRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publishServicePublishStreamClient) CloseAndRecv() (*PublishResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PublishResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for PublishService service
+
+type PublishServiceServer interface {
+	// Publish an event to a signal.
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
+	// PublishStream publishes a stream of events, which may be routed to different signals.
+	// The response is sent once the client closes the stream, or the stream fails on the first
+	// event which is not accepted.
+	PublishStream(PublishService_PublishStreamServer) error
+}
+
+func RegisterPublishServiceServer(s *grpc.Server, srv PublishServiceServer) {
+	s.RegisterService(&_PublishService_serviceDesc, srv)
+}
+
+func _PublishService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublishServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publish.PublishService/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublishServiceServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublishService_PublishStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublishServiceServer).PublishStream(&publishServicePublishStreamServer{stream})
+}
+
+type PublishService_PublishStreamServer interface {
+	SendAndClose(*PublishResponse) error
+	Recv() (*PublishRequest, error)
+	grpc.ServerStream
+}
+
+type publishServicePublishStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *publishServicePublishStreamServer) SendAndClose(m *PublishResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publishServicePublishStreamServer) Recv() (*PublishRequest, error) {
+	m := new(PublishRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _PublishService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "publish.PublishService",
+	HandlerType: (*PublishServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _PublishService_Publish_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PublishStream",
+			Handler:       _PublishService_PublishStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "sdk/publish/publish.proto",
+}
+
+func (m *PublishRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signal) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublish(dAtA, i, uint64(len(m.Signal)))
+		i += copy(dAtA[i:], m.Signal)
+	}
+	if m.Event != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPublish(dAtA, i, uint64(m.Event.Size()))
+		n1, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PublishResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Accepted != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPublish(dAtA, i, uint64(m.Accepted))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintPublish(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PublishRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovPublish(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovPublish(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Accepted != 0 {
+		n += 1 + sovPublish(uint64(m.Accepted))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPublish(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPublish(x uint64) (n int) {
+	return sovPublish(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublish
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublish
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublish
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublish
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublish
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &v1alpha1.Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublish(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublish
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublish
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			m.Accepted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublish
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accepted |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublish(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublish
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPublish(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPublish
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPublish
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPublish
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthPublish
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPublish
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPublish(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPublish = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPublish   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("sdk/publish/publish.proto", fileDescriptor_publish_68217fa90c8c4447) }
+
+var fileDescriptor_publish_68217fa90c8c4447 = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4a, 0xf4, 0x30,
+	0x14, 0x86, 0xbf, 0x7c, 0x30, 0x33, 0x1a, 0x51, 0x21, 0x0b, 0xad, 0x5d, 0x94, 0x61, 0x56, 0x05,
+	0x99, 0x84, 0x19, 0xb7, 0x8a, 0x20, 0x0a, 0x2e, 0xa5, 0xe2, 0xc6, 0x8d, 0xa4, 0xed, 0x21, 0xad,
+	0xed, 0x34, 0x31, 0x49, 0xbb, 0xf5, 0x26, 0x5c, 0x78, 0x49, 0x2e, 0xbd, 0x04, 0xa9, 0x37, 0x22,
+	0xd3, 0x3f, 0x15, 0x17, 0x82, 0xab, 0x9e, 0xb7, 0x07, 0x9e, 0x3c, 0xc9, 0x8b, 0x0f, 0x4c, 0x9c,
+	0x31, 0x55, 0x86, 0x79, 0x6a, 0x92, 0xfe, 0x4b, 0x95, 0x96, 0x56, 0x92, 0x49, 0x17, 0xdd, 0x4b,
+	0x91, 0xda, 0xa4, 0x0c, 0x69, 0x24, 0x57, 0x8c, 0x6b, 0x21, 0x95, 0x96, 0xf7, 0xcd, 0x30, 0x87,
+	0x0a, 0x0a, 0x6b, 0x98, 0xca, 0x04, 0xe3, 0x2a, 0x35, 0xcc, 0x40, 0x61, 0xa4, 0x66, 0xd5, 0x82,
+	0xe7, 0x2a, 0xe1, 0x0b, 0x26, 0xa0, 0x00, 0xcd, 0x2d, 0xc4, 0x2d, 0x72, 0xf6, 0x88, 0x77, 0xae,
+	0x5a, 0x68, 0x00, 0x0f, 0x25, 0x18, 0x4b, 0xf6, 0xf0, 0xd8, 0xa4, 0xa2, 0xe0, 0xb9, 0x83, 0xa6,
+	0xc8, 0xdf, 0x0c, 0xba, 0x44, 0x6e, 0xf0, 0xa8, 0x81, 0x3b, 0xff, 0xa7, 0xc8, 0xdf, 0x5a, 0x9e,
+	0xd2, 0x4f, 0x07, 0xda, 0x3b, 0x34, 0xc3, 0x5d, 0xeb, 0x40, 0x55, 0x26, 0xe8, 0xda, 0x81, 0xb6,
+	0x0e, 0xb4, 0x77, 0xa0, 0x17, 0xeb, 0x7d, 0xd0, 0xd2, 0x66, 0x73, 0xbc, 0x3b, 0x08, 0x18, 0x25,
+	0x0b, 0x03, 0xc4, 0xc5, 0x1b, 0x3c, 0x8a, 0x40, 0x59, 0x88, 0x1b, 0x87, 0x51, 0x30, 0xe4, 0xe5,
+	0x13, 0x1a, 0x84, 0xaf, 0x41, 0x57, 0x69, 0x04, 0xe4, 0x18, 0x4f, 0xba, 0x3f, 0x64, 0x9f, 0xf6,
+	0x0f, 0xf6, 0xfd, 0x52, 0xae, 0xf3, 0x73, 0xd1, 0x1d, 0x76, 0x8e, 0xb7, 0x7b, 0x9e, 0xd5, 0xc0,
+	0x57, 0x7f, 0x60, 0xf8, 0xe8, 0xec, 0xe4, 0xa5, 0xf6, 0xd0, 0x6b, 0xed, 0xa1, 0xb7, 0xda, 0x43,
+	0xcf, 0xef, 0xde, 0xbf, 0xdb, 0xc3, 0xdf, 0x2a, 0xfa, 0x52, 0x73, 0x38, 0x6e, 0xca, 0x38, 0xfa,
+	0x18, 0x00, 0xe5, 0xe4, 0x81, 0x64, 0xfc, 0x01, 0x00, 0x00,
+}
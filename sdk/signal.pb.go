@@ -26,18 +26,19 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type SignalContext struct {
-	Signal               *v1alpha1.Signal `protobuf:"bytes,1,opt,name=signal" json:"signal,omitempty"`
-	Done                 bool             `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Signal               *v1alpha1.Signal      `protobuf:"bytes,1,opt,name=signal" json:"signal,omitempty"`
+	Done                 bool                  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	State                *v1alpha1.SignalState `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *SignalContext) Reset()         { *m = SignalContext{} }
 func (m *SignalContext) String() string { return proto.CompactTextString(m) }
 func (*SignalContext) ProtoMessage()    {}
 func (*SignalContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signal_3ee01d8cbc721879, []int{0}
+	return fileDescriptor_signal_eaf68ea57fd369ba, []int{0}
 }
 func (m *SignalContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,6 +81,13 @@ func (m *SignalContext) GetDone() bool {
 	return false
 }
 
+func (m *SignalContext) GetState() *v1alpha1.SignalState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
 type EventContext struct {
 	Event                *v1alpha1.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
 	Done                 bool            `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
@@ -92,7 +100,7 @@ func (m *EventContext) Reset()         { *m = EventContext{} }
 func (m *EventContext) String() string { return proto.CompactTextString(m) }
 func (*EventContext) ProtoMessage()    {}
 func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signal_3ee01d8cbc721879, []int{1}
+	return fileDescriptor_signal_eaf68ea57fd369ba, []int{1}
 }
 func (m *EventContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,8 +167,9 @@ type SignalServiceClient interface {
 	// a confirmation in the form of done=true to signify that the Signal is set up
 	// correctly.
 	//
-	// Later sends should only signify if the signal is done. Later sends do not
-	// update the signal process attached to this channel.
+	// Later sends should only signify if the signal is done or carry the state of
+	// the signal persisted by the sensor controller once it accepted an event.
+	// Later sends do not update the signal process attached to this channel.
 	Listen(ctx context.Context, opts ...grpc.CallOption) (SignalService_ListenClient, error)
 	// Ping the signal service.
 	// This is used on the client-side to monitor the presence of signal services.
@@ -226,8 +235,9 @@ type SignalServiceServer interface {
 	// a confirmation in the form of done=true to signify that the Signal is set up
 	// correctly.
 	//
-	// Later sends should only signify if the signal is done. Later sends do not
-	// update the signal process attached to this channel.
+	// Later sends should only signify if the signal is done or carry the state of
+	// the signal persisted by the sensor controller once it accepted an event.
+	// Later sends do not update the signal process attached to this channel.
 	Listen(SignalService_ListenServer) error
 	// Ping the signal service.
 	// This is used on the client-side to monitor the presence of signal services.
@@ -337,6 +347,16 @@ func (m *SignalContext) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.State != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSignal(dAtA, i, uint64(m.State.Size()))
+		n2, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -362,11 +382,11 @@ func (m *EventContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSignal(dAtA, i, uint64(m.Event.Size()))
-		n2, err := m.Event.MarshalTo(dAtA[i:])
+		n3, err := m.Event.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.Done {
 		dAtA[i] = 0x10
@@ -403,6 +423,10 @@ func (m *SignalContext) Size() (n int) {
 	if m.Done {
 		n += 2
 	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovSignal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -520,6 +544,39 @@ func (m *SignalContext) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Done = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &v1alpha1.SignalState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSignal(dAtA[iNdEx:])
@@ -751,29 +808,30 @@ var (
 	ErrIntOverflowSignal   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("sdk/signal.proto", fileDescriptor_signal_3ee01d8cbc721879) }
-
-var fileDescriptor_signal_3ee01d8cbc721879 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
-	0x14, 0x45, 0x8d, 0xd6, 0x22, 0x51, 0x41, 0xb3, 0x90, 0x32, 0xc2, 0x50, 0xea, 0xa6, 0x1b, 0x5f,
-	0x6c, 0x8b, 0x6e, 0x15, 0xa5, 0xe0, 0xc2, 0x85, 0xb4, 0x08, 0xe2, 0x46, 0xa6, 0x9d, 0x67, 0x3a,
-	0x4e, 0x9b, 0x84, 0x24, 0x2d, 0x16, 0xf4, 0x3f, 0xfc, 0x24, 0x97, 0x7e, 0x82, 0xd4, 0x1f, 0x91,
-	0x26, 0x1d, 0x54, 0x50, 0x04, 0x77, 0x97, 0xcb, 0x70, 0xce, 0x9b, 0x5c, 0xba, 0x65, 0xd3, 0x9c,
-	0xdb, 0x4c, 0xc8, 0x64, 0x08, 0xda, 0x28, 0xa7, 0xd8, 0x8a, 0x4d, 0xf3, 0x68, 0x57, 0x28, 0x25,
-	0x86, 0xc8, 0x7d, 0xd5, 0x1b, 0xdf, 0x71, 0x1c, 0x69, 0x37, 0x0d, 0x5f, 0x44, 0xe7, 0x22, 0x73,
-	0x83, 0x71, 0x0f, 0xfa, 0x6a, 0xc4, 0x13, 0x23, 0x94, 0x36, 0xea, 0xde, 0x87, 0x7d, 0x9c, 0xa0,
-	0x74, 0x96, 0xeb, 0x5c, 0xf0, 0x44, 0x67, 0x96, 0x5b, 0x94, 0x56, 0x19, 0x3e, 0x69, 0x24, 0x43,
-	0x3d, 0x48, 0x1a, 0x5c, 0xa0, 0x44, 0x93, 0x38, 0x4c, 0x03, 0xa9, 0xf6, 0x44, 0x37, 0xbb, 0xde,
-	0x7d, 0xa6, 0xa4, 0xc3, 0x07, 0xc7, 0xae, 0x69, 0x39, 0x1c, 0x53, 0x21, 0x55, 0x52, 0x5f, 0x6f,
-	0x9e, 0xc0, 0xa7, 0x0b, 0x0a, 0x97, 0x0f, 0xb7, 0xc1, 0x05, 0x3a, 0x17, 0x30, 0x77, 0x41, 0x70,
-	0x41, 0xe1, 0x82, 0x00, 0xee, 0x2c, 0x78, 0x8c, 0xd1, 0x52, 0xaa, 0x24, 0x56, 0x96, 0xab, 0xa4,
-	0xbe, 0xd6, 0xf1, 0xb9, 0x36, 0xa5, 0x1b, 0xed, 0x39, 0xa5, 0xb0, 0x5f, 0xd1, 0x55, 0x4f, 0x5d,
-	0xc8, 0x8f, 0xff, 0x2f, 0xf7, 0xd8, 0x4e, 0xa0, 0xfd, 0xa4, 0x6e, 0x3e, 0x16, 0x7f, 0xde, 0x45,
-	0x33, 0xc9, 0xfa, 0xc8, 0x5a, 0xb4, 0x7c, 0x91, 0x59, 0x87, 0x92, 0x31, 0xb0, 0x69, 0x0e, 0xdf,
-	0xde, 0x25, 0xda, 0xf6, 0xdd, 0xd7, 0x63, 0xeb, 0xe4, 0x80, 0xb0, 0x23, 0x5a, 0xba, 0xcc, 0xa4,
-	0x60, 0x3b, 0x10, 0xf6, 0x82, 0x62, 0x2f, 0x68, 0xcf, 0xf7, 0x8a, 0x7e, 0xe9, 0x4f, 0x0f, 0x5f,
-	0x66, 0x31, 0x79, 0x9d, 0xc5, 0xe4, 0x6d, 0x16, 0x93, 0xe7, 0xf7, 0x78, 0xe9, 0x66, 0xef, 0xaf,
-	0x4d, 0x6d, 0x9a, 0xf7, 0xca, 0x1e, 0xd3, 0xfa, 0x08, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x12, 0xe7,
-	0x6e, 0x35, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("sdk/signal.proto", fileDescriptor_signal_eaf68ea57fd369ba) }
+
+var fileDescriptor_signal_eaf68ea57fd369ba = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x4a, 0x03, 0x31,
+	0x10, 0x87, 0x8d, 0xfd, 0x83, 0x44, 0x05, 0xcd, 0x41, 0xca, 0x0a, 0x4b, 0xa9, 0x97, 0x5e, 0x9c,
+	0xd8, 0x16, 0xbd, 0x2a, 0x4a, 0xc1, 0x83, 0x07, 0xd9, 0x22, 0x88, 0x1e, 0x64, 0xdb, 0x1d, 0xd3,
+	0x75, 0xdb, 0x64, 0xd9, 0xa4, 0xc5, 0x82, 0x0f, 0xe2, 0x23, 0x79, 0xf4, 0xec, 0x49, 0xea, 0x8b,
+	0xc8, 0x26, 0x5d, 0x54, 0x50, 0x04, 0xbd, 0x0d, 0x43, 0xf2, 0x7d, 0x33, 0xc3, 0x8f, 0x6e, 0xe8,
+	0x28, 0xe1, 0x3a, 0x16, 0x32, 0x1c, 0x41, 0x9a, 0x29, 0xa3, 0x58, 0x49, 0x47, 0x89, 0xb7, 0x2d,
+	0x94, 0x12, 0x23, 0xe4, 0xb6, 0xd5, 0x9f, 0xdc, 0x72, 0x1c, 0xa7, 0x66, 0xe6, 0x5e, 0x78, 0xa7,
+	0x22, 0x36, 0xc3, 0x49, 0x1f, 0x06, 0x6a, 0xcc, 0xc3, 0x4c, 0xa8, 0x34, 0x53, 0x77, 0xb6, 0xd8,
+	0xc5, 0x29, 0x4a, 0xa3, 0x79, 0x9a, 0x08, 0x1e, 0xa6, 0xb1, 0xe6, 0x1a, 0xa5, 0x56, 0x19, 0x9f,
+	0xb6, 0xc2, 0x51, 0x3a, 0x0c, 0x5b, 0x5c, 0xa0, 0xc4, 0x2c, 0x34, 0x18, 0x39, 0x52, 0xe3, 0x85,
+	0xd0, 0xf5, 0x9e, 0x95, 0x9f, 0x28, 0x69, 0xf0, 0xde, 0xb0, 0x4b, 0x5a, 0x75, 0xd3, 0xd4, 0x48,
+	0x9d, 0x34, 0x57, 0xdb, 0x47, 0xf0, 0x21, 0x83, 0x42, 0x66, 0x8b, 0x1b, 0x27, 0x83, 0x34, 0x11,
+	0x90, 0xcb, 0xc0, 0xc9, 0xa0, 0x90, 0x81, 0x03, 0x07, 0x0b, 0x1e, 0x63, 0xb4, 0x1c, 0x29, 0x89,
+	0xb5, 0xe5, 0x3a, 0x69, 0xae, 0x04, 0xb6, 0x66, 0xd7, 0xb4, 0xa2, 0x4d, 0x68, 0xb0, 0x56, 0xb2,
+	0xb2, 0xee, 0x7f, 0x65, 0xbd, 0x1c, 0x16, 0x38, 0x66, 0x63, 0x46, 0xd7, 0xba, 0xf9, 0xaf, 0x62,
+	0xb5, 0x0b, 0x5a, 0xb1, 0x94, 0xc5, 0x66, 0x87, 0x7f, 0x97, 0x59, 0x6c, 0xe0, 0x68, 0xdf, 0xed,
+	0xd5, 0x7e, 0x28, 0xce, 0xda, 0xc3, 0x6c, 0x1a, 0x0f, 0x90, 0x75, 0x68, 0xf5, 0x2c, 0xd6, 0x06,
+	0x25, 0x63, 0xa0, 0xa3, 0x04, 0xbe, 0x1c, 0xdd, 0xdb, 0xb4, 0xbd, 0xcf, 0xc3, 0x36, 0xc9, 0x1e,
+	0x61, 0x07, 0xb4, 0x7c, 0x1e, 0x4b, 0xc1, 0xb6, 0xc0, 0xa5, 0x01, 0x8a, 0x34, 0x40, 0x37, 0x4f,
+	0x83, 0xf7, 0x43, 0xff, 0x78, 0xff, 0x69, 0xee, 0x93, 0xe7, 0xb9, 0x4f, 0x5e, 0xe7, 0x3e, 0x79,
+	0x7c, 0xf3, 0x97, 0xae, 0x76, 0x7e, 0x4b, 0x8c, 0x8e, 0x92, 0x7e, 0xd5, 0x62, 0x3a, 0xef, 0x03,
+	0x00, 0x31, 0x08, 0xa9, 0x64, 0x93, 0x02, 0x00, 0x00,
 }
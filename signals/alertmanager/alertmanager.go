@@ -0,0 +1,264 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContextExtensionLabelPrefix is the prefix of the event context extension keys of the labels of the alert
+	ContextExtensionLabelPrefix = "label."
+
+	// ContextExtensionAnnotationPrefix is the prefix of the event context extension keys of the annotations of the alert
+	ContextExtensionAnnotationPrefix = "annotation."
+
+	// ContextExtensionReceiverKey is the event context extension key of the receiver of the notification
+	ContextExtensionReceiverKey = "receiver"
+
+	// ContextExtensionAlertFingerprintKey is the event context extension key of the fingerprint of the alert
+	ContextExtensionAlertFingerprintKey = "alertFingerprint"
+
+	// the version of the webhook payloads which are supported
+	supportedVersion = "4"
+)
+
+// notification is the payload of the webhook receivers of Alertmanager
+// See https://prometheus.io/docs/alerting/configuration/#webhook_config
+type notification struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []alert           `json:"alerts"`
+}
+
+type alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint,omitempty"`
+}
+
+// eventData is the data of the event of an alert
+type eventData struct {
+	alert
+	Receiver    string            `json:"receiver"`
+	GroupKey    string            `json:"groupKey"`
+	GroupLabels map[string]string `json:"groupLabels"`
+	ExternalURL string            `json:"externalURL"`
+}
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// like webhooks, the alertmanager signals share one http server since the port is fixed at runtime.
+// The endpoints of the listening signals are registered with the server and removed when the signals stop.
+type alertmanager struct {
+	// endpoints are the endpoints of the listening signals by path
+	endpoints sync.Map
+}
+
+// endpoint receives the alerts of a signal
+type endpoint struct {
+	signal *v1alpha1.Signal
+	stream *common.EventStream
+}
+
+// New creates a new alertmanager listener serving the endpoints of the signals on the specified port
+func New(port int) sdk.Listener {
+	am := &alertmanager{}
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%v", port),
+		Handler: am,
+		// Good practice to enforce timeouts to avoid Slowloris attacks
+		WriteTimeout: time.Second * 5,
+		ReadTimeout:  time.Second * 5,
+		IdleTimeout:  time.Second * 30,
+	}
+	go func() {
+		log.Printf("starting http server listening on: %s", srv.Addr)
+		err := srv.ListenAndServe()
+		if err == http.ErrServerClosed {
+			log.Printf("successfully shutdown http server")
+		} else {
+			log.Panicf("http server encountered error listening: %v", err)
+		}
+	}()
+	return am
+}
+
+func (am *alertmanager) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	path := signal.Alertmanager.Endpoint
+	e := &endpoint{signal: signal, stream: common.NewEventStream(done)}
+	if existing, loaded := am.endpoints.LoadOrStore(path, e); loaded {
+		return nil, fmt.Errorf("endpoint %s is already used by signal '%s'", path, existing.(*endpoint).signal.Name)
+	}
+
+	go func() {
+		<-done
+		am.endpoints.Delete(path)
+		e.stream.Close()
+		log.Printf("signal '%s' stopped listening at [%s]", signal.Name, path)
+	}()
+	log.Printf("signal '%s' listening for alertmanager notifications at [%s]...", signal.Name, path)
+	return e.stream.Events(), nil
+}
+
+// ServeHTTP decodes the notification of the request and sends the events of its alerts to the signal of the endpoint
+// the request is only acknowledged once all the events were sent.
+func (am *alertmanager) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	value, ok := am.endpoints.Load(req.URL.Path)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	e := value.(*endpoint)
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var n notification
+	if err := json.NewDecoder(req.Body).Decode(&n); err != nil {
+		log.Warnf("signal '%s' received an invalid alertmanager notification: %s", e.signal.Name, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if n.Version != supportedVersion {
+		log.Warnf("signal '%s' received an alertmanager notification of version %s, expected version %s", e.signal.Name, n.Version, supportedVersion)
+	}
+	for _, a := range n.Alerts {
+		if !matches(e.signal.Alertmanager.Labels, a.Labels) {
+			log.Debugf("FILTERED: alert %s does not match the labels of signal '%s'", a.Labels["alertname"], e.signal.Name)
+			continue
+		}
+		event, err := newEvent(&n, a)
+		if err != nil {
+			log.Warnf("failed to create event of alert %s: %s", a.Labels["alertname"], err)
+			continue
+		}
+		if !e.stream.Send(event, req.Context().Done()) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// matches checks if the alert has all the expected labels
+func matches(expected, labels map[string]string) bool {
+	for name, value := range expected {
+		if actual, ok := labels[name]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func newEvent(n *notification, a alert) (*v1alpha1.Event, error) {
+	b, err := json.Marshal(eventData{
+		alert:       a,
+		Receiver:    n.Receiver,
+		GroupKey:    n.GroupKey,
+		GroupLabels: n.GroupLabels,
+		ExternalURL: n.ExternalURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	status := a.Status
+	if status == "" {
+		status = n.Status
+	}
+	eventTime := a.StartsAt
+	if status == "resolved" && !a.EndsAt.IsZero() {
+		eventTime = a.EndsAt
+	}
+	if eventTime.IsZero() {
+		eventTime = time.Now()
+	}
+	id := a.Fingerprint
+	if id == "" {
+		id = a.Labels["alertname"]
+	}
+	extensions := map[string]string{
+		ContextExtensionReceiverKey: n.Receiver,
+	}
+	if a.Fingerprint != "" {
+		extensions[ContextExtensionAlertFingerprintKey] = a.Fingerprint
+	}
+	for name, value := range a.Labels {
+		extensions[ContextExtensionLabelPrefix+name] = value
+	}
+	for name, value := range a.Annotations {
+		extensions[ContextExtensionAnnotationPrefix+name] = value
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          status,
+			EventTypeVersion:   n.Version,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%s-%d", id, status, a.StartsAt.Unix()),
+			EventTime:          metav1.Time{Time: eventTime.UTC()},
+			Source:             generatorURI(a.GeneratorURL),
+			ContentType:        "application/json",
+			Extensions:         extensions,
+		},
+		Data: b,
+	}, nil
+}
+
+// generatorURI returns the URI of the generator of the alert, e.g. the Prometheus expression browser
+// returns nil if the URL cannot be parsed.
+func generatorURI(generatorURL string) *v1alpha1.URI {
+	if generatorURL == "" {
+		return nil
+	}
+	u, err := url.Parse(generatorURL)
+	if err != nil {
+		log.Warnf("failed to parse generator url %s: %s", generatorURL, err)
+		return nil
+	}
+	uri := &v1alpha1.URI{
+		Scheme: u.Scheme,
+		Host:   u.Hostname(),
+		Path:   u.Path,
+		Query:  u.RawQuery,
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		uri.Port = int32(port)
+	}
+	return uri
+}
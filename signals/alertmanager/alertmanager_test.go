@@ -0,0 +1,156 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertmanager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+)
+
+const payload = `{
+  "version": "4",
+  "groupKey": "{}:{alertname=\"DiskAlmostFull\"}",
+  "status": "firing",
+  "receiver": "argo-events",
+  "groupLabels": {"alertname": "DiskAlmostFull"},
+  "commonLabels": {"alertname": "DiskAlmostFull", "severity": "critical"},
+  "commonAnnotations": {},
+  "externalURL": "http://alertmanager:9093",
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {"alertname": "DiskAlmostFull", "severity": "critical", "instance": "node-1"},
+      "annotations": {"summary": "Disk of node-1 is almost full"},
+      "startsAt": "2018-08-03T09:52:26.739266876Z",
+      "endsAt": "0001-01-01T00:00:00Z",
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=disk_free",
+      "fingerprint": "a1b2c3"
+    },
+    {
+      "status": "resolved",
+      "labels": {"alertname": "DiskAlmostFull", "severity": "critical", "instance": "node-2"},
+      "annotations": {"summary": "Disk of node-2 is almost full"},
+      "startsAt": "2018-08-03T09:50:26.739266876Z",
+      "endsAt": "2018-08-03T09:55:26.739266876Z",
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=disk_free"
+    },
+    {
+      "status": "firing",
+      "labels": {"alertname": "HighLatency", "severity": "critical"},
+      "annotations": {},
+      "startsAt": "2018-08-03T09:52:26.739266876Z",
+      "endsAt": "0001-01-01T00:00:00Z",
+      "generatorURL": ""
+    }
+  ]
+}`
+
+func TestListen(t *testing.T) {
+	am := &alertmanager{}
+	server := httptest.NewServer(am)
+	defer server.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	signal := &v1alpha1.Signal{
+		Name: "disk",
+		Alertmanager: &v1alpha1.AlertmanagerSignal{
+			Endpoint: "/alerts",
+			Labels:   map[string]string{"alertname": "DiskAlmostFull"},
+		},
+	}
+	events, err := am.Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := make(chan int, 1)
+	go func() {
+		resp, err := http.Post(server.URL+"/alerts", "application/json", strings.NewReader(payload))
+		if err != nil {
+			t.Error(err)
+			statuses <- 0
+			return
+		}
+		resp.Body.Close()
+		statuses <- resp.StatusCode
+	}()
+
+	// an event is emitted per alert matching the labels
+	for _, expected := range []struct {
+		eventType string
+		instance  string
+		eventTime time.Time
+	}{
+		{eventType: "firing", instance: "node-1", eventTime: time.Date(2018, 8, 3, 9, 52, 26, 739266876, time.UTC)},
+		{eventType: "resolved", instance: "node-2", eventTime: time.Date(2018, 8, 3, 9, 55, 26, 739266876, time.UTC)},
+	} {
+		select {
+		case event := <-events:
+			ctx := event.Context
+			if ctx.EventType != expected.eventType || ctx.Extensions[ContextExtensionLabelPrefix+"instance"] != expected.instance ||
+				ctx.Extensions[ContextExtensionAnnotationPrefix+"summary"] == "" || ctx.Extensions[ContextExtensionReceiverKey] != "argo-events" ||
+				!ctx.EventTime.Time.Equal(expected.eventTime) || ctx.Source == nil || ctx.Source.Host != "prometheus" {
+				t.Errorf("unexpected event context %+v", ctx)
+			}
+			if instance := gjson.GetBytes(event.Data, "labels.instance").String(); instance != expected.instance {
+				t.Errorf("expected the data of the alert of %s but found %s", expected.instance, event.Data)
+			}
+			if receiver := gjson.GetBytes(event.Data, "receiver").String(); receiver != "argo-events" {
+				t.Errorf("expected the receiver in the data but found %s", event.Data)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the event")
+		}
+	}
+	if status := <-statuses; status != http.StatusOK {
+		t.Errorf("expected status %d but found %d", http.StatusOK, status)
+	}
+
+	resp, err := http.Post(server.URL+"/alerts", "application/json", strings.NewReader("{"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d for an invalid notification but found %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"alertname": "DiskAlmostFull", "severity": "critical"}
+	tests := []struct {
+		expected map[string]string
+		matches  bool
+	}{
+		{expected: nil, matches: true},
+		{expected: map[string]string{"alertname": "DiskAlmostFull"}, matches: true},
+		{expected: map[string]string{"alertname": "DiskAlmostFull", "severity": "warning"}, matches: false},
+		{expected: map[string]string{"team": "data"}, matches: false},
+	}
+	for _, test := range tests {
+		if matches(test.expected, labels) != test.matches {
+			t.Errorf("expected labels %v to match: %t", test.expected, test.matches)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/signals/alertmanager"
+	"github.com/micro/go-micro"
+	k8s "github.com/micro/kubernetes/go/micro"
+)
+
+const (
+	// EnvVarAlertmanagerPort is the Env Var Key for the alertmanager port
+	EnvVarAlertmanagerPort string = "ALERTMANAGER_PORT"
+
+	// DefaultAlertmanagerPort is the default port to use if the EnvVarAlertmanagerPort is not set
+	DefaultAlertmanagerPort int = 7072
+)
+
+func main() {
+	svc := k8s.NewService(micro.Name("alertmanager"), micro.Metadata(sdk.SignalMetadata))
+	svc.Init()
+
+	// get the container port from container
+	port := DefaultAlertmanagerPort
+	if strPort, ok := os.LookupEnv(EnvVarAlertmanagerPort); ok {
+		port, _ = strconv.Atoi(strPort)
+	}
+
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(alertmanager.New(port)))
+
+	if err := svc.Run(); err != nil {
+		panic(err)
+	}
+}
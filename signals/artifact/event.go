@@ -0,0 +1,210 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	minio "github.com/minio/minio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ContextExtensionObjectKeyKey is the event context extension key of the key of the S3 object
+	ContextExtensionObjectKeyKey = "objectKey"
+
+	// ContextExtensionObjectSizeKey is the event context extension key of the size in bytes of the S3 object
+	ContextExtensionObjectSizeKey = "objectSize"
+
+	// ContextExtensionObjectETagKey is the event context extension key of the ETag of the S3 object
+	ContextExtensionObjectETagKey = "objectETag"
+
+	// ContextExtensionObjectContentTypeKey is the event context extension key of the content type of the S3 object
+	ContextExtensionObjectContentTypeKey = "objectContentType"
+
+	// ContextExtensionObjectMetadataPrefix is the prefix of the event context extension keys of the user metadata
+	// of the S3 object, e.g. objectMetadata.owner for the x-amz-meta-owner metadata
+	ContextExtensionObjectMetadataPrefix = "objectMetadata."
+
+	// the prefix of the headers of the user metadata of S3 objects
+	userMetadataHeaderPrefix = "x-amz-meta-"
+)
+
+// errObjectTooLarge is returned when the content of an object exceeds the inline content limit
+var errObjectTooLarge = errors.New("object is larger than the inline content limit")
+
+// recordMetadata is the metadata of the object of a notification record which is not exposed by the
+// minio notification types. Minio includes it in its notifications while AWS S3 does not.
+type recordMetadata struct {
+	S3 struct {
+		Object struct {
+			ContentType  string            `json:"contentType"`
+			UserMetadata map[string]string `json:"userMetadata"`
+			// UserDefined is the user metadata of the notifications of older Minio versions
+			UserDefined map[string]string `json:"userDefined"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+// recordEnhancer creates the artifact events of the bucket notification records
+// if the client is set, the metadata missing from the records is retrieved from the object and
+// the content of objects up to the inline content limit is inlined into the event data.
+type recordEnhancer struct {
+	client             *minio.Client
+	inlineContentLimit int64
+}
+
+// newEvent creates the artifact event of the notification record from the base event
+// raw is the original JSON of the record, if nil the record is marshalled instead.
+func (e *recordEnhancer) newEvent(base *v1alpha1.Event, record *minio.NotificationEvent, raw json.RawMessage) *v1alpha1.Event {
+	event := base.DeepCopy()
+	port, _ := strconv.ParseInt(record.Source.Port, 10, 32)
+	event.Context.EventType = EventType
+	event.Context.EventTime = getMetaTimestamp(record.EventTime)
+	event.Context.EventTypeVersion = record.EventVersion
+	event.Context.Source = &v1alpha1.URI{
+		Scheme: record.EventSource,
+		User:   record.UserIdentity.PrincipalID,
+		Host:   record.Source.Host,
+		Port:   int32(port),
+	}
+	event.Context.SchemaURL = &v1alpha1.URI{
+		Scheme: record.S3.SchemaVersion,
+	}
+	event.Context.EventID = record.S3.Object.ETag
+	event.Context.ContentType = "application/json"
+	if event.Context.Extensions == nil {
+		event.Context.Extensions = make(map[string]string)
+	}
+
+	data := []byte(raw)
+	if data == nil {
+		var err error
+		data, err = json.Marshal(record)
+		if err != nil {
+			log.Warnf("failed to marshal notification record into json: %s. falling back to the base event's original data", err)
+			event.Data = base.Data
+			return event
+		}
+	}
+
+	// the keys of notified objects are URL encoded
+	key := record.S3.Object.Key
+	if unescaped, err := url.QueryUnescape(key); err == nil {
+		key = unescaped
+	}
+	var metadata recordMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		log.Warnf("failed to unmarshal metadata of notification record: %s", err)
+	}
+	contentType := metadata.S3.Object.ContentType
+	userMetadata := make(map[string]string)
+	for _, m := range []map[string]string{metadata.S3.Object.UserDefined, metadata.S3.Object.UserMetadata} {
+		for name, value := range m {
+			userMetadata[name] = value
+		}
+	}
+	created := strings.HasPrefix(record.EventName, "s3:ObjectCreated:")
+	if contentType == "" && e.client != nil && created {
+		info, err := e.client.StatObject(record.S3.Bucket.Name, key, minio.StatObjectOptions{})
+		if err != nil {
+			log.Warnf("failed to retrieve metadata of object %s: %s", key, err)
+		} else {
+			contentType = info.ContentType
+			for name, values := range info.Metadata {
+				if len(values) > 0 {
+					userMetadata[name] = values[0]
+				}
+			}
+		}
+	}
+
+	event.Context.Extensions[ContextExtensionObjectKeyKey] = key
+	event.Context.Extensions[ContextExtensionObjectSizeKey] = strconv.FormatInt(record.S3.Object.Size, 10)
+	event.Context.Extensions[ContextExtensionObjectETagKey] = record.S3.Object.ETag
+	if contentType != "" {
+		event.Context.Extensions[ContextExtensionObjectContentTypeKey] = contentType
+	}
+	for name, value := range userMetadata {
+		// the metadata also contains system metadata such as the content type of the object
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, userMetadataHeaderPrefix) {
+			event.Context.Extensions[ContextExtensionObjectMetadataPrefix+strings.TrimPrefix(name, userMetadataHeaderPrefix)] = value
+		}
+	}
+
+	if e.client != nil && e.inlineContentLimit > 0 && created && record.S3.Object.Size <= e.inlineContentLimit {
+		content, err := e.readObject(record.S3.Bucket.Name, key)
+		if err != nil {
+			log.Warnf("failed to inline content of object %s: %s", key, err)
+		} else if inlined, err := inlineContent(data, content); err != nil {
+			log.Warnf("failed to inline content of object %s: %s", key, err)
+		} else {
+			data = inlined
+		}
+	}
+	event.Data = data
+	return event
+}
+
+// readObject reads the content of the object up to the inline content limit
+func (e *recordEnhancer) readObject(bucket, key string) ([]byte, error) {
+	obj, err := e.client.GetObject(bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(obj, e.inlineContentLimit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > e.inlineContentLimit {
+		return nil, errObjectTooLarge
+	}
+	return content, nil
+}
+
+// inlineContent adds the content of the object to the JSON of the notification record
+// the content is a string if it is valid UTF-8, otherwise it is base64 encoded.
+func inlineContent(data []byte, content []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var value string
+	if utf8.Valid(content) {
+		value = string(content)
+	} else {
+		value = base64.StdEncoding.EncodeToString(content)
+		fields["contentEncoding"] = json.RawMessage(`"base64"`)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	fields["content"] = encoded
+	return json.Marshal(fields)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	minio "github.com/minio/minio-go"
+)
+
+const metadataRecord = `{"eventVersion":"2.0","eventSource":"minio:s3","eventTime":"2018-07-07T18:46:37Z","eventName":"s3:ObjectCreated:Put",` +
+	`"s3":{"bucket":{"name":"images"},"object":{"key":"my+photo.jpg","size":5,"eTag":"etag-a","contentType":"image/jpeg",` +
+	`"userMetadata":{"X-Amz-Meta-Owner":"argo","content-type":"image/jpeg"}}}}`
+
+func TestRecordEnhancerMetadata(t *testing.T) {
+	var record minio.NotificationEvent
+	if err := json.Unmarshal([]byte(metadataRecord), &record); err != nil {
+		t.Fatal(err)
+	}
+	e := &recordEnhancer{}
+	event := e.newEvent(&v1alpha1.Event{}, &record, json.RawMessage(metadataRecord))
+
+	if string(event.Data) != metadataRecord {
+		t.Errorf("expected the event data to be the original record but found %s", event.Data)
+	}
+	expected := map[string]string{
+		ContextExtensionObjectKeyKey:                   "my photo.jpg",
+		ContextExtensionObjectSizeKey:                  "5",
+		ContextExtensionObjectETagKey:                  "etag-a",
+		ContextExtensionObjectContentTypeKey:           "image/jpeg",
+		ContextExtensionObjectMetadataPrefix + "owner": "argo",
+	}
+	for key, value := range expected {
+		if event.Context.Extensions[key] != value {
+			t.Errorf("extension %s:\nexpected: %s\nactual: %s", key, value, event.Context.Extensions[key])
+		}
+	}
+	if _, ok := event.Context.Extensions[ContextExtensionObjectMetadataPrefix+"content-type"]; ok {
+		t.Errorf("expected system metadata not to be a user metadata extension")
+	}
+}
+
+// fakeObjectServer serves the stat and get object APIs of a Minio server
+type fakeObjectServer struct {
+	content []byte
+}
+
+func (f *fakeObjectServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(f.content)))
+	w.Header().Set("ETag", `"etag-a"`)
+	w.Header().Set("Last-Modified", "Sat, 07 Jul 2018 18:46:37 GMT")
+	w.Header().Set("X-Amz-Meta-Owner", "argo")
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodGet {
+		w.Write(f.content)
+	}
+}
+
+func TestRecordEnhancerInlineContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  []byte
+		limit    int64
+		expected string
+		encoding string
+	}{
+		{name: "text", content: []byte("hello"), limit: 10, expected: "hello"},
+		{name: "binary", content: []byte{0xff, 0xfe}, limit: 10, expected: "//4=", encoding: "base64"},
+		{name: "too large", content: []byte("hello"), limit: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(&fakeObjectServer{content: test.content})
+			defer server.Close()
+			client, err := minio.NewWithRegion(strings.TrimPrefix(server.URL, "http://"), "access", "secret", false, "us-east-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			record := minio.NotificationEvent{EventName: minio.ObjectCreatedPut}
+			record.S3.Bucket.Name = "images"
+			record.S3.Object.Key = "a.txt"
+			record.S3.Object.Size = int64(len(test.content))
+			e := &recordEnhancer{client: client, inlineContentLimit: test.limit}
+			event := e.newEvent(&v1alpha1.Event{}, &record, nil)
+
+			var data struct {
+				S3              json.RawMessage `json:"s3"`
+				Content         string          `json:"content"`
+				ContentEncoding string          `json:"contentEncoding"`
+			}
+			if err := json.Unmarshal(event.Data, &data); err != nil {
+				t.Fatal(err)
+			}
+			if data.S3 == nil {
+				t.Errorf("expected the event data to be the notification record but found %s", event.Data)
+			}
+			if data.Content != test.expected || data.ContentEncoding != test.encoding {
+				t.Errorf("expected content %q with encoding %q but found %s", test.expected, test.encoding, event.Data)
+			}
+			// the metadata is retrieved from the object if the record does not contain it
+			if event.Context.Extensions[ContextExtensionObjectContentTypeKey] != "text/plain" ||
+				event.Context.Extensions[ContextExtensionObjectMetadataPrefix+"owner"] != "argo" {
+				t.Errorf("expected the object metadata extensions but found %v", event.Context.Extensions)
+			}
+		})
+	}
+}
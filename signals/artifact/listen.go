@@ -0,0 +1,322 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	minio "github.com/minio/minio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ContextExtensionReconciledKey is the event context extension key which is set to "true" for the events
+	// of objects which were created while the listener was disconnected from the S3 server
+	ContextExtensionReconciledKey = "reconciled"
+
+	// the maximum delay between reconnection attempts to the S3 server
+	maxReconnectDelay = 30 * time.Second
+
+	// the duration for which emitted objects are remembered to deduplicate the reconciled objects
+	seenRetention = 10 * time.Minute
+)
+
+// listenBucketNotification listens for the bucket notifications directly from the S3 server of the artifact signal
+func (s *s3) listenBucketNotification(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	loc := signal.Artifact.ArtifactLocation
+	if loc.S3 == nil {
+		return nil, fmt.Errorf("artifact signal '%s' must specify an s3 location to listen for bucket notifications", signal.Name)
+	}
+	if s.kubeClient == nil {
+		return nil, fmt.Errorf("failed to listen for bucket notifications: kubernetes client is not configured")
+	}
+	creds, err := store.GetCredentials(s.kubeClient, common.DefaultSensorControllerNamespace, &loc)
+	if err != nil {
+		return nil, err
+	}
+	client, err := store.NewMinioClient(loc.S3, *creds)
+	if err != nil {
+		return nil, err
+	}
+
+	l := newBucketListener(client, loc)
+	l.enhancer.inlineContentLimit = signal.Artifact.InlineContentLimit
+	events := make(chan *v1alpha1.Event)
+	go l.run(events, done)
+	log.Printf("signal '%s' listening for S3 [%s] notifications of bucket [%s]...", signal.Name, loc.S3.Event, loc.S3.Bucket)
+	return events, nil
+}
+
+// bucketListener listens for the bucket notifications of an S3 bucket
+// Minio does not replay the notifications which occur while the listener is disconnected so the objects
+// created in the meantime are reconciled by listing the bucket once the listener reconnects.
+type bucketListener struct {
+	client   *minio.Client
+	loc      v1alpha1.ArtifactLocation
+	enhancer *recordEnhancer
+
+	mu sync.Mutex
+	// disconnectedAt is the time at which the listener was last disconnected from the S3 server
+	disconnectedAt time.Time
+	// reconnected receives the time at which the listener was disconnected when the listener reconnects
+	reconnected chan time.Time
+	// seen contains the objects which were sent by key and ETag
+	seen map[string]seenObject
+}
+
+// seenObject is an object which was sent by the listener
+type seenObject struct {
+	at         time.Time
+	reconciled bool
+}
+
+func newBucketListener(client *minio.Client, loc v1alpha1.ArtifactLocation) *bucketListener {
+	l := &bucketListener{
+		client:      client,
+		loc:         loc,
+		enhancer:    &recordEnhancer{client: client},
+		reconnected: make(chan time.Time, 1),
+		seen:        make(map[string]seenObject),
+	}
+	client.SetCustomTransport(&listenTransport{RoundTripper: minio.DefaultTransport, listener: l})
+	return l
+}
+
+// run listens for the bucket notifications and sends the events until done is closed
+func (l *bucketListener) run(events chan<- *v1alpha1.Event, done <-chan struct{}) {
+	defer close(events)
+	var prefix, suffix string
+	if l.loc.S3.Filter != nil {
+		prefix, suffix = l.loc.S3.Filter.Prefix, l.loc.S3.Filter.Suffix
+	}
+	delay := time.Second
+	for {
+		notifications := l.client.ListenBucketNotification(l.loc.S3.Bucket, prefix, suffix, []string{string(l.loc.S3.Event)}, done)
+	receive:
+		for {
+			select {
+			case notification, ok := <-notifications:
+				if !ok {
+					break receive
+				}
+				if notification.Err != nil {
+					log.Warnf("failed to listen for notifications of bucket %s: %s", l.loc.S3.Bucket, notification.Err)
+					continue
+				}
+				delay = time.Second
+				for i := range notification.Records {
+					if !l.send(events, &notification.Records[i], false, done) {
+						return
+					}
+				}
+			case since := <-l.reconnected:
+				delay = time.Second
+				if !l.reconcile(events, since, done) {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+
+		// the listener stopped after failing to connect, reconnect after a delay
+		log.Infof("reconnecting to notifications of bucket %s in %s", l.loc.S3.Bucket, delay)
+		select {
+		case <-time.After(delay):
+		case <-done:
+			return
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// reconcile lists the objects of the bucket modified since the listener was disconnected and sends the events
+// of the objects which were not sent yet.
+// Only created objects can be reconciled, notifications of removed objects are lost while disconnected.
+// returns false if done is closed.
+func (l *bucketListener) reconcile(events chan<- *v1alpha1.Event, since time.Time, done <-chan struct{}) bool {
+	eventName := string(l.loc.S3.Event)
+	if !strings.HasPrefix(eventName, "s3:ObjectCreated:") {
+		return true
+	}
+	if strings.HasSuffix(eventName, "*") {
+		eventName = minio.ObjectCreatedPut
+	}
+	var prefix string
+	if l.loc.S3.Filter != nil {
+		prefix = l.loc.S3.Filter.Prefix
+	}
+	log.Infof("reconciling objects of bucket %s modified since %s", l.loc.S3.Bucket, since)
+
+	// the last modified time of objects has a precision of a second
+	since = since.Add(-time.Second)
+	listDone := make(chan struct{})
+	defer close(listDone)
+	for obj := range l.client.ListObjectsV2(l.loc.S3.Bucket, prefix, true, listDone) {
+		if obj.Err != nil {
+			log.Warnf("failed to reconcile objects of bucket %s: %s", l.loc.S3.Bucket, obj.Err)
+			return true
+		}
+		if obj.LastModified.Before(since) {
+			continue
+		}
+		record := minio.NotificationEvent{
+			EventVersion: "2.0",
+			EventSource:  "minio:s3",
+			EventTime:    obj.LastModified.UTC().Format(ISO8601),
+			EventName:    eventName,
+		}
+		record.S3.Bucket.Name = l.loc.S3.Bucket
+		record.S3.Object.Key = obj.Key
+		record.S3.Object.Size = obj.Size
+		record.S3.Object.ETag = strings.Trim(obj.ETag, "\"")
+		if !l.send(events, &record, true, done) {
+			return false
+		}
+	}
+	return true
+}
+
+// send sends the event of the notification record unless the object was already sent
+// returns false if done is closed.
+func (l *bucketListener) send(events chan<- *v1alpha1.Event, record *minio.NotificationEvent, reconciled bool, done <-chan struct{}) bool {
+	if !applyFilter(record, l.loc) {
+		return true
+	}
+	if strings.HasPrefix(record.EventName, "s3:ObjectCreated:") && !l.markSeen(record.S3.Object.Key, record.S3.Object.ETag, reconciled) {
+		log.Debugf("skipping notification of object %s which was already sent", record.S3.Object.Key)
+		return true
+	}
+	base := &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			Extensions:         make(map[string]string),
+		},
+	}
+	if reconciled {
+		base.Context.Extensions[ContextExtensionReconciledKey] = "true"
+	}
+	select {
+	case events <- l.enhancer.newEvent(base, record, nil):
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// markSeen remembers the object was sent and returns false if the object must not be sent again
+// reconciled objects are not sent if they were sent before, while notified objects are only not sent
+// if they were reconciled before, since objects can be overwritten with the same content.
+func (l *bucketListener) markSeen(key, etag string, reconciled bool) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for k, obj := range l.seen {
+		if now.Sub(obj.at) > seenRetention {
+			delete(l.seen, k)
+		}
+	}
+	if !reconciled {
+		// the keys of notified objects are URL encoded
+		if unescaped, err := url.QueryUnescape(key); err == nil {
+			key = unescaped
+		}
+	}
+	id := key + "@" + strings.Trim(etag, "\"")
+	if obj, ok := l.seen[id]; ok && (reconciled || obj.reconciled) {
+		return false
+	}
+	l.seen[id] = seenObject{at: now, reconciled: reconciled}
+	return true
+}
+
+// connected is called when a listen request to the S3 server succeeds
+func (l *bucketListener) connected() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.disconnectedAt.IsZero() {
+		return
+	}
+	select {
+	case l.reconnected <- l.disconnectedAt:
+	default:
+		// a reconciliation since an earlier disconnect is already pending
+	}
+	l.disconnectedAt = time.Time{}
+}
+
+// disconnected is called when the response of a listen request to the S3 server ends
+func (l *bucketListener) disconnected() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.disconnectedAt.IsZero() {
+		l.disconnectedAt = time.Now()
+	}
+}
+
+// listenTransport notifies the listener of the connections of the listen requests to the S3 server
+// the Minio client transparently reconnects when the server closes the connection, so the transport
+// is the only place where the listener can detect the reconnections.
+type listenTransport struct {
+	http.RoundTripper
+	listener *bucketListener
+}
+
+func (t *listenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet || req.URL.Query()["events"] == nil {
+		return resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	t.listener.connected()
+	resp.Body = &listenBody{ReadCloser: resp.Body, listener: t.listener}
+	return resp, nil
+}
+
+// listenBody notifies the listener when the response of a listen request ends
+type listenBody struct {
+	io.ReadCloser
+	listener *bucketListener
+	once     sync.Once
+}
+
+func (b *listenBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.listener.disconnected)
+	}
+	return n, err
+}
+
+func (b *listenBody) Close() error {
+	b.once.Do(b.listener.disconnected)
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	minio "github.com/minio/minio-go"
+)
+
+// fakeMinio serves the listen bucket notification and list objects APIs of a Minio server
+// the first listen connection notifies a.jpg and is closed by the server, b.jpg is created while disconnected
+// and the second listen connection notifies c.jpg as well as b.jpg again.
+type fakeMinio struct {
+	mu      sync.Mutex
+	listens int
+	lists   int
+	stop    chan struct{}
+}
+
+func (f *fakeMinio) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := req.URL.Query()
+	switch {
+	case query["events"] != nil:
+		f.listens++
+		w.WriteHeader(http.StatusOK)
+		if f.listens == 1 {
+			fmt.Fprintln(w, notification("a.jpg", "etag-a"))
+			return
+		}
+		if f.listens == 2 {
+			fmt.Fprintln(w, notification("c.jpg", "etag-c"))
+			fmt.Fprintln(w, notification("b.jpg", "etag-b"))
+		}
+		w.(http.Flusher).Flush()
+		// keep the connection open until the test ends
+		f.mu.Unlock()
+		<-f.stop
+		f.mu.Lock()
+	case query.Get("list-type") == "2":
+		f.lists++
+		lastModified := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>images</Name><Prefix></Prefix><KeyCount>2</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>
+<Contents><Key>old.jpg</Key><LastModified>2018-01-01T00:00:00.000Z</LastModified><ETag>"etag-old"</ETag><Size>10</Size><StorageClass>STANDARD</StorageClass></Contents>
+<Contents><Key>b.jpg</Key><LastModified>%s</LastModified><ETag>"etag-b"</ETag><Size>10</Size><StorageClass>STANDARD</StorageClass></Contents>
+</ListBucketResult>`, lastModified)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func notification(key, etag string) string {
+	return fmt.Sprintf(`{"Records":[{"eventVersion":"2.0","eventSource":"minio:s3","eventTime":"2018-07-07T18:46:37Z","eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"images"},"object":{"key":"%s","eTag":"%s"}},"source":{"host":"127.0.0.1","port":"9000"}}]}`, key, etag)
+}
+
+func TestBucketListener(t *testing.T) {
+	fake := &fakeMinio{stop: make(chan struct{})}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	defer close(fake.stop)
+
+	client, err := minio.NewWithRegion(strings.TrimPrefix(server.URL, "http://"), "access", "secret", false, "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loc := v1alpha1.ArtifactLocation{
+		S3: &v1alpha1.S3Artifact{
+			S3Bucket: v1alpha1.S3Bucket{Bucket: "images"},
+			Event:    "s3:ObjectCreated:*",
+		},
+	}
+	l := newBucketListener(client, loc)
+	events := make(chan *v1alpha1.Event)
+	done := make(chan struct{})
+	go l.run(events, done)
+
+	expected := []string{"etag-a", "etag-b", "etag-c"}
+	received := make(map[string]bool)
+	for len(received) < len(expected) {
+		select {
+		case event := <-events:
+			if _, ok := received[event.Context.EventID]; ok {
+				t.Errorf("received event %s twice", event.Context.EventID)
+			}
+			received[event.Context.EventID] = event.Context.Extensions[ContextExtensionReconciledKey] == "true"
+		case <-time.After(10 * time.Second):
+			t.Fatalf("expected events %v but received %v", expected, received)
+		}
+	}
+	// b.jpg may be reconciled before or after it is notified by the second connection, but only sent once
+	for _, id := range expected {
+		if _, ok := received[id]; !ok {
+			t.Errorf("expected event %s", id)
+		}
+	}
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event %s", event.Context.EventID)
+	case <-time.After(500 * time.Millisecond):
+	}
+	fake.mu.Lock()
+	if fake.lists == 0 {
+		t.Errorf("expected the objects to be reconciled after reconnecting")
+	}
+	fake.mu.Unlock()
+	close(done)
+}
+
+func TestMatchEventName(t *testing.T) {
+	if !matchEventName("s3:ObjectCreated:Put", minio.ObjectCreatedPut) {
+		t.Errorf("expected event name to match the event type")
+	}
+	if !matchEventName("s3:ObjectCreated:Copy", minio.ObjectCreatedAll) {
+		t.Errorf("expected event name to match the wildcard event type")
+	}
+	if matchEventName("s3:ObjectRemoved:Delete", minio.ObjectCreatedAll) {
+		t.Errorf("expected event name not to match the wildcard event type")
+	}
+}
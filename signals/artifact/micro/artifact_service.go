@@ -19,10 +19,12 @@ package main
 import (
 	"os"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/sdk"
 	"github.com/argoproj/argo-events/signals/artifact"
 	"github.com/micro/go-micro"
 	k8s "github.com/micro/kubernetes/go/micro"
+	"k8s.io/client-go/kubernetes"
 )
 
 func main() {
@@ -38,7 +40,15 @@ func main() {
 	}
 	streamClient := sdk.NewMicroSignalClient(stream, svc.Client())
 
-	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(artifact.New(streamClient)))
+	// kubernetes configuration
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	rest, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		panic(err)
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(rest)
+
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(artifact.New(streamClient, kubeClient)))
 
 	if err := svc.Run(); err != nil {
 		panic(err)
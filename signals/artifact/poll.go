@@ -0,0 +1,199 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PollEventType is the event type of the events of polled artifacts
+	PollEventType = "com.github.argoproj.artifact.poll"
+
+	// DefaultPollInterval is the default duration between polls of an artifact
+	DefaultPollInterval = time.Minute
+)
+
+// pollEventData is the data of the event of a polled artifact
+type pollEventData struct {
+	ETag         string     `json:"etag,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Size         int64      `json:"size"`
+	ContentType  string     `json:"contentType,omitempty"`
+	Checksum     string     `json:"checksum,omitempty"`
+	// Content is base64 encoded in JSON
+	Content []byte `json:"content,omitempty"`
+}
+
+// poller polls an artifact and detects its creation or changes by its fingerprint
+type poller struct {
+	reader         store.ArtifactReader
+	includeContent bool
+	// last is the fingerprint of the artifact of the latest event
+	last string
+}
+
+// pollArtifact periodically polls the artifact of the artifact signal
+func (s *s3) pollArtifact(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	loc := signal.Artifact.ArtifactLocation
+	poll := signal.Artifact.Poll
+	if poll == nil {
+		poll = &v1alpha1.ArtifactPoll{}
+	}
+	interval := DefaultPollInterval
+	if poll.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(poll.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse poll interval %s. Cause: %+v", poll.Interval, err.Error())
+		}
+	}
+	if loc.S3 != nil && s.kubeClient == nil {
+		return nil, fmt.Errorf("failed to poll s3 artifact: kubernetes client is not configured")
+	}
+	var creds *store.Credentials
+	if s.kubeClient != nil {
+		var err error
+		creds, err = store.GetCredentials(s.kubeClient, common.DefaultSensorControllerNamespace, &loc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	reader, err := store.GetArtifactReader(&loc, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &poller{
+		reader:         reader,
+		includeContent: poll.IncludeContent,
+	}
+	if signal.State != nil {
+		p.last = signal.State.ArtifactFingerprint
+	}
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if event := p.poll(); event != nil {
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+	log.Printf("signal '%s' polling artifact every %s...", signal.Name, interval)
+	return events, nil
+}
+
+// poll returns the event of the artifact if it was created or changed since the latest event
+// returns nil if the artifact did not change or cannot be read.
+func (p *poller) poll() *v1alpha1.Event {
+	data, fingerprint, err := p.fingerprint()
+	if err != nil {
+		log.Warnf("failed to poll artifact: %s", err)
+		return nil
+	}
+	if fingerprint == p.last {
+		return nil
+	}
+	p.last = fingerprint
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		log.Warnf("failed to marshal artifact poll event data: %s", err)
+		return nil
+	}
+	eventTime := time.Now().UTC()
+	if data.LastModified != nil {
+		eventTime = data.LastModified.UTC()
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          PollEventType,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fingerprint,
+			EventTime:          metav1.Time{Time: eventTime},
+			ContentType:        "application/json",
+			Extensions: map[string]string{
+				sdk.ContextExtensionFingerprintKey: fingerprint,
+			},
+		},
+		Data: b,
+	}
+}
+
+// fingerprint retrieves the metadata of the artifact and computes its fingerprint
+// The fingerprint is the ETag of the artifact if supported by the store, otherwise its modification time and size.
+// If the store supports neither, the content of the artifact is read and its checksum is the fingerprint.
+func (p *poller) fingerprint() (*pollEventData, string, error) {
+	data := &pollEventData{}
+	if statReader, ok := p.reader.(store.ArtifactStatReader); ok {
+		info, err := statReader.Stat()
+		if err != nil {
+			return nil, "", err
+		}
+		data.ETag = strings.Trim(info.ETag, "\"")
+		data.Size = info.Size
+		data.ContentType = info.ContentType
+		if !info.LastModified.IsZero() {
+			data.LastModified = &info.LastModified
+		}
+	}
+	if p.includeContent || (data.ETag == "" && data.LastModified == nil) {
+		content, err := p.reader.Read()
+		if err != nil {
+			return nil, "", err
+		}
+		checksum := sha256.Sum256(content)
+		data.Checksum = hex.EncodeToString(checksum[:])
+		data.Size = int64(len(content))
+		if p.includeContent {
+			data.Content = content
+		}
+	}
+
+	switch {
+	case data.ETag != "":
+		return data, "etag:" + data.ETag, nil
+	case data.LastModified != nil:
+		return data, fmt.Sprintf("modified:%d-size:%d", data.LastModified.UnixNano(), data.Size), nil
+	default:
+		return data, "sha256:" + data.Checksum, nil
+	}
+}
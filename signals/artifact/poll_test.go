@@ -0,0 +1,105 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+)
+
+func TestPollerFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifact-poll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "artifact.txt")
+
+	reader, err := store.NewFileReader(&v1alpha1.FileArtifact{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &poller{reader: reader, includeContent: true}
+
+	// the artifact does not exist yet
+	if event := p.poll(); event != nil {
+		t.Errorf("expected no event for a missing artifact")
+	}
+
+	// the artifact is created
+	if err := ioutil.WriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	event := p.poll()
+	if event == nil {
+		t.Fatalf("expected an event for the created artifact")
+	}
+	var data pollEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if string(data.Content) != "hello" || data.Size != 5 || data.Checksum == "" {
+		t.Errorf("unexpected event data %s", event.Data)
+	}
+	if event.Context.Extensions[sdk.ContextExtensionFingerprintKey] != event.Context.EventID {
+		t.Errorf("expected the fingerprint extension to be the event ID")
+	}
+
+	// the artifact is unchanged
+	if event := p.poll(); event != nil {
+		t.Errorf("expected no event for an unchanged artifact")
+	}
+
+	// the artifact is changed
+	modTime := time.Now().Add(time.Minute)
+	if err := ioutil.WriteFile(path, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if event := p.poll(); event == nil {
+		t.Errorf("expected an event for the changed artifact")
+	}
+}
+
+func TestPollerChecksum(t *testing.T) {
+	content := "hello"
+	reader, err := store.NewInlineReader(&content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &poller{reader: reader}
+	event := p.poll()
+	if event == nil {
+		t.Fatalf("expected an event for the artifact")
+	}
+
+	// a poller with the persisted fingerprint does not emit the unchanged artifact after a restart
+	restarted := &poller{reader: reader, last: event.Context.Extensions[sdk.ContextExtensionFingerprintKey]}
+	if event := restarted.poll(); event != nil {
+		t.Errorf("expected no event for the artifact with the last fingerprint")
+	}
+}
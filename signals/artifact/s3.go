@@ -22,15 +22,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
 	minio "github.com/minio/minio-go"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -43,14 +45,28 @@ const (
 // Listen() methods CAN retrieve fields from the s3 struct.
 type s3 struct {
 	streamClient sdk.SignalClient
+	kubeClient   kubernetes.Interface
 }
 
 // New creates a new S3 signal
-func New(client sdk.SignalClient) sdk.Listener {
-	return &s3{streamClient: client}
+func New(client sdk.SignalClient, kubeClient kubernetes.Interface) sdk.Listener {
+	return &s3{streamClient: client, kubeClient: kubeClient}
 }
 
 func (s *s3) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	if signal.Artifact != nil {
+		switch signal.Artifact.Mode {
+		case v1alpha1.ArtifactSignalModeListen:
+			return s.listenBucketNotification(signal, done)
+		case v1alpha1.ArtifactSignalModePoll:
+			return s.pollArtifact(signal, done)
+		}
+	}
+	return s.listenStream(signal, done)
+}
+
+// listenStream listens for the bucket notifications published to the target stream of the artifact signal
+func (s *s3) listenStream(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
 	ctx := context.TODO()
 	streamSignal, err := extractAndCreateStreamSignal(signal)
 	if err != nil {
@@ -77,10 +93,14 @@ func (s *s3) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1al
 		}
 	}()
 
+	enhancer, err := s.newStreamRecordEnhancer(signal)
+	if err != nil {
+		return nil, err
+	}
 	events := make(chan *v1alpha1.Event)
 
 	// start stream receiver
-	go s.interceptFilterAndEnhanceEvents(signal, events, streamEvents)
+	go s.interceptFilterAndEnhanceEvents(signal, enhancer, events, streamEvents)
 
 	// wait for stop signal
 	go func() {
@@ -103,7 +123,7 @@ func (s *s3) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1al
 // method should be invoked as a separate go routine within the artifact Start method
 // intercepts the receive-only msgs off the stream, filters them, and writes artifact events
 // to the sendCh.
-func (s *s3) interceptFilterAndEnhanceEvents(sig *v1alpha1.Signal, sendCh chan *v1alpha1.Event, recvCh <-chan *v1alpha1.Event) {
+func (s *s3) interceptFilterAndEnhanceEvents(sig *v1alpha1.Signal, enhancer *recordEnhancer, sendCh chan *v1alpha1.Event, recvCh <-chan *v1alpha1.Event) {
 	loc := sig.Artifact.ArtifactLocation
 	defer close(sendCh)
 	for streamEvent := range recvCh {
@@ -116,12 +136,20 @@ func (s *s3) interceptFilterAndEnhanceEvents(sig *v1alpha1.Signal, sendCh chan *
 		}
 		if notification.Err != nil {
 			event := streamEvent.DeepCopy()
+			if event.Context.Extensions == nil {
+				event.Context.Extensions = make(map[string]string)
+			}
 			event.Context.Extensions[sdk.ContextExtensionErrorKey] = notification.Err.Error()
 			sendCh <- event
 		}
-		for _, record := range notification.Records {
-			event := streamEvent.DeepCopy()
-
+		// the original JSON of the records retains the object metadata which is not part of the minio types
+		var raw struct {
+			Records []json.RawMessage
+		}
+		if err := json.Unmarshal(streamEvent.Data, &raw); err != nil || len(raw.Records) != len(notification.Records) {
+			raw.Records = make([]json.RawMessage, len(notification.Records))
+		}
+		for i, record := range notification.Records {
 			if ok := applyFilter(&record, loc); !ok {
 				// this record failed to pass the filter so we ignore it
 				log.Debugf("filtered event - record metadata [bucket: %s, event: %s, key: %s] "+
@@ -130,36 +158,32 @@ func (s *s3) interceptFilterAndEnhanceEvents(sig *v1alpha1.Signal, sendCh chan *
 					loc.S3.Bucket, loc.S3.Event, loc.S3.Filter)
 				continue
 			}
-			port, _ := strconv.ParseInt(record.Source.Port, 10, 32)
-			event.Context.EventType = EventType
-			event.Context.EventTime = getMetaTimestamp(record.EventTime)
-			event.Context.EventTypeVersion = record.EventVersion
-			event.Context.Source = &v1alpha1.URI{
-				Scheme: record.EventSource,
-				User:   record.UserIdentity.PrincipalID,
-				Host:   record.Source.Host,
-				Port:   int32(port),
-			}
-			event.Context.SchemaURL = &v1alpha1.URI{
-				Scheme: record.S3.SchemaVersion,
-			}
-			event.Context.EventID = record.S3.Object.ETag
-			event.Context.ContentType = "application/json"
-
-			// re-marshal each record back into json
-			recordEvent := new(minio.NotificationEvent)
-			recordEventBytes, err := json.Marshal(recordEvent)
-			if err != nil {
-				log.Warnf("failed to re-marshal notification event into json: %s. falling back to the stream event's original data", err)
-				event.Data = streamEvent.Data
-			} else {
-				event.Data = recordEventBytes
-			}
-			sendCh <- event
+			sendCh <- enhancer.newEvent(streamEvent, &record, raw.Records[i])
 		}
 	}
 }
 
+// newStreamRecordEnhancer creates the enhancer of the records published to the target stream
+// the S3 server is only accessed to inline the content of objects, which requires its credentials.
+func (s *s3) newStreamRecordEnhancer(signal *v1alpha1.Signal) (*recordEnhancer, error) {
+	loc := signal.Artifact.ArtifactLocation
+	if signal.Artifact.InlineContentLimit <= 0 || loc.S3 == nil {
+		return &recordEnhancer{}, nil
+	}
+	if s.kubeClient == nil {
+		return nil, fmt.Errorf("failed to inline content of s3 objects: kubernetes client is not configured")
+	}
+	creds, err := store.GetCredentials(s.kubeClient, common.DefaultSensorControllerNamespace, &loc)
+	if err != nil {
+		return nil, err
+	}
+	client, err := store.NewMinioClient(loc.S3, *creds)
+	if err != nil {
+		return nil, err
+	}
+	return &recordEnhancer{client: client, inlineContentLimit: signal.Artifact.InlineContentLimit}, nil
+}
+
 // utility method to extract the stream definition from within the artifact signal definition.
 // used to reconfigure the artifact signal to create a first class stream signal
 func extractAndCreateStreamSignal(artifactSignal *v1alpha1.Signal) (*v1alpha1.Signal, error) {
@@ -177,17 +201,25 @@ func extractAndCreateStreamSignal(artifactSignal *v1alpha1.Signal) (*v1alpha1.Si
 // checks if the notification satisfies the signal
 // 3 conditions must be met
 // 1. notification bucket name must equal the S3 bucket
-// 2. notification event name must equal the signal S3 event
+// 2. notification event name must match the signal S3 event, e.g. s3:ObjectCreated:* matches s3:ObjectCreated:Put
 // 3. notification object must pass the prefix and suffix string literals
 func applyFilter(notification *minio.NotificationEvent, loc v1alpha1.ArtifactLocation) bool {
 	if loc.S3.Filter != nil {
 		return notification.S3.Bucket.Name == loc.S3.Bucket &&
-			notification.EventName == string(loc.S3.Event) &&
+			matchEventName(notification.EventName, loc.S3.Event) &&
 			strings.HasPrefix(notification.S3.Object.Key, loc.S3.Filter.Prefix) &&
 			strings.HasSuffix(notification.S3.Object.Key, loc.S3.Filter.Suffix)
 	}
 	return notification.S3.Bucket.Name == loc.S3.Bucket &&
-		notification.EventName == string(loc.S3.Event)
+		matchEventName(notification.EventName, loc.S3.Event)
+}
+
+// matchEventName checks if the event name matches the event type which may end with a wildcard
+func matchEventName(name string, eventType minio.NotificationEventType) bool {
+	if strings.HasSuffix(string(eventType), "*") {
+		return strings.HasPrefix(name, strings.TrimSuffix(string(eventType), "*"))
+	}
+	return name == string(eventType)
 }
 
 func getMetaTimestamp(tStr string) metav1.Time {
@@ -17,6 +17,7 @@ limitations under the License.
 package artifact
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -66,7 +67,7 @@ func TestExtractAndCreateStreamSignal(t *testing.T) {
 
 func TestSignal(t *testing.T) {
 	fakeClient := fake.NewClient()
-	s3 := New(fakeClient)
+	s3 := New(fakeClient, nil)
 
 	signal := v1alpha1.Signal{
 		Name: "s3-test",
@@ -107,6 +108,16 @@ func TestSignal(t *testing.T) {
 	if event.Context.EventType != EventType {
 		t.Errorf("event context EventType:\nexpected: %s\nactual: %s", EventType, event.Context.EventID)
 	}
+	var record minio.NotificationEvent
+	if err := json.Unmarshal(event.Data, &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.S3.Object.Key != "myphoto.jpg" {
+		t.Errorf("expected the event data to be the notification record but found %s", event.Data)
+	}
+	if event.Context.Extensions[ContextExtensionObjectKeyKey] != "myphoto.jpg" {
+		t.Errorf("expected the object key extension but found %v", event.Context.Extensions)
+	}
 
 	close(done)
 	// ensure events channel is closed
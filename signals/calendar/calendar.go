@@ -26,10 +26,23 @@ import (
 	cronlib "github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
 	EventType = "com.github.argoproj.calendar"
+
+	// ContextExtensionScheduledTimeKey is the event context extension key for the scheduled time of the event
+	// formatted as RFC3339 in the time zone of the calendar signal.
+	ContextExtensionScheduledTimeKey = "scheduledTime"
+
+	// ContextExtensionCatchUpKey is the event context extension key which marks events that were missed
+	// during down-time and fired according to the catch up policy of the calendar signal.
+	ContextExtensionCatchUpKey = "catchUp"
+
+	// maxMissedEvents is the maximum number of missed events which are evaluated from the time the calendar signal
+	// last fired. If more events were missed, only the latest part of the down-time is evaluated.
+	maxMissedEvents = 10000
 )
 
 // Next is a function to compute the next signal time from a given time
@@ -37,62 +50,154 @@ type Next func(time.Time) time.Time
 
 // Note: micro requires stateless operation so the Listen() method should not use the
 // receive struct to save or modify state.
-type calendar struct{}
+// Listen() methods CAN retrieve the kubeClient from the calendar struct.
+type calendar struct {
+	kubeClient kubernetes.Interface
+}
 
 // New creates a new calendar listener
-func New() sdk.Listener {
-	return new(calendar)
+// the kubeClient is used to retrieve the credentials of iCalendar files and can be nil
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &calendar{kubeClient: kubeClient}
 }
 
 func (c *calendar) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
-	schedule, err := resolveSchedule(signal.Calendar)
+	location, err := resolveLocation(signal.Calendar)
+	if err != nil {
+		return nil, err
+	}
+	recurrence, err := common.ParseRecurrence(signal.Calendar.Recurrence, location)
 	if err != nil {
 		return nil, err
 	}
-	exDates, err := common.ParseExclusionDates(signal.Calendar.Recurrence)
+	ical, err := c.resolveICalendar(signal.Calendar.ICalendar, location)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := resolveSchedule(signal.Calendar, recurrence, ical)
 	if err != nil {
 		return nil, err
 	}
 
 	events := make(chan *v1alpha1.Event)
 
+	next := nextFunc(schedule, recurrence.ExDates, location, ical)
+	missed := missedEvents(signal.Calendar, signal.State, next, time.Now())
+
+	// start handling events
+	if ical != nil {
+		go ical.watch(done)
+	}
+	go c.handleEvents(events, next, missed, done)
+	return events, nil
+}
+
+// missedEvents returns the scheduled times of the events missed since the calendar signal last fired
+// which need to be caught up according to the catch up policy.
+func missedEvents(cal *v1alpha1.CalendarSignal, state *v1alpha1.SignalState, next Next, now time.Time) []time.Time {
+	if cal.CatchUp == nil || state == nil || state.LastFired == nil {
+		return nil
+	}
+	var limit int
+	switch cal.CatchUp.Mode {
+	case v1alpha1.CatchUpModeOnce:
+		limit = 1
+	case v1alpha1.CatchUpModeAll:
+		limit = int(cal.CatchUp.Limit)
+	default:
+		return nil
+	}
+	if limit <= 0 {
+		return nil
+	}
+	from := state.LastFired.Time
+	missed, ok := walkMissedEvents(next, from, now, limit)
+	for !ok {
+		// halve the evaluated down-time until its events can be evaluated
+		from = now.Add(-now.Sub(from) / 2)
+		missed, ok = walkMissedEvents(next, from, now, limit)
+	}
+	if len(missed) > 0 {
+		log.Infof("catching up on %d missed calendar events since %s", len(missed), from)
+	}
+	return missed
+}
+
+// walkMissedEvents returns the latest limit scheduled times after from and until now
+// returns false if more than maxMissedEvents times are scheduled.
+func walkMissedEvents(next Next, from, now time.Time, limit int) ([]time.Time, bool) {
+	missed := make([]time.Time, 0)
+	n := 0
+	for t := next(from); !t.IsZero() && !t.After(now); t = next(t) {
+		n++
+		if n > maxMissedEvents {
+			return nil, false
+		}
+		missed = append(missed, t)
+		// only keep the latest missed events
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed, true
+}
+
+// nextFunc returns the Next function for the schedule which skips over the exclusion dates and excluded iCalendar events.
+// the schedule is evaluated and the exclusion dates are matched in the given location.
+func nextFunc(schedule cronlib.Schedule, exDates []time.Time, location *time.Location, ical *icalendar) Next {
 	var next Next
 	next = func(last time.Time) time.Time {
-		nextT := schedule.Next(last)
+		nextT := schedule.Next(last.In(location))
 		nextYear := nextT.Year()
 		nextMonth := nextT.Month()
 		nextDay := nextT.Day()
 		for _, exDate := range exDates {
+			exDate = exDate.In(location)
 			// if exDate == nextEvent, then we need to skip this and get the next
 			if exDate.Year() == nextYear && exDate.Month() == nextMonth && exDate.Day() == nextDay {
 				return next(nextT)
 			}
 		}
+		if !nextT.IsZero() && ical.excludes(nextT) {
+			return next(nextT)
+		}
 		return nextT
 	}
-
-	// start handling events
-	go c.handleEvents(events, next, done)
-	return events, nil
+	return next
 }
 
-func (c *calendar) handleEvents(events chan *v1alpha1.Event, next Next, done <-chan struct{}) {
+func (c *calendar) handleEvents(events chan *v1alpha1.Event, next Next, missed []time.Time, done <-chan struct{}) {
 	defer close(events)
+	for _, t := range missed {
+		event := newEvent(t)
+		event.Context.Extensions[ContextExtensionCatchUpKey] = "true"
+		select {
+		case events <- event:
+		case <-done:
+			return
+		}
+	}
 	eventTimer := c.getEventTimer(next, done)
 	for t := range eventTimer {
-		event := &v1alpha1.Event{
-			Context: v1alpha1.EventContext{
-				EventID:            t.String(),
-				EventType:          EventType,
-				CloudEventsVersion: sdk.CloudEventsVersion,
-				EventTime:          metav1.Time{Time: t},
-				Extensions:         make(map[string]string),
+		events <- newEvent(t)
+	}
+}
+
+func newEvent(t time.Time) *v1alpha1.Event {
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventID:            t.String(),
+			EventType:          EventType,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventTime:          metav1.Time{Time: t},
+			Extensions: map[string]string{
+				ContextExtensionScheduledTimeKey: t.Format(time.RFC3339),
 			},
-		}
-		events <- event
+		},
 	}
 }
 
+// getEventTimer returns a channel which receives the scheduled time of each calendar event
 func (c *calendar) getEventTimer(next Next, done <-chan struct{}) <-chan time.Time {
 	lastT := time.Now()
 	eventTimer := make(chan time.Time)
@@ -100,11 +205,16 @@ func (c *calendar) getEventTimer(next Next, done <-chan struct{}) <-chan time.Ti
 		defer close(eventTimer)
 		for {
 			t := next(lastT)
+			if t.IsZero() {
+				log.Printf("calendar has no further events")
+				<-done
+				return
+			}
 			timer := time.After(time.Until(t))
 			log.Printf("expected next calendar event %s", t)
 			select {
 			case tx := <-timer:
-				eventTimer <- tx
+				eventTimer <- t
 				lastT = tx
 			case <-done:
 				return
@@ -114,7 +224,35 @@ func (c *calendar) getEventTimer(next Next, done <-chan struct{}) <-chan time.Ti
 	return eventTimer
 }
 
-func resolveSchedule(cal *v1alpha1.CalendarSignal) (cronlib.Schedule, error) {
+// resolveSchedule returns the schedule, interval or recurrence of the calendar combined with the included iCalendar events
+// the recurrence is only used as the schedule if it defines RRULEs or RDATEs.
+func resolveSchedule(cal *v1alpha1.CalendarSignal, recurrence *common.Recurrence, ical *icalendar) (cronlib.Schedule, error) {
+	schedule, err := resolveBaseSchedule(cal, recurrence)
+	if ical == nil || ical.mode != v1alpha1.ICalendarModeInclude {
+		return schedule, err
+	}
+	if err != nil {
+		// the included iCalendar events can be the only schedule
+		return ical, nil
+	}
+	return unionSchedule{schedule, ical}, nil
+}
+
+// unionSchedule is the union of the times of multiple schedules
+type unionSchedule []cronlib.Schedule
+
+// Next returns the earliest next time of the schedules
+func (u unionSchedule) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range u {
+		if n := schedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+func resolveBaseSchedule(cal *v1alpha1.CalendarSignal, recurrence *common.Recurrence) (cronlib.Schedule, error) {
 	if cal.Schedule != "" {
 		schedule, err := cronlib.Parse(cal.Schedule)
 		if err != nil {
@@ -128,7 +266,20 @@ func resolveSchedule(cal *v1alpha1.CalendarSignal) (cronlib.Schedule, error) {
 		}
 		schedule := cronlib.ConstantDelaySchedule{Delay: intervalDuration}
 		return schedule, nil
+	} else if recurrence.IsSchedule() {
+		return recurrence, nil
 	} else {
-		return nil, fmt.Errorf("calendar signal must contain either a schedule or interval")
+		return nil, fmt.Errorf("calendar signal must contain either a schedule, interval or recurrence rules and dates")
+	}
+}
+
+func resolveLocation(cal *v1alpha1.CalendarSignal) (*time.Location, error) {
+	if cal.Timezone == "" {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(cal.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone %s from calendar signal. Cause: %+v", cal.Timezone, err.Error())
 	}
+	return location, nil
 }
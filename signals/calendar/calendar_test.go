@@ -20,7 +20,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestCalendarListenFailures(t *testing.T) {
@@ -30,7 +32,7 @@ func TestCalendarListenFailures(t *testing.T) {
 			Recurrence: []string{},
 		},
 	}
-	cal := New()
+	cal := New(nil)
 	done := make(chan struct{})
 
 	// test unknown signal
@@ -67,7 +69,7 @@ func TestCalendarListenFailures(t *testing.T) {
 }
 
 func TestScheduleCalendar(t *testing.T) {
-	cal := New()
+	cal := New(nil)
 	done := make(chan struct{})
 
 	signal := v1alpha1.Signal{
@@ -97,7 +99,7 @@ func TestScheduleCalendar(t *testing.T) {
 }
 
 func TestIntervalCalendar(t *testing.T) {
-	cal := New()
+	cal := New(nil)
 	done := make(chan struct{})
 
 	signal := v1alpha1.Signal{
@@ -126,3 +128,271 @@ func TestIntervalCalendar(t *testing.T) {
 		t.Errorf("event context EventType\nexpected: %s\nactual: %s", EventType, event.Context.EventType)
 	}
 }
+
+func TestTimezoneCalendar(t *testing.T) {
+	location, err := resolveLocation(&v1alpha1.CalendarSignal{Timezone: "America/New_York"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = resolveLocation(&v1alpha1.CalendarSignal{Timezone: "Mars/Olympus_Mons"})
+	if err == nil {
+		t.Errorf("expected a non nil error for an unknown timezone")
+	}
+
+	schedule, err := resolveSchedule(&v1alpha1.CalendarSignal{Schedule: "0 0 9 * * *"}, &common.Recurrence{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2018-07-04T13:00:00Z is 2018-07-04T09:00:00 in New York
+	exDates := []time.Time{time.Date(2018, 7, 4, 13, 0, 0, 0, time.UTC)}
+	next := nextFunc(schedule, exDates, location, nil)
+
+	last := time.Date(2018, 7, 3, 14, 0, 0, 0, time.UTC)
+	actual := next(last)
+	expected := time.Date(2018, 7, 5, 9, 0, 0, 0, location)
+	if !actual.Equal(expected) {
+		t.Errorf("next calendar event\nexpected: %s\nactual: %s", expected, actual)
+	}
+	if actual.Location() != location {
+		t.Errorf("next calendar event location\nexpected: %s\nactual: %s", location, actual.Location())
+	}
+}
+
+func TestRecurrenceCalendar(t *testing.T) {
+	cal := New(nil)
+	done := make(chan struct{})
+
+	now := time.Now().UTC()
+	signal := v1alpha1.Signal{
+		Name: "nats-test",
+		Calendar: &v1alpha1.CalendarSignal{
+			Recurrence: []string{
+				"DTSTART:" + now.Format("20060102T150405Z"),
+				"RRULE:FREQ=SECONDLY;COUNT=2",
+			},
+		},
+	}
+
+	events, err := cal.Listen(&signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, ok := <-events
+	if !ok {
+		t.Fatalf("expected an event but found none")
+	}
+
+	close(done)
+
+	// ensure the event was correct
+	if event.Context.EventType != EventType {
+		t.Errorf("event context EventType\nexpected: %s\nactual: %s", EventType, event.Context.EventType)
+	}
+	expected := now.Truncate(time.Second).Add(time.Second)
+	if !event.Context.EventTime.Time.Equal(expected) {
+		t.Errorf("event context EventTime\nexpected: %s\nactual: %s", expected, event.Context.EventTime.Time)
+	}
+}
+
+func TestMissedEvents(t *testing.T) {
+	schedule, err := resolveSchedule(&v1alpha1.CalendarSignal{Interval: "1h"}, &common.Recurrence{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := nextFunc(schedule, nil, time.UTC, nil)
+	lastFired := metav1.Time{Time: time.Date(2018, 7, 4, 9, 0, 0, 0, time.UTC)}
+	now := time.Date(2018, 7, 4, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		catchUp  *v1alpha1.CatchUpPolicy
+		expected []time.Time
+	}{
+		{"no policy", nil, nil},
+		{"skip", &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeSkip}, nil},
+		{"once", &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeOnce}, []time.Time{
+			time.Date(2018, 7, 4, 14, 0, 0, 0, time.UTC),
+		}},
+		{"all without limit", &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeAll}, nil},
+		{"all up to limit", &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeAll, Limit: 3}, []time.Time{
+			time.Date(2018, 7, 4, 12, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 13, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 14, 0, 0, 0, time.UTC),
+		}},
+		{"all within limit", &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeAll, Limit: 10}, []time.Time{
+			time.Date(2018, 7, 4, 10, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 11, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 12, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 13, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 4, 14, 0, 0, 0, time.UTC),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := &v1alpha1.CalendarSignal{CatchUp: tt.catchUp}
+			missed := missedEvents(cal, &v1alpha1.SignalState{LastFired: &lastFired}, next, now)
+			if len(missed) != len(tt.expected) {
+				t.Fatalf("missed events\nexpected: %v\nactual: %v", tt.expected, missed)
+			}
+			for i := range missed {
+				if !missed[i].Equal(tt.expected[i]) {
+					t.Errorf("missed event %d\nexpected: %s\nactual: %s", i, tt.expected[i], missed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMissedEventsLongDownTime(t *testing.T) {
+	schedule, err := resolveSchedule(&v1alpha1.CalendarSignal{Interval: "1s"}, &common.Recurrence{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := nextFunc(schedule, nil, time.UTC, nil)
+	now := time.Date(2018, 7, 4, 14, 30, 0, 0, time.UTC)
+	lastFired := metav1.Time{Time: now.AddDate(-1, 0, 0)}
+
+	// only the latest part of the down-time is evaluated
+	cal := &v1alpha1.CalendarSignal{CatchUp: &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeAll, Limit: 3}}
+	missed := missedEvents(cal, &v1alpha1.SignalState{LastFired: &lastFired}, next, now)
+	expected := []time.Time{now.Add(-2 * time.Second), now.Add(-time.Second), now}
+	if len(missed) != len(expected) {
+		t.Fatalf("missed events\nexpected: %v\nactual: %v", expected, missed)
+	}
+	for i := range missed {
+		if !missed[i].Equal(expected[i]) {
+			t.Errorf("missed event %d\nexpected: %s\nactual: %s", i, expected[i], missed[i])
+		}
+	}
+}
+
+func TestCatchUpCalendar(t *testing.T) {
+	cal := New(nil)
+	done := make(chan struct{})
+
+	lastFired := metav1.Time{Time: time.Now().Add(-90 * time.Minute)}
+	signal := v1alpha1.Signal{
+		Name: "nats-test",
+		Calendar: &v1alpha1.CalendarSignal{
+			Interval: "1h",
+			CatchUp:  &v1alpha1.CatchUpPolicy{Mode: v1alpha1.CatchUpModeOnce},
+		},
+		State: &v1alpha1.SignalState{LastFired: &lastFired},
+	}
+
+	events, err := cal.Listen(&signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, ok := <-events
+	if !ok {
+		t.Fatalf("expected an event but found none")
+	}
+
+	close(done)
+
+	if event.Context.Extensions[ContextExtensionCatchUpKey] != "true" {
+		t.Errorf("expected a caught up event but found %v", event.Context.Extensions)
+	}
+}
+
+var holidays = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//argo-events//holidays//EN
+BEGIN:VEVENT
+UID:independence-day
+DTSTART;VALUE=DATE:20180704
+SUMMARY:Independence Day
+END:VEVENT
+BEGIN:VEVENT
+UID:christmas
+DTSTART;VALUE=DATE:20171225
+DTEND;VALUE=DATE:20171227
+RRULE:FREQ=YEARLY
+SUMMARY:Christmas
+END:VEVENT
+BEGIN:VEVENT
+UID:offsite
+DTSTART;TZID=America/New_York:20180810T150000
+DTEND;TZID=America/New_York:20180810T170000
+SUMMARY:Offsite with a long description which is folded
+  over multiple lines
+END:VEVENT
+BEGIN:VEVENT
+UID:unknown-timezone
+DTSTART;TZID=Mars/Olympus_Mons:20180810T150000
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestICalendarCalendar(t *testing.T) {
+	cal := &calendar{}
+	_, err := cal.resolveICalendar(&v1alpha1.ICalendarSource{
+		Location: v1alpha1.ArtifactLocation{Inline: &holidays},
+		Mode:     v1alpha1.ICalendarModeExclude,
+	}, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notICalendar := "this is not an icalendar"
+	_, err = cal.resolveICalendar(&v1alpha1.ICalendarSource{
+		Location: v1alpha1.ArtifactLocation{Inline: &notICalendar},
+	}, time.UTC)
+	if err == nil {
+		t.Errorf("expected a non nil error for an invalid icalendar")
+	}
+
+	schedule, err := resolveSchedule(&v1alpha1.CalendarSignal{Schedule: "0 0 9 * * *"}, &common.Recurrence{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// exclusion
+	exclude, err := cal.resolveICalendar(&v1alpha1.ICalendarSource{
+		Location: v1alpha1.ArtifactLocation{Inline: &holidays},
+	}, ny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exclude.events) != 3 {
+		t.Errorf("expected 3 icalendar events but found %d", len(exclude.events))
+	}
+	next := nextFunc(schedule, nil, ny, exclude)
+	for _, tt := range []struct {
+		last     time.Time
+		expected time.Time
+	}{
+		{time.Date(2018, 7, 3, 12, 0, 0, 0, ny), time.Date(2018, 7, 5, 9, 0, 0, 0, ny)},
+		{time.Date(2018, 12, 24, 12, 0, 0, 0, ny), time.Date(2018, 12, 27, 9, 0, 0, 0, ny)},
+		{time.Date(2018, 8, 9, 12, 0, 0, 0, ny), time.Date(2018, 8, 11, 9, 0, 0, 0, ny)},
+	} {
+		if actual := next(tt.last); !actual.Equal(tt.expected) {
+			t.Errorf("next calendar event after %s\nexpected: %s\nactual: %s", tt.last, tt.expected, actual)
+		}
+	}
+
+	// inclusion
+	include, err := cal.resolveICalendar(&v1alpha1.ICalendarSource{
+		Location: v1alpha1.ArtifactLocation{Inline: &holidays},
+		Mode:     v1alpha1.ICalendarModeInclude,
+	}, ny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedule, err = resolveSchedule(&v1alpha1.CalendarSignal{}, &common.Recurrence{}, include)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next = nextFunc(schedule, nil, ny, include)
+	expected := time.Date(2018, 8, 10, 15, 0, 0, 0, ny)
+	if actual := next(time.Date(2018, 7, 5, 0, 0, 0, 0, ny)); !actual.Equal(expected) {
+		t.Errorf("next calendar event\nexpected: %s\nactual: %s", expected, actual)
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultICalendarRefreshInterval is the default interval after which iCalendar files are re-fetched
+const DefaultICalendarRefreshInterval = time.Hour
+
+// icalEvent is a VEVENT of an iCalendar file
+type icalEvent struct {
+	recurrence *common.Recurrence
+	duration   time.Duration
+}
+
+// icalendar contains the events of an iCalendar file which is periodically re-fetched
+type icalendar struct {
+	mode            v1alpha1.ICalendarMode
+	location        *time.Location
+	reader          store.ArtifactReader
+	refreshInterval time.Duration
+
+	mu     sync.RWMutex
+	events []icalEvent
+}
+
+// Next returns the earliest start of an event after t
+// If there is no event after t, the zero time is returned.
+func (ical *icalendar) Next(t time.Time) time.Time {
+	ical.mu.RLock()
+	defer ical.mu.RUnlock()
+	var next time.Time
+	for _, event := range ical.events {
+		if n := event.recurrence.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// excludes returns true if the iCalendar is in exclusion mode and an event takes place on the day of t
+func (ical *icalendar) excludes(t time.Time) bool {
+	if ical == nil || ical.mode == v1alpha1.ICalendarModeInclude {
+		return false
+	}
+	t = t.In(ical.location)
+	dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, ical.location)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	ical.mu.RLock()
+	defer ical.mu.RUnlock()
+	for _, event := range ical.events {
+		duration := event.duration
+		if duration <= 0 {
+			duration = time.Nanosecond
+		}
+		// the event takes place on the day if it starts before the end of the day and ends after the start of the day
+		if start := event.recurrence.Next(dayStart.Add(-duration)); !start.IsZero() && start.Before(dayEnd) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveICalendar fetches the iCalendar of the calendar signal
+// returns nil if the calendar signal does not specify an iCalendar
+func (c *calendar) resolveICalendar(source *v1alpha1.ICalendarSource, location *time.Location) (*icalendar, error) {
+	if source == nil {
+		return nil, nil
+	}
+	refreshInterval := DefaultICalendarRefreshInterval
+	if source.RefreshInterval != "" {
+		var err error
+		refreshInterval, err = time.ParseDuration(source.RefreshInterval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse icalendar refresh interval %s. Cause: %+v", source.RefreshInterval, err.Error())
+		}
+	}
+	if source.Location.S3 != nil && c.kubeClient == nil {
+		return nil, fmt.Errorf("failed to read icalendar from s3: kubernetes client is not configured")
+	}
+	var creds *store.Credentials
+	if c.kubeClient != nil {
+		var err error
+		creds, err = store.GetCredentials(c.kubeClient, common.DefaultSensorControllerNamespace, &source.Location)
+		if err != nil {
+			return nil, err
+		}
+	}
+	reader, err := store.GetArtifactReader(&source.Location, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	ical := &icalendar{
+		mode:            source.Mode,
+		location:        location,
+		reader:          reader,
+		refreshInterval: refreshInterval,
+	}
+	if err := ical.refresh(); err != nil {
+		return nil, err
+	}
+	return ical, nil
+}
+
+// watch periodically re-fetches the iCalendar file until done is closed
+func (ical *icalendar) watch(done <-chan struct{}) {
+	ticker := time.NewTicker(ical.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ical.refresh(); err != nil {
+				log.Warnf("failed to refresh icalendar, keeping the previous events: %s", err)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// refresh reads and parses the iCalendar file and replaces the events
+func (ical *icalendar) refresh() error {
+	b, err := ical.reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read icalendar. Cause: %+v", err)
+	}
+	events, err := parseICalendar(string(b), ical.location)
+	if err != nil {
+		return err
+	}
+	ical.mu.Lock()
+	ical.events = events
+	ical.mu.Unlock()
+	log.Infof("loaded %d icalendar events", len(events))
+	return nil
+}
+
+// parseICalendar parses the VEVENTs of an iCalendar file
+// The start of an event is always an instance of the event, even if it doesn't match the RRULE of the event.
+// Events which cannot be parsed, e.g. due to a TZID which isn't an IANA time zone name, are skipped.
+func parseICalendar(s string, location *time.Location) ([]icalEvent, error) {
+	// unfold lines which are split over multiple lines, see https://tools.ietf.org/html/rfc5545#section-3.1
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	s = strings.Replace(s, "\n ", "", -1)
+	s = strings.Replace(s, "\n\t", "", -1)
+	lines := strings.Split(s, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "BEGIN:VCALENDAR" {
+		return nil, fmt.Errorf("failed to parse icalendar: missing BEGIN:VCALENDAR")
+	}
+
+	events := make([]icalEvent, 0)
+	var props []string
+	var end string
+	inEvent := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			props = make([]string, 0)
+			end = ""
+		case line == "END:VEVENT":
+			inEvent = false
+			event, err := parseICalendarEvent(props, end, location)
+			if err != nil {
+				log.Warnf("skipping icalendar event: %s", err)
+				continue
+			}
+			events = append(events, event)
+		case !inEvent:
+		case hasProperty(line, "DTSTART"), hasProperty(line, "RRULE"), hasProperty(line, "RDATE"), hasProperty(line, "EXDATE"):
+			props = append(props, line)
+		case hasProperty(line, "DTEND"):
+			end = line
+		}
+	}
+	return events, nil
+}
+
+func parseICalendarEvent(props []string, end string, location *time.Location) (icalEvent, error) {
+	recurrence, err := common.ParseRecurrence(props, location)
+	if err != nil {
+		return icalEvent{}, err
+	}
+	if recurrence.Start.IsZero() {
+		return icalEvent{}, fmt.Errorf("event does not have a DTSTART")
+	}
+	recurrence.Dates = append(recurrence.Dates, recurrence.Start)
+
+	event := icalEvent{recurrence: recurrence}
+	if end != "" {
+		endT, err := common.ParseDateTimeProperty(end, location)
+		if err != nil {
+			return icalEvent{}, err
+		}
+		event.duration = endT.Sub(recurrence.Start)
+	} else if isDate(props) {
+		// an event with a date start and without an end lasts a day
+		event.duration = 24 * time.Hour
+	}
+	return event, nil
+}
+
+// hasProperty returns true if the content line is of the property with the given name
+func hasProperty(line, name string) bool {
+	return strings.HasPrefix(line, name+":") || strings.HasPrefix(line, name+";")
+}
+
+// isDate returns true if the DTSTART of the event properties is a date
+func isDate(props []string) bool {
+	for _, prop := range props {
+		if hasProperty(prop, "DTSTART") {
+			return len(prop[strings.LastIndex(prop, ":")+1:]) == len("20060102")
+		}
+	}
+	return false
+}
@@ -17,17 +17,29 @@ limitations under the License.
 package main
 
 import (
+	"os"
+
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/sdk"
 	"github.com/argoproj/argo-events/signals/calendar"
 	"github.com/micro/go-micro"
 	k8s "github.com/micro/kubernetes/go/micro"
+	"k8s.io/client-go/kubernetes"
 )
 
 func main() {
 	svc := k8s.NewService(micro.Name("calendar"), micro.Metadata(sdk.SignalMetadata))
 	svc.Init()
 
-	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(calendar.New()))
+	// kubernetes configuration
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	rest, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		panic(err)
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(rest)
+
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(calendar.New(kubeClient)))
 
 	if err := svc.Run(); err != nil {
 		panic(err)
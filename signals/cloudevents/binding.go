@@ -0,0 +1,337 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MediaTypeStructured is the media type of the events of the structured mode
+	MediaTypeStructured = "application/cloudevents+json"
+
+	// MediaTypeBatch is the media type of the events of the batch mode
+	MediaTypeBatch = "application/cloudevents-batch+json"
+
+	// the prefix of the headers of the attributes of the binary mode
+	headerPrefix = "ce-"
+
+	// the prefix of the headers of the extensions of the binary mode of CloudEvents v0.1
+	headerExtensionPrefix = "ce-x-"
+
+	// the media type of the JSON data of structured events
+	mediaTypeJSON = "application/json"
+)
+
+// the names of the context attributes of the CloudEvents versions
+// the first name is used by the latest CloudEvents versions and the last name by CloudEvents v0.1.
+var (
+	attributeSpecVersion = []string{"specversion", "cloudEventsVersion"}
+	attributeType        = []string{"type", "eventType"}
+	attributeTypeVersion = []string{"eventTypeVersion"}
+	attributeSource      = []string{"source"}
+	attributeID          = []string{"id", "eventID"}
+	attributeTime        = []string{"time", "eventTime"}
+	attributeSchema      = []string{"dataschema", "schemaurl", "schemaURL"}
+	attributeContentType = []string{"datacontenttype", "contenttype", "contentType"}
+)
+
+// the names of the members of structured events which are not context attributes
+const (
+	memberExtensions   = "extensions"
+	memberData         = "data"
+	memberDataBase64   = "data_base64"
+	memberDataEncoding = "datacontentencoding"
+)
+
+// knownMembers are the members of structured events which are not extensions
+var knownMembers = map[string]bool{memberExtensions: true, memberData: true, memberDataBase64: true, memberDataEncoding: true}
+
+func init() {
+	for _, names := range [][]string{attributeSpecVersion, attributeType, attributeTypeVersion, attributeSource, attributeID, attributeTime, attributeSchema, attributeContentType} {
+		for _, name := range names {
+			knownMembers[name] = true
+		}
+	}
+}
+
+// decodeRequest decodes the events of the request in the binary, structured or batch mode
+func decodeRequest(req *http.Request, body []byte) ([]*v1alpha1.Event, error) {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch mediaType {
+	case MediaTypeStructured:
+		event, err := decodeStructured(body)
+		if err != nil {
+			return nil, err
+		}
+		return []*v1alpha1.Event{event}, nil
+	case MediaTypeBatch:
+		var raws []json.RawMessage
+		if err := json.Unmarshal(body, &raws); err != nil {
+			return nil, fmt.Errorf("failed to decode batch of events. Cause: %+v", err.Error())
+		}
+		events := make([]*v1alpha1.Event, 0, len(raws))
+		for i, raw := range raws {
+			event, err := decodeStructured(raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode event %d of batch. Cause: %+v", i, err.Error())
+			}
+			events = append(events, event)
+		}
+		return events, nil
+	default:
+		event, err := decodeBinary(req.Header, body)
+		if err != nil {
+			return nil, err
+		}
+		return []*v1alpha1.Event{event}, nil
+	}
+}
+
+// decodeBinary decodes the event of the binary mode, whose attributes are the ce-* headers and whose data is the body
+func decodeBinary(header http.Header, body []byte) (*v1alpha1.Event, error) {
+	attributes := make(map[string]string)
+	extensions := make(map[string]string)
+	for name, values := range header {
+		name = strings.ToLower(name)
+		if !strings.HasPrefix(name, headerPrefix) || len(values) == 0 {
+			continue
+		}
+		// the values of the headers are percent-encoded since CloudEvents v1.0
+		value, err := url.PathUnescape(values[0])
+		if err != nil {
+			value = values[0]
+		}
+		if strings.HasPrefix(name, headerExtensionPrefix) {
+			extensions[strings.TrimPrefix(name, headerExtensionPrefix)] = value
+			continue
+		}
+		attributes[strings.TrimPrefix(name, headerPrefix)] = value
+	}
+	if _, ok := attributes["contenttype"]; !ok {
+		attributes["contenttype"] = header.Get("Content-Type")
+	}
+	lookup := func(names []string) string {
+		for _, name := range names {
+			if value, ok := attributes[strings.ToLower(name)]; ok {
+				delete(attributes, strings.ToLower(name))
+				return value
+			}
+		}
+		return ""
+	}
+	event, err := newEvent(lookup)
+	if err != nil {
+		return nil, err
+	}
+	// the remaining attributes are extensions
+	for name, value := range attributes {
+		extensions[name] = value
+	}
+	if len(extensions) > 0 {
+		event.Context.Extensions = extensions
+	}
+	event.Data = body
+	return event, nil
+}
+
+// decodeStructured decodes the event of the structured mode, which is a JSON object of the attributes and the data
+func decodeStructured(body []byte) (*v1alpha1.Event, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode structured event. Cause: %+v", err.Error())
+	}
+	var decodeErr error
+	lookup := func(names []string) string {
+		for _, name := range names {
+			if raw, ok := fields[name]; ok {
+				var value string
+				if err := json.Unmarshal(raw, &value); err != nil {
+					decodeErr = fmt.Errorf("attribute %s is not a string", name)
+				}
+				return value
+			}
+		}
+		return ""
+	}
+	event, err := newEvent(lookup)
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make(map[string]string)
+	// the extensions of CloudEvents v0.1 are a map, while the extensions of later versions are top-level attributes
+	if raw, ok := fields[memberExtensions]; ok {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, fmt.Errorf("failed to decode extensions. Cause: %+v", err.Error())
+		}
+		for name, value := range values {
+			extensions[name] = extensionValue(value)
+		}
+	}
+	for name, value := range fields {
+		if !knownMembers[name] {
+			extensions[name] = extensionValue(value)
+		}
+	}
+	if len(extensions) > 0 {
+		event.Context.Extensions = extensions
+	}
+
+	data, err := structuredData(fields, event.Context.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil && event.Context.ContentType == "" {
+		event.Context.ContentType = mediaTypeJSON
+	}
+	event.Data = data
+	return event, nil
+}
+
+// structuredData returns the data of the structured event
+// JSON data is kept as is while string data of other content types and base64 encoded data are decoded.
+func structuredData(fields map[string]json.RawMessage, contentType string) ([]byte, error) {
+	if raw, ok := fields[memberDataBase64]; ok {
+		return decodeBase64(raw)
+	}
+	raw, ok := fields[memberData]
+	if !ok || string(raw) == "null" {
+		return nil, nil
+	}
+	if encoding, ok := fields[memberDataEncoding]; ok {
+		var value string
+		if err := json.Unmarshal(encoding, &value); err == nil && strings.ToLower(value) == "base64" {
+			return decodeBase64(raw)
+		}
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if contentType == "" || mediaType == mediaTypeJSON || strings.HasSuffix(mediaType, "+json") {
+		return raw, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		// the data is not a string, e.g. a JSON object with a non JSON content type
+		return raw, nil
+	}
+	return []byte(value), nil
+}
+
+func decodeBase64(raw json.RawMessage) ([]byte, error) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("base64 encoded data is not a string")
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 encoded data. Cause: %+v", err.Error())
+	}
+	return data, nil
+}
+
+// extensionValue returns the string value of the extension, other JSON values are kept as is
+func extensionValue(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+	return string(raw)
+}
+
+// newEvent creates the event from the context attributes returned by the lookup of their names
+func newEvent(lookup func(names []string) string) (*v1alpha1.Event, error) {
+	ctx := v1alpha1.EventContext{
+		CloudEventsVersion: lookup(attributeSpecVersion),
+		EventType:          lookup(attributeType),
+		EventTypeVersion:   lookup(attributeTypeVersion),
+		EventID:            lookup(attributeID),
+		ContentType:        lookup(attributeContentType),
+	}
+	if ctx.CloudEventsVersion == "" {
+		return nil, fmt.Errorf("missing required attribute specversion")
+	}
+	if ctx.EventType == "" {
+		return nil, fmt.Errorf("missing required attribute type")
+	}
+	if ctx.EventID == "" {
+		return nil, fmt.Errorf("missing required attribute id")
+	}
+	source := lookup(attributeSource)
+	if source == "" {
+		return nil, fmt.Errorf("missing required attribute source")
+	}
+	var err error
+	if ctx.Source, err = parseURI(source); err != nil {
+		return nil, fmt.Errorf("invalid source %s. Cause: %+v", source, err.Error())
+	}
+	if schema := lookup(attributeSchema); schema != "" {
+		if ctx.SchemaURL, err = parseURI(schema); err != nil {
+			return nil, fmt.Errorf("invalid schema url %s. Cause: %+v", schema, err.Error())
+		}
+	}
+	if eventTime := lookup(attributeTime); eventTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, eventTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %s. Cause: %+v", eventTime, err.Error())
+		}
+		ctx.EventTime = metav1.Time{Time: t.UTC()}
+	} else {
+		ctx.EventTime = metav1.Time{Time: time.Now().UTC()}
+	}
+	return &v1alpha1.Event{Context: ctx}, nil
+}
+
+// parseURI parses the URI reference into its components
+func parseURI(value string) (*v1alpha1.URI, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	uri := &v1alpha1.URI{
+		Scheme:   u.Scheme,
+		Host:     u.Hostname(),
+		Path:     u.Path,
+		Query:    u.RawQuery,
+		Fragment: u.Fragment,
+	}
+	if u.Opaque != "" {
+		// e.g. urn:event:from:myapi
+		uri.Path = u.Opaque
+	}
+	if u.User != nil {
+		uri.User = u.User.Username()
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		uri.Port = int32(port)
+	}
+	return uri, nil
+}
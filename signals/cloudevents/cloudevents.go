@@ -0,0 +1,127 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxBodySize is the maximum size of the body of the requests, so that the events fit in the sensor status
+const maxBodySize = 1 << 20
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// like webhooks, the CloudEvents signals share one http server since the port is fixed at runtime.
+// The endpoints of the listening signals are registered with the server and removed when the signals stop.
+type cloudEvents struct {
+	// endpoints are the endpoints of the listening signals by path
+	endpoints sync.Map
+}
+
+// endpoint receives the events of a signal
+type endpoint struct {
+	signal string
+	stream *common.EventStream
+}
+
+// New creates a new CloudEvents listener serving the endpoints of the signals on the specified port
+func New(port int) sdk.Listener {
+	ce := &cloudEvents{}
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%v", port),
+		Handler: ce,
+		// Good practice to enforce timeouts to avoid Slowloris attacks
+		WriteTimeout: time.Second * 5,
+		ReadTimeout:  time.Second * 5,
+		IdleTimeout:  time.Second * 30,
+	}
+	go func() {
+		log.Printf("starting http server listening on: %s", srv.Addr)
+		err := srv.ListenAndServe()
+		if err == http.ErrServerClosed {
+			log.Printf("successfully shutdown http server")
+		} else {
+			log.Panicf("http server encountered error listening: %v", err)
+		}
+	}()
+	return ce
+}
+
+func (ce *cloudEvents) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	path := signal.CloudEvents.Endpoint
+	e := &endpoint{signal: signal.Name, stream: common.NewEventStream(done)}
+	if existing, loaded := ce.endpoints.LoadOrStore(path, e); loaded {
+		return nil, fmt.Errorf("endpoint %s is already used by signal '%s'", path, existing.(*endpoint).signal)
+	}
+
+	go func() {
+		<-done
+		ce.endpoints.Delete(path)
+		e.stream.Close()
+		log.Printf("signal '%s' stopped listening at [%s]", signal.Name, path)
+	}()
+	log.Printf("signal '%s' listening for cloudevents at [%s]...", signal.Name, path)
+	return e.stream.Events(), nil
+}
+
+// ServeHTTP decodes the events of the request and sends them to the signal of the endpoint
+// the request is only acknowledged once all its events were sent.
+func (ce *cloudEvents) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	value, ok := ce.endpoints.Load(req.URL.Path)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	e := value.(*endpoint)
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil && len(body) == maxBodySize {
+		log.Warnf("signal '%s' received a request body larger than %d bytes from '%s'", e.signal, maxBodySize, req.RemoteAddr)
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		log.Warnf("signal '%s' failed to read request body: %s", e.signal, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	events, err := decodeRequest(req, body)
+	if err != nil {
+		log.Warnf("signal '%s' received an invalid cloudevent from '%s': %s", e.signal, req.RemoteAddr, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, event := range events {
+		if !e.stream.Send(event, req.Context().Done()) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
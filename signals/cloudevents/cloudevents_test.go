@@ -0,0 +1,255 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestDecodeRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		headers     map[string]string
+		body        string
+		expected    []v1alpha1.EventContext
+		data        []string
+		wantErr     bool
+	}{
+		{
+			name:        "binary",
+			contentType: "application/json",
+			headers: map[string]string{
+				"ce-specversion": "1.0",
+				"ce-type":        "com.example.order.created",
+				"ce-source":      "https://shop.example.com/orders",
+				"ce-id":          "A234-1234-1234",
+				"ce-time":        "2018-04-05T17:31:00Z",
+				"ce-dataschema":  "https://shop.example.com/schemas/order",
+				"ce-tenant":      "acme%20corp",
+			},
+			body: `{"order":1}`,
+			expected: []v1alpha1.EventContext{{
+				CloudEventsVersion: "1.0",
+				EventType:          "com.example.order.created",
+				Source:             &v1alpha1.URI{Scheme: "https", Host: "shop.example.com", Path: "/orders"},
+				EventID:            "A234-1234-1234",
+				SchemaURL:          &v1alpha1.URI{Scheme: "https", Host: "shop.example.com", Path: "/schemas/order"},
+				ContentType:        "application/json",
+				Extensions:         map[string]string{"tenant": "acme corp"},
+			}},
+			data: []string{`{"order":1}`},
+		},
+		{
+			name:        "binary v0.1",
+			contentType: "text/plain",
+			headers: map[string]string{
+				"CE-CloudEventsVersion": "0.1",
+				"CE-EventType":          "com.example.ping",
+				"CE-EventTypeVersion":   "v2",
+				"CE-Source":             "/pinger",
+				"CE-EventID":            "1",
+				"CE-X-Region":           "eu",
+			},
+			body: "ping",
+			expected: []v1alpha1.EventContext{{
+				CloudEventsVersion: "0.1",
+				EventType:          "com.example.ping",
+				EventTypeVersion:   "v2",
+				Source:             &v1alpha1.URI{Path: "/pinger"},
+				EventID:            "1",
+				ContentType:        "text/plain",
+				Extensions:         map[string]string{"region": "eu"},
+			}},
+			data: []string{"ping"},
+		},
+		{
+			name:        "binary - missing id",
+			contentType: "application/json",
+			headers:     map[string]string{"ce-specversion": "1.0", "ce-type": "com.example.ping", "ce-source": "/pinger"},
+			body:        `{}`,
+			wantErr:     true,
+		},
+		{
+			name:        "structured",
+			contentType: "application/cloudevents+json; charset=utf-8",
+			body: `{"specversion":"1.0","type":"com.example.order.created","source":"urn:shop:orders","id":"2",
+				"time":"2018-04-05T17:31:00Z","priority":3,"tenant":"acme","data":{"order":2}}`,
+			expected: []v1alpha1.EventContext{{
+				CloudEventsVersion: "1.0",
+				EventType:          "com.example.order.created",
+				Source:             &v1alpha1.URI{Scheme: "urn", Path: "shop:orders"},
+				EventID:            "2",
+				ContentType:        "application/json",
+				Extensions:         map[string]string{"priority": "3", "tenant": "acme"},
+			}},
+			data: []string{`{"order":2}`},
+		},
+		{
+			name:        "structured v0.1",
+			contentType: "application/cloudevents+json",
+			body: `{"cloudEventsVersion":"0.1","eventType":"com.example.ping","source":"/pinger","eventID":"3",
+				"contentType":"text/plain","extensions":{"region":"eu"},"data":"ping"}`,
+			expected: []v1alpha1.EventContext{{
+				CloudEventsVersion: "0.1",
+				EventType:          "com.example.ping",
+				Source:             &v1alpha1.URI{Path: "/pinger"},
+				EventID:            "3",
+				ContentType:        "text/plain",
+				Extensions:         map[string]string{"region": "eu"},
+			}},
+			data: []string{"ping"},
+		},
+		{
+			name:        "structured - invalid time",
+			contentType: "application/cloudevents+json",
+			body:        `{"specversion":"1.0","type":"com.example.ping","source":"/pinger","id":"4","time":"yesterday"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "batch",
+			contentType: "application/cloudevents-batch+json",
+			body: `[{"specversion":"1.0","type":"com.example.ping","source":"/pinger","id":"5","data_base64":"cGluZw==","datacontenttype":"text/plain"},
+				{"specversion":"1.0","type":"com.example.pong","source":"/ponger","id":"6"}]`,
+			expected: []v1alpha1.EventContext{
+				{
+					CloudEventsVersion: "1.0",
+					EventType:          "com.example.ping",
+					Source:             &v1alpha1.URI{Path: "/pinger"},
+					EventID:            "5",
+					ContentType:        "text/plain",
+				},
+				{
+					CloudEventsVersion: "1.0",
+					EventType:          "com.example.pong",
+					Source:             &v1alpha1.URI{Path: "/ponger"},
+					EventID:            "6",
+				},
+			},
+			data: []string{"ping", ""},
+		},
+		{
+			name:        "batch - invalid event",
+			contentType: "application/cloudevents-batch+json",
+			body:        `[{"specversion":"1.0","type":"com.example.ping","source":"/pinger","id":"7"},{"specversion":"1.0"}]`,
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+			for name, value := range test.headers {
+				req.Header.Set(name, value)
+			}
+			events, err := decodeRequest(req, []byte(test.body))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("decodeRequest() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if len(events) != len(test.expected) {
+				t.Fatalf("expected %d events but found %d", len(test.expected), len(events))
+			}
+			for i, event := range events {
+				ctx := event.Context
+				if ctx.EventTime.IsZero() {
+					t.Errorf("expected event %d to have an event time", i)
+				}
+				ctx.EventTime = test.expected[i].EventTime
+				if !reflect.DeepEqual(ctx, test.expected[i]) {
+					t.Errorf("expected event context %+v but found %+v", test.expected[i], ctx)
+				}
+				if string(event.Data) != test.data[i] {
+					t.Errorf("expected event data %s but found %s", test.data[i], event.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestListen(t *testing.T) {
+	ce := &cloudEvents{}
+	server := httptest.NewServer(ce)
+	defer server.Close()
+
+	done := make(chan struct{})
+	signal := &v1alpha1.Signal{Name: "orders", CloudEvents: &v1alpha1.CloudEventsSignal{Endpoint: "/orders"}}
+	events, err := ce.Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ce.Listen(&v1alpha1.Signal{Name: "other", CloudEvents: signal.CloudEvents}, done); err == nil {
+		t.Error("expected an error for the endpoint which is already used")
+	}
+
+	statuses := make(chan int, 1)
+	post := func(path, body string) {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("ce-specversion", "1.0")
+		req.Header.Set("ce-type", "com.example.order.created")
+		req.Header.Set("ce-source", "/orders")
+		req.Header.Set("ce-id", "1")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Error(err)
+			statuses <- 0
+			return
+		}
+		resp.Body.Close()
+		statuses <- resp.StatusCode
+	}
+
+	go post("/orders", `{"order":1}`)
+	select {
+	case event := <-events:
+		if event.Context.EventID != "1" || event.Context.EventType != "com.example.order.created" {
+			t.Errorf("unexpected event context %+v", event.Context)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	if status := <-statuses; status != http.StatusAccepted {
+		t.Errorf("expected status %d but found %d", http.StatusAccepted, status)
+	}
+
+	post("/unknown", `{"order":1}`)
+	if status := <-statuses; status != http.StatusNotFound {
+		t.Errorf("expected status %d for an unknown endpoint but found %d", http.StatusNotFound, status)
+	}
+
+	post("/orders", `{"order":"`+strings.Repeat("1", maxBodySize)+`"}`)
+	if status := <-statuses; status != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d for a body which is too large but found %d", http.StatusRequestEntityTooLarge, status)
+	}
+
+	// the endpoint is removed once the signal stops
+	close(done)
+	if _, ok := <-events; ok {
+		t.Error("expected the events to be closed")
+	}
+	post("/orders", `{"order":1}`)
+	if status := <-statuses; status != http.StatusNotFound {
+		t.Errorf("expected status %d for a stopped signal but found %d", http.StatusNotFound, status)
+	}
+}
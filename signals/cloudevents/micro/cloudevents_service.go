@@ -0,0 +1,52 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/signals/cloudevents"
+	"github.com/micro/go-micro"
+	k8s "github.com/micro/kubernetes/go/micro"
+)
+
+const (
+	// EnvVarCloudEventsPort is the Env Var Key for the cloudevents port
+	EnvVarCloudEventsPort string = "CLOUDEVENTS_PORT"
+
+	// DefaultCloudEventsPort is the default port to use if the EnvVarCloudEventsPort is not set
+	DefaultCloudEventsPort int = 7071
+)
+
+func main() {
+	svc := k8s.NewService(micro.Name("cloudevents"), micro.Metadata(sdk.SignalMetadata))
+	svc.Init()
+
+	// get the container port from container
+	port := DefaultCloudEventsPort
+	if strPort, ok := os.LookupEnv(EnvVarCloudEventsPort); ok {
+		port, _ = strconv.Atoi(strPort)
+	}
+
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(cloudevents.New(port)))
+
+	if err := svc.Run(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EventType is the event type of the events of watched files
+	EventType = "com.github.argoproj.file"
+
+	// ContextExtensionOperationKey is the event context extension key of the operation on the file
+	ContextExtensionOperationKey = "operation"
+
+	// ContextExtensionPathKey is the event context extension key of the path of the file relative to the watched directory
+	ContextExtensionPathKey = "path"
+
+	// DefaultDebounce is the default duration for which a file must not be written before its event is emitted
+	DefaultDebounce = time.Second
+)
+
+// eventData is the data of the event of a watched file
+type eventData struct {
+	// Path is the absolute path of the file
+	Path string `json:"path"`
+	// Name is the path of the file relative to the watched directory
+	Name      string                 `json:"name"`
+	Operation v1alpha1.FileOperation `json:"operation"`
+	// Size and ModTime are not set for removed and renamed files
+	Size    int64      `json:"size"`
+	ModTime *time.Time `json:"modTime,omitempty"`
+}
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+type file struct{}
+
+// New creates a new file signal
+func New() sdk.Listener {
+	return &file{}
+}
+
+func (f *file) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	w, err := newWatcher(signal.File)
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan *v1alpha1.Event)
+	go w.run(events, done)
+	log.Printf("signal '%s' watching files of directory [%s]...", signal.Name, signal.File.Directory)
+	return events, nil
+}
+
+// watcher watches the files of a directory and debounces the events of created and written files
+type watcher struct {
+	signal     *v1alpha1.FileSignal
+	directory  string
+	debounce   time.Duration
+	operations map[v1alpha1.FileOperation]bool
+	fs         *fsnotify.Watcher
+
+	mu sync.Mutex
+	// pending are the timers of the created or written files which are debounced by path
+	pending map[string]*pendingFile
+	// ready receives the paths of the debounced files
+	ready chan string
+	// stopped is closed when the watcher stops
+	stopped chan struct{}
+}
+
+// pendingFile is a created or written file whose event is debounced
+type pendingFile struct {
+	timer   *time.Timer
+	created bool
+	// written is the time of the latest create or write of the file
+	written time.Time
+}
+
+func newWatcher(signal *v1alpha1.FileSignal) (*watcher, error) {
+	debounce := DefaultDebounce
+	if signal.Debounce != "" {
+		var err error
+		debounce, err = time.ParseDuration(signal.Debounce)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse debounce %s. Cause: %+v", signal.Debounce, err.Error())
+		}
+	}
+	operations := make(map[v1alpha1.FileOperation]bool)
+	for _, op := range signal.Operations {
+		operations[op] = true
+	}
+	if len(operations) == 0 {
+		for _, op := range []v1alpha1.FileOperation{v1alpha1.FileOperationCreate, v1alpha1.FileOperationWrite, v1alpha1.FileOperationRemove, v1alpha1.FileOperationRename} {
+			operations[op] = true
+		}
+	}
+	fs, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file system watcher. Cause: %+v", err.Error())
+	}
+	w := &watcher{
+		signal:     signal,
+		directory:  filepath.Clean(signal.Directory),
+		debounce:   debounce,
+		operations: operations,
+		fs:         fs,
+		pending:    make(map[string]*pendingFile),
+		ready:      make(chan string),
+		stopped:    make(chan struct{}),
+	}
+	if _, err := w.watchDirectory(w.directory); err != nil {
+		fs.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// watchDirectory watches the directory and its subdirectories if the signal is recursive
+// returns the files found in the subdirectories, which is used to detect files created
+// in new subdirectories before they were watched.
+func (w *watcher) watchDirectory(dir string) ([]string, error) {
+	if !w.signal.Recursive {
+		if err := w.fs.Add(dir); err != nil {
+			return nil, fmt.Errorf("failed to watch directory %s. Cause: %+v", dir, err.Error())
+		}
+		return nil, nil
+	}
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			return nil
+		}
+		if err := w.fs.Add(path); err != nil {
+			return fmt.Errorf("failed to watch directory %s. Cause: %+v", path, err.Error())
+		}
+		return nil
+	})
+	return files, err
+}
+
+// run handles the file system events and sends the events of the files until done is closed
+func (w *watcher) run(events chan<- *v1alpha1.Event, done <-chan struct{}) {
+	defer close(events)
+	defer w.stop()
+	for {
+		select {
+		case e, ok := <-w.fs.Events:
+			if !ok {
+				return
+			}
+			if event := w.handle(e); event != nil {
+				if !send(events, event, done) {
+					return
+				}
+			}
+		case path := <-w.ready:
+			if event := w.flush(path); event != nil {
+				if !send(events, event, done) {
+					return
+				}
+			}
+		case err, ok := <-w.fs.Errors:
+			if !ok {
+				return
+			}
+			log.Warnf("failed to watch files of directory %s: %s", w.directory, err)
+		case <-done:
+			return
+		}
+	}
+}
+
+func send(events chan<- *v1alpha1.Event, event *v1alpha1.Event, done <-chan struct{}) bool {
+	select {
+	case events <- event:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// stop closes the file system watcher and stops the pending timers
+func (w *watcher) stop() {
+	close(w.stopped)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for path, p := range w.pending {
+		p.timer.Stop()
+		delete(w.pending, path)
+	}
+	if err := w.fs.Close(); err != nil {
+		log.Warnf("failed to close file system watcher of directory %s: %s", w.directory, err)
+	}
+}
+
+// handle handles a file system event
+// the events of created and written files are debounced, while the events of removed and renamed files are returned.
+func (w *watcher) handle(e fsnotify.Event) *v1alpha1.Event {
+	path := filepath.Clean(e.Name)
+	switch {
+	case e.Op&fsnotify.Create == fsnotify.Create:
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			if w.signal.Recursive {
+				files, err := w.watchDirectory(path)
+				if err != nil {
+					log.Warnf("failed to watch new directory %s: %s", path, err)
+				}
+				// the files may have been created before the directory was watched
+				for _, file := range files {
+					w.schedule(file, true)
+				}
+			}
+			return nil
+		}
+		w.schedule(path, true)
+	case e.Op&fsnotify.Write == fsnotify.Write:
+		w.schedule(path, false)
+	case e.Op&fsnotify.Remove == fsnotify.Remove:
+		return w.removed(path, v1alpha1.FileOperationRemove)
+	case e.Op&fsnotify.Rename == fsnotify.Rename:
+		return w.removed(path, v1alpha1.FileOperationRename)
+	}
+	return nil
+}
+
+// schedule debounces the event of the created or written file
+func (w *watcher) schedule(path string, created bool) {
+	if !w.matches(path) {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if p, ok := w.pending[path]; ok {
+		p.created = p.created || created
+		p.written = time.Now()
+		p.timer.Reset(w.debounce)
+		return
+	}
+	w.pending[path] = &pendingFile{
+		created: created,
+		written: time.Now(),
+		timer: time.AfterFunc(w.debounce, func() {
+			select {
+			case w.ready <- path:
+			case <-w.stopped:
+			}
+		}),
+	}
+}
+
+// flush returns the event of the debounced file, unless the file no longer exists
+func (w *watcher) flush(path string) *v1alpha1.Event {
+	w.mu.Lock()
+	p, ok := w.pending[path]
+	if !ok || time.Since(p.written) < w.debounce {
+		// the file was written again after the timer fired, the reset timer fires again
+		w.mu.Unlock()
+		return nil
+	}
+	delete(w.pending, path)
+	w.mu.Unlock()
+	op := v1alpha1.FileOperationWrite
+	if p.created {
+		op = v1alpha1.FileOperationCreate
+	}
+	if !w.operations[op] {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		log.Debugf("skipping event of file %s which no longer exists: %s", path, err)
+		return nil
+	}
+	if info.IsDir() {
+		return nil
+	}
+	modTime := info.ModTime().UTC()
+	return w.newEvent(&eventData{
+		Path:      path,
+		Operation: op,
+		Size:      info.Size(),
+		ModTime:   &modTime,
+	})
+}
+
+// removed returns the event of the removed or renamed file and cancels its pending event
+// a renamed file is created with its new name, which emits a separate event.
+func (w *watcher) removed(path string, op v1alpha1.FileOperation) *v1alpha1.Event {
+	w.mu.Lock()
+	if p, ok := w.pending[path]; ok {
+		p.timer.Stop()
+		delete(w.pending, path)
+	}
+	w.mu.Unlock()
+	if !w.operations[op] || !w.matches(path) {
+		return nil
+	}
+	return w.newEvent(&eventData{Path: path, Operation: op})
+}
+
+// matches checks if the path relative to the directory or the name of the file matches any of the patterns
+func (w *watcher) matches(path string) bool {
+	if len(w.signal.Patterns) == 0 {
+		return true
+	}
+	rel, err := filepath.Rel(w.directory, path)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range w.signal.Patterns {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *watcher) newEvent(data *eventData) *v1alpha1.Event {
+	if rel, err := filepath.Rel(w.directory, data.Path); err == nil {
+		data.Name = filepath.ToSlash(rel)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		log.Warnf("failed to marshal event data of file %s: %s", data.Path, err)
+		return nil
+	}
+	eventTime := time.Now().UTC()
+	eventID := fmt.Sprintf("%s-%s-%d", strings.ToLower(string(data.Operation)), data.Name, eventTime.UnixNano())
+	if data.ModTime != nil {
+		eventTime = *data.ModTime
+		eventID = fmt.Sprintf("%s-%s-%d-%d", strings.ToLower(string(data.Operation)), data.Name, data.ModTime.UnixNano(), data.Size)
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            eventID,
+			EventTime:          metav1.Time{Time: eventTime},
+			Source:             &v1alpha1.URI{Scheme: "file", Path: data.Path},
+			ContentType:        "application/json",
+			Extensions: map[string]string{
+				ContextExtensionOperationKey: string(data.Operation),
+				ContextExtensionPathKey:      data.Name,
+			},
+		},
+		Data: b,
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func receive(t *testing.T, events <-chan *v1alpha1.Event) *eventData {
+	select {
+	case event := <-events:
+		var data eventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			t.Fatal(err)
+		}
+		if event.Context.Extensions[ContextExtensionPathKey] != data.Name {
+			t.Errorf("expected the path extension %s but found %s", data.Name, event.Context.Extensions[ContextExtensionPathKey])
+		}
+		return &data
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected an event")
+	}
+	return nil
+}
+
+func expectNoEvent(t *testing.T, events <-chan *v1alpha1.Event) {
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event %s", event.Data)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestFileSignal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-signal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	signal := &v1alpha1.Signal{
+		Name: "file-test",
+		File: &v1alpha1.FileSignal{
+			Directory: dir,
+			Recursive: true,
+			Patterns:  []string{"*.csv"},
+			Debounce:  "200ms",
+		},
+	}
+	done := make(chan struct{})
+	events, err := New().Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a file written in several steps emits a single create event
+	path := filepath.Join(dir, "a.csv")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := f.WriteString("a,b\n"); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	f.Close()
+	data := receive(t, events)
+	if data.Operation != v1alpha1.FileOperationCreate || data.Path != path || data.Name != "a.csv" || data.Size != 12 || data.ModTime == nil {
+		t.Errorf("unexpected event data %+v", data)
+	}
+	expectNoEvent(t, events)
+
+	// files not matching the patterns are ignored
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	expectNoEvent(t, events)
+
+	// files of new subdirectories are watched
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if err := ioutil.WriteFile(filepath.Join(sub, "b.csv"), []byte("b"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data = receive(t, events)
+	if data.Operation != v1alpha1.FileOperationCreate || data.Name != "sub/b.csv" {
+		t.Errorf("unexpected event data %+v", data)
+	}
+
+	// removed files emit an event immediately
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	data = receive(t, events)
+	if data.Operation != v1alpha1.FileOperationRemove || data.Name != "a.csv" {
+		t.Errorf("unexpected event data %+v", data)
+	}
+
+	close(done)
+	// ensure events channel is closed
+	for range events {
+	}
+}
+
+func TestWatcherOperations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-signal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.csv")
+	if err := ioutil.WriteFile(path, []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	signal := &v1alpha1.Signal{
+		Name: "file-test",
+		File: &v1alpha1.FileSignal{
+			Directory:  dir,
+			Operations: []v1alpha1.FileOperation{v1alpha1.FileOperationWrite, v1alpha1.FileOperationRename},
+			Debounce:   "100ms",
+		},
+	}
+	done := make(chan struct{})
+	defer close(done)
+	events, err := New().Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("ab"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data := receive(t, events)
+	if data.Operation != v1alpha1.FileOperationWrite || data.Size != 2 {
+		t.Errorf("unexpected event data %+v", data)
+	}
+
+	// the renamed file is not emitted as created file since create is not watched
+	if err := os.Rename(path, filepath.Join(dir, "b.csv")); err != nil {
+		t.Fatal(err)
+	}
+	data = receive(t, events)
+	if data.Operation != v1alpha1.FileOperationRename || data.Name != "a.csv" {
+		t.Errorf("unexpected event data %+v", data)
+	}
+	expectNoEvent(t, events)
+}
+
+func TestMatches(t *testing.T) {
+	w := &watcher{
+		signal:    &v1alpha1.FileSignal{Patterns: []string{"*.csv", "reports/*.pdf"}},
+		directory: "/files",
+	}
+	tests := []struct {
+		path    string
+		matches bool
+	}{
+		{path: "/files/a.csv", matches: true},
+		{path: "/files/sub/a.csv", matches: true},
+		{path: "/files/reports/a.pdf", matches: true},
+		{path: "/files/a.pdf", matches: false},
+		{path: "/files/a.txt", matches: false},
+	}
+	for _, test := range tests {
+		if w.matches(test.path) != test.matches {
+			t.Errorf("expected %s to match: %t", test.path, test.matches)
+		}
+	}
+}
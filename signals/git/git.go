@@ -0,0 +1,461 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	log "github.com/sirupsen/logrus"
+	cryptossh "golang.org/x/crypto/ssh"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	githttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+	gitssh "gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
+	"gopkg.in/src-d/go-git.v4/storage/memory"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EventType is the event type of the events of new commits and tags
+	EventType = "com.github.argoproj.git"
+
+	// DefaultInterval is the default duration between polls of the remote repository
+	DefaultInterval = time.Minute
+
+	// DefaultMaxCommits is the default maximum number of new commits of a ref emitted per poll
+	DefaultMaxCommits = 100
+
+	// the default username used to authenticate with the token or SSH key
+	defaultUsername = "git"
+
+	// the name of the remote of the repository
+	remoteName = "origin"
+)
+
+// the default patterns of the watched refs
+var defaultRefs = []string{"refs/heads/*", "refs/tags/*"}
+
+// eventData is the data of the event of a new commit or tag
+type eventData struct {
+	Repository string `json:"repository"`
+	Ref        string `json:"ref"`
+	// Tag is the short name of the tag of tag events
+	Tag          string    `json:"tag,omitempty"`
+	SHA          string    `json:"sha"`
+	Author       signature `json:"author"`
+	Committer    signature `json:"committer"`
+	Message      string    `json:"message"`
+	Parents      []string  `json:"parents,omitempty"`
+	ChangedFiles []string  `json:"changedFiles"`
+}
+
+type signature struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	When  time.Time `json:"when"`
+}
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// Listen() methods CAN retrieve the kubeClient from the git struct.
+type gitSignal struct {
+	kubeClient kubernetes.Interface
+}
+
+// New creates a new git signal
+// the kubeClient is used to retrieve the credentials of the repositories and can be nil
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &gitSignal{kubeClient: kubeClient}
+}
+
+func (g *gitSignal) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	interval := DefaultInterval
+	if signal.Git.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(signal.Git.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval %s. Cause: %+v", signal.Git.Interval, err.Error())
+		}
+	}
+	auth, err := g.resolveAuth(signal.Git)
+	if err != nil {
+		return nil, err
+	}
+	var lastRefs map[string]string
+	if signal.State != nil {
+		lastRefs = signal.State.GitRefs
+	}
+	p, err := newPoller(signal.Git, lastRefs, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			newEvents, err := p.poll()
+			if err != nil {
+				log.Warnf("failed to poll git repository %s: %s", signal.Git.URL, err)
+			}
+			for _, event := range newEvents {
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+	log.Printf("signal '%s' polling git repository [%s] every %s...", signal.Name, signal.Git.URL, interval)
+	return events, nil
+}
+
+// resolveAuth resolves the authentication method of the repository from the secrets of the git signal
+func (g *gitSignal) resolveAuth(signal *v1alpha1.GitSignal) (transport.AuthMethod, error) {
+	if signal.SSHKey == nil && signal.Token == nil {
+		return nil, nil
+	}
+	if g.kubeClient == nil {
+		return nil, fmt.Errorf("failed to retrieve git credentials: kubernetes client is not configured")
+	}
+	username := signal.Username
+	if username == "" {
+		username = defaultUsername
+	}
+	if signal.SSHKey != nil {
+		key, err := store.GetSecrets(g.kubeClient, common.DefaultSensorControllerNamespace, signal.SSHKey.Name, signal.SSHKey.Key)
+		if err != nil {
+			return nil, err
+		}
+		auth, err := gitssh.NewPublicKeys(username, []byte(key), "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ssh key. Cause: %+v", err.Error())
+		}
+		if signal.InsecureIgnoreHostKey {
+			auth.HostKeyCallback = cryptossh.InsecureIgnoreHostKey()
+		}
+		return auth, nil
+	}
+	token, err := store.GetSecrets(g.kubeClient, common.DefaultSensorControllerNamespace, signal.Token.Name, signal.Token.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &githttp.BasicAuth{Username: username, Password: token}, nil
+}
+
+// poller polls the refs of a remote repository and fetches the new commits of the refs which moved
+// the commits are fetched into an in-memory repository which is kept between polls.
+type poller struct {
+	signal     *v1alpha1.GitSignal
+	auth       transport.AuthMethod
+	patterns   []string
+	maxCommits int
+	repo       *git.Repository
+	remote     *git.Remote
+	source     *v1alpha1.URI
+
+	// refs are the hashes of the watched refs by name
+	refs map[string]string
+	// initialized is set once the refs of the remote repository were listed for the first time
+	initialized bool
+}
+
+// newPoller creates a poller of the remote repository of the git signal
+// the lastRefs are the commits of the refs of the latest accepted events, whose new commits are caught up on.
+func newPoller(signal *v1alpha1.GitSignal, lastRefs map[string]string, auth transport.AuthMethod) (*poller, error) {
+	endpoint, err := transport.NewEndpoint(signal.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse git repository url %s. Cause: %+v", signal.URL, err.Error())
+	}
+	repo, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := repo.CreateRemote(&config.RemoteConfig{Name: remoteName, URLs: []string{signal.URL}})
+	if err != nil {
+		return nil, err
+	}
+	patterns := signal.Refs
+	if len(patterns) == 0 {
+		patterns = defaultRefs
+	}
+	maxCommits := DefaultMaxCommits
+	if signal.MaxCommits > 0 {
+		maxCommits = int(signal.MaxCommits)
+	}
+	refs := make(map[string]string)
+	for name, hash := range lastRefs {
+		refs[name] = hash
+	}
+	return &poller{
+		signal:     signal,
+		auth:       auth,
+		patterns:   patterns,
+		maxCommits: maxCommits,
+		repo:       repo,
+		remote:     remote,
+		source: &v1alpha1.URI{
+			Scheme: endpoint.Protocol,
+			User:   endpoint.User,
+			Host:   endpoint.Host,
+			Port:   int32(endpoint.Port),
+			Path:   endpoint.Path,
+		},
+		refs: refs,
+	}, nil
+}
+
+// poll lists the refs of the remote repository and returns the events of the new commits and tags since the last poll
+// the refs which exist when the repository is polled for the first time are not emitted, unless they moved since
+// the latest accepted events of the signal.
+func (p *poller) poll() ([]*v1alpha1.Event, error) {
+	remoteRefs, err := p.remote.List(&git.ListOptions{Auth: p.auth})
+	if err != nil && err != transport.ErrEmptyRemoteRepository {
+		return nil, err
+	}
+	current := make(map[string]string)
+	for _, ref := range remoteRefs {
+		if ref.Type() != plumbing.HashReference || !p.matches(ref.Name()) {
+			continue
+		}
+		current[ref.Name().String()] = ref.Hash().String()
+	}
+	if !p.initialized {
+		for name, hash := range current {
+			if _, ok := p.refs[name]; !ok {
+				p.refs[name] = hash
+			}
+		}
+		p.initialized = true
+	}
+	var changed []string
+	for name, hash := range current {
+		if p.refs[name] != hash {
+			changed = append(changed, name)
+		}
+	}
+	for name := range p.refs {
+		if _, ok := current[name]; !ok {
+			delete(p.refs, name)
+		}
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	sort.Strings(changed)
+
+	refSpecs := make([]config.RefSpec, 0, len(changed))
+	for _, name := range changed {
+		refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+%s:%s", name, name)))
+	}
+	err = p.remote.Fetch(&git.FetchOptions{RemoteName: remoteName, RefSpecs: refSpecs, Auth: p.auth, Tags: git.NoTags, Force: true})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return nil, fmt.Errorf("failed to fetch refs %v. Cause: %+v", changed, err.Error())
+	}
+
+	var events []*v1alpha1.Event
+	for _, name := range changed {
+		refEvents, err := p.refEvents(plumbing.ReferenceName(name), plumbing.NewHash(current[name]), p.refs[name])
+		if err != nil {
+			log.Warnf("failed to resolve new commits of ref %s: %s", name, err)
+			continue
+		}
+		events = append(events, refEvents...)
+		p.refs[name] = current[name]
+	}
+	return events, nil
+}
+
+// refEvents returns the events of the new commits of a branch since the last commit, oldest first,
+// or the event of a new or moved tag
+func (p *poller) refEvents(name plumbing.ReferenceName, hash plumbing.Hash, last string) ([]*v1alpha1.Event, error) {
+	commit, err := p.resolveCommit(hash)
+	if err != nil {
+		return nil, err
+	}
+	if commit.Hash.String() == last {
+		// the hash of an annotated tag is the hash of the tag object while its last commit is the tagged commit
+		return nil, nil
+	}
+	if name.IsTag() || last == "" {
+		event, err := p.newEvent(name, commit)
+		if err != nil {
+			return nil, err
+		}
+		return []*v1alpha1.Event{event}, nil
+	}
+
+	// the new commits are the ones which are reachable from the commit but not from the last commit, like last..commit
+	// the history of the ref is walked up to the maximum of commits if the last commit is unknown, e.g. it was force pushed
+	// away before the signal restarted.
+	seen := make(map[plumbing.Hash]bool)
+	lastCommit, err := p.repo.CommitObject(plumbing.NewHash(last))
+	switch err {
+	case nil:
+		err := object.NewCommitPreorderIter(lastCommit, nil, nil).ForEach(func(c *object.Commit) error {
+			seen[c.Hash] = true
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case plumbing.ErrObjectNotFound:
+	default:
+		return nil, err
+	}
+	var commits []*object.Commit
+	iter := object.NewCommitPreorderIter(commit, seen, nil)
+	defer iter.Close()
+	for len(commits) < p.maxCommits {
+		c, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, c)
+	}
+	events := make([]*v1alpha1.Event, 0, len(commits))
+	for i := len(commits) - 1; i >= 0; i-- {
+		event, err := p.newEvent(name, commits[i])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// resolveCommit returns the commit of the hash of a ref, which is a tag object for annotated tags
+func (p *poller) resolveCommit(hash plumbing.Hash) (*object.Commit, error) {
+	if tag, err := p.repo.TagObject(hash); err == nil {
+		return tag.Commit()
+	}
+	return p.repo.CommitObject(hash)
+}
+
+// matches checks if the full or short name of the ref matches any of the patterns
+func (p *poller) matches(name plumbing.ReferenceName) bool {
+	if !name.IsBranch() && !name.IsTag() {
+		return false
+	}
+	for _, pattern := range p.patterns {
+		if ok, _ := path.Match(pattern, name.String()); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, name.Short()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *poller) newEvent(name plumbing.ReferenceName, commit *object.Commit) (*v1alpha1.Event, error) {
+	files, err := changedFiles(commit)
+	if err != nil {
+		return nil, err
+	}
+	data := &eventData{
+		Repository:   p.signal.URL,
+		Ref:          name.String(),
+		SHA:          commit.Hash.String(),
+		Author:       signature{Name: commit.Author.Name, Email: commit.Author.Email, When: commit.Author.When.UTC()},
+		Committer:    signature{Name: commit.Committer.Name, Email: commit.Committer.Email, When: commit.Committer.When.UTC()},
+		Message:      commit.Message,
+		ChangedFiles: files,
+	}
+	if name.IsTag() {
+		data.Tag = name.Short()
+	}
+	for _, parent := range commit.ParentHashes {
+		data.Parents = append(data.Parents, parent.String())
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	source := *p.source
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s@%s", name, commit.Hash),
+			EventTime:          metav1.Time{Time: commit.Committer.When.UTC()},
+			Source:             &source,
+			ContentType:        "application/json",
+			Extensions: map[string]string{
+				sdk.ContextExtensionGitRefKey:    name.String(),
+				sdk.ContextExtensionGitCommitKey: commit.Hash.String(),
+			},
+		},
+		Data: b,
+	}, nil
+}
+
+// changedFiles returns the paths of the files changed by the commit compared to its first parent
+func changedFiles(commit *object.Commit) ([]string, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	var parentTree *object.Tree
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, err
+		}
+	}
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(changes))
+	for _, change := range changes {
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	return files, nil
+}
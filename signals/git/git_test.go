@@ -0,0 +1,300 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"gopkg.in/src-d/go-billy.v4/osfs"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/client"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/server"
+)
+
+func init() {
+	// serve local repositories in-process instead of with the git binaries
+	client.InstallProtocol("file", server.NewClient(server.NewFilesystemLoader(osfs.New("/"))))
+}
+
+// testRepo is a working repository which pushes to a local bare repository
+type testRepo struct {
+	t      *testing.T
+	dir    string
+	bare   string
+	repo   *git.Repository
+	commit int
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	dir, err := ioutil.TempDir("", "git-signal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bare := filepath.Join(dir, "bare.git")
+	if _, err := git.PlainInit(bare, true); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := git.PlainInit(filepath.Join(dir, "work"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bare}}); err != nil {
+		t.Fatal(err)
+	}
+	return &testRepo{t: t, dir: dir, bare: bare, repo: repo}
+}
+
+// commitFile writes the file and commits it to the current branch
+func (r *testRepo) commitFile(name, content, message string) plumbing.Hash {
+	w, err := r.repo.Worktree()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(r.dir, "work", name), []byte(content), 0644); err != nil {
+		r.t.Fatal(err)
+	}
+	if _, err := w.Add(name); err != nil {
+		r.t.Fatal(err)
+	}
+	r.commit++
+	hash, err := w.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{Name: "argo", Email: "argo@argoproj.io", When: time.Unix(int64(1530000000+r.commit), 0)},
+	})
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return hash
+}
+
+// merge commits a merge of the parents to the current branch
+func (r *testRepo) merge(message string, parents ...plumbing.Hash) plumbing.Hash {
+	w, err := r.repo.Worktree()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	r.commit++
+	hash, err := w.Commit(message, &git.CommitOptions{
+		Author:  &object.Signature{Name: "argo", Email: "argo@argoproj.io", When: time.Unix(int64(1530000000+r.commit), 0)},
+		Parents: parents,
+	})
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return hash
+}
+
+func (r *testRepo) tag(name string, hash plumbing.Hash) {
+	ref := plumbing.NewHashReference(plumbing.ReferenceName("refs/tags/"+name), hash)
+	if err := r.repo.Storer.SetReference(ref); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func (r *testRepo) push() {
+	err := r.repo.Push(&git.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"},
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		r.t.Fatal(err)
+	}
+}
+
+func decode(t *testing.T, event *v1alpha1.Event) *eventData {
+	var data eventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if event.Context.Extensions[sdk.ContextExtensionGitRefKey] != data.Ref || event.Context.Extensions[sdk.ContextExtensionGitCommitKey] != data.SHA {
+		t.Errorf("expected the ref and commit extensions of %s@%s but found %v", data.Ref, data.SHA, event.Context.Extensions)
+	}
+	return &data
+}
+
+func TestPoller(t *testing.T) {
+	repo := newTestRepo(t)
+	defer os.RemoveAll(repo.dir)
+	repo.commitFile("README.md", "hello", "initial commit")
+	repo.push()
+
+	p, err := newPoller(&v1alpha1.GitSignal{URL: repo.bare, Refs: []string{"master", "refs/tags/v*"}}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the existing refs are not emitted
+	events, err := p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events for the existing refs but found %d", len(events))
+	}
+
+	// an event is emitted per new commit, oldest first
+	first := repo.commitFile("a.txt", "a", "add a")
+	second := repo.commitFile("b.txt", "b", "add b")
+	repo.push()
+	events, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events for the new commits but found %d", len(events))
+	}
+	data := decode(t, events[0])
+	if data.SHA != first.String() || data.Ref != "refs/heads/master" || data.Message != "add a" ||
+		data.Author.Name != "argo" || !reflect.DeepEqual(data.ChangedFiles, []string{"a.txt"}) {
+		t.Errorf("unexpected event data %+v", data)
+	}
+	data = decode(t, events[1])
+	if data.SHA != second.String() || len(data.Parents) != 1 || data.Parents[0] != first.String() {
+		t.Errorf("unexpected event data %+v", data)
+	}
+
+	// new tags matching the patterns are emitted
+	repo.tag("v1.0", second)
+	repo.tag("latest", second)
+	repo.push()
+	events, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event for the new tag but found %d", len(events))
+	}
+	data = decode(t, events[0])
+	if data.Ref != "refs/tags/v1.0" || data.Tag != "v1.0" || data.SHA != second.String() {
+		t.Errorf("unexpected event data %+v", data)
+	}
+
+	// nothing changed
+	events, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events but found %d", len(events))
+	}
+}
+
+func TestPollerLastRefs(t *testing.T) {
+	repo := newTestRepo(t)
+	defer os.RemoveAll(repo.dir)
+	first := repo.commitFile("README.md", "hello", "initial commit")
+	second := repo.commitFile("a.txt", "a", "add a")
+	third := repo.commitFile("a.txt", "aa", "update a")
+	repo.push()
+
+	// the commits since the latest accepted commit of a ref are caught up on after a restart
+	signal := &v1alpha1.GitSignal{
+		URL:        repo.bare,
+		MaxCommits: 1,
+	}
+	p, err := newPoller(signal, map[string]string{"refs/heads/master": first.String()}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the latest commits up to the maximum are emitted
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event but found %d", len(events))
+	}
+	data := decode(t, events[0])
+	if data.SHA != third.String() || !reflect.DeepEqual(data.ChangedFiles, []string{"a.txt"}) {
+		t.Errorf("unexpected event data %+v, second commit %s", data, second)
+	}
+}
+
+func TestPollerMerges(t *testing.T) {
+	repo := newTestRepo(t)
+	defer os.RemoveAll(repo.dir)
+	last := repo.commitFile("README.md", "hello", "initial commit")
+	repo.push()
+	p, err := newPoller(&v1alpha1.GitSignal{URL: repo.bare}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.poll(); err != nil {
+		t.Fatal(err)
+	}
+	shas := func(events []*v1alpha1.Event) []string {
+		var shas []string
+		for _, event := range events {
+			shas = append(shas, decode(t, event).SHA)
+		}
+		return shas
+	}
+
+	// the commits merged into the last commit are new
+	feature := repo.commitFile("a.txt", "a", "add a")
+	merge := repo.merge("merge feature", last, feature)
+	repo.push()
+	events, err := p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{feature.String(), merge.String()}; !reflect.DeepEqual(shas(events), expected) {
+		t.Errorf("expected the events of the commits %v but found %v", expected, shas(events))
+	}
+
+	// the commits of the history of the last commit are not new when it is merged into another branch
+	fix := repo.merge("fix a", feature)
+	merge2 := repo.merge("merge master", fix, merge)
+	repo.push()
+	events, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{fix.String(), merge2.String()}; !reflect.DeepEqual(shas(events), expected) {
+		t.Errorf("expected the events of the commits %v but found %v", expected, shas(events))
+	}
+}
+
+func TestMatches(t *testing.T) {
+	p := &poller{patterns: []string{"master", "release-*", "refs/tags/v*"}}
+	tests := []struct {
+		ref     string
+		matches bool
+	}{
+		{ref: "refs/heads/master", matches: true},
+		{ref: "refs/heads/release-1.0", matches: true},
+		{ref: "refs/heads/feature", matches: false},
+		{ref: "refs/tags/v1.0", matches: true},
+		{ref: "refs/tags/latest", matches: false},
+		{ref: "HEAD", matches: false},
+	}
+	for _, test := range tests {
+		if p.matches(plumbing.ReferenceName(test.ref)) != test.matches {
+			t.Errorf("expected %s to match: %t", test.ref, test.matches)
+		}
+	}
+}
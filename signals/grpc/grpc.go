@@ -0,0 +1,221 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/sdk/publish"
+	"github.com/argoproj/argo-events/store"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// like webhooks, the grpc signals share one gRPC server since the port is fixed at runtime.
+// The routes of the listening signals are registered with the server and removed when the signals stop.
+type grpcSignal struct {
+	kubeClient kubernetes.Interface
+
+	// routes are the routes of the listening signals by signal name
+	routes sync.Map
+}
+
+// route receives the events published to a signal
+type route struct {
+	signal *v1alpha1.Signal
+	token  string
+	stream *common.EventStream
+}
+
+// New creates a new grpc listener serving the publish API on the specified port
+// the credentials secure the server with TLS and can be nil, the kubeClient is used to retrieve the tokens of the signals.
+func New(kubeClient kubernetes.Interface, port int, creds credentials.TransportCredentials) sdk.Listener {
+	g := &grpcSignal{kubeClient: kubeClient}
+	var opts []grpclib.ServerOption
+	if creds != nil {
+		opts = append(opts, grpclib.Creds(creds))
+	}
+	srv := grpclib.NewServer(opts...)
+	publish.RegisterPublishServiceServer(srv, g)
+	go func() {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
+		if err != nil {
+			log.Panicf("grpc server failed to listen: %v", err)
+		}
+		log.Printf("starting grpc server listening on: %s", lis.Addr())
+		if err := srv.Serve(lis); err != nil {
+			log.Panicf("grpc server encountered error serving: %v", err)
+		}
+	}()
+	return g
+}
+
+func (g *grpcSignal) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	token, err := g.resolveToken(signal.GRPC)
+	if err != nil {
+		return nil, err
+	}
+	r := &route{signal: signal, token: token, stream: common.NewEventStream(done)}
+	if _, loaded := g.routes.LoadOrStore(signal.Name, r); loaded {
+		return nil, fmt.Errorf("signal '%s' is already listening", signal.Name)
+	}
+
+	go func() {
+		<-done
+		g.routes.Delete(signal.Name)
+		r.stream.Close()
+		log.Printf("signal '%s' stopped listening for published events", signal.Name)
+	}()
+	log.Printf("signal '%s' listening for published events...", signal.Name)
+	return r.stream.Events(), nil
+}
+
+// resolveToken resolves the token of the callers from the secret of the signal
+func (g *grpcSignal) resolveToken(signal *v1alpha1.GRPCSignal) (string, error) {
+	if signal.Token == nil {
+		return "", nil
+	}
+	if g.kubeClient == nil {
+		return "", fmt.Errorf("failed to retrieve grpc token: kubernetes client is not configured")
+	}
+	token, err := store.GetSecrets(g.kubeClient, common.DefaultSensorControllerNamespace, signal.Token.Name, signal.Token.Key)
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		return "", fmt.Errorf("grpc token of secret %s is empty", signal.Token.Name)
+	}
+	return token, nil
+}
+
+// Publish publishes the event of the request to its signal
+func (g *grpcSignal) Publish(ctx context.Context, req *publish.PublishRequest) (*publish.PublishResponse, error) {
+	if err := g.publish(ctx, req); err != nil {
+		return nil, err
+	}
+	return &publish.PublishResponse{Accepted: 1}, nil
+}
+
+// PublishStream publishes the events of the requests of the stream to their signals
+// the stream fails on the first event which is not accepted.
+func (g *grpcSignal) PublishStream(stream publish.PublishService_PublishStreamServer) error {
+	var accepted int32
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&publish.PublishResponse{Accepted: accepted})
+		}
+		if err != nil {
+			return err
+		}
+		if err := g.publish(stream.Context(), req); err != nil {
+			return err
+		}
+		accepted++
+	}
+}
+
+// publish authenticates the caller and sends the event to the signal of the request
+func (g *grpcSignal) publish(ctx context.Context, req *publish.PublishRequest) error {
+	value, ok := g.routes.Load(req.Signal)
+	if !ok {
+		return status.Errorf(codes.NotFound, "signal '%s' is not listening", req.Signal)
+	}
+	r := value.(*route)
+	if err := r.authenticate(ctx); err != nil {
+		return err
+	}
+	event := req.Event
+	if event == nil {
+		return status.Errorf(codes.InvalidArgument, "event must be specified")
+	}
+	if event.Context.EventType == "" || event.Context.EventID == "" {
+		return status.Errorf(codes.InvalidArgument, "event type and event id must be specified")
+	}
+	if event.Context.CloudEventsVersion == "" {
+		event.Context.CloudEventsVersion = sdk.CloudEventsVersion
+	}
+	if event.Context.EventTime.IsZero() {
+		event.Context.EventTime = metav1.Time{Time: time.Now().UTC()}
+	}
+	if !r.stream.Send(event, ctx.Done()) {
+		if err := ctx.Err(); err != nil {
+			return status.Errorf(codes.Canceled, "%s", err)
+		}
+		return status.Errorf(codes.Unavailable, "signal '%s' stopped listening", req.Signal)
+	}
+	return nil
+}
+
+// authenticate checks the token and the client certificate of the caller
+// callers are only anonymous if the signal explicitly allows it.
+func (r *route) authenticate(ctx context.Context) error {
+	if r.token == "" && len(r.signal.GRPC.ClientCommonNames) == 0 && !r.signal.GRPC.AllowAnonymous {
+		return status.Errorf(codes.PermissionDenied, "signal '%s' does not allow anonymous callers", r.signal.Name)
+	}
+	if r.token != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md[publish.AuthorizationMetadataKey]
+		if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+r.token)) != 1 {
+			return status.Errorf(codes.Unauthenticated, "invalid token for signal '%s'", r.signal.Name)
+		}
+	}
+	if names := r.signal.GRPC.ClientCommonNames; len(names) > 0 {
+		cn, ok := clientCommonName(ctx)
+		if !ok {
+			return status.Errorf(codes.Unauthenticated, "a verified client certificate is required for signal '%s'", r.signal.Name)
+		}
+		for _, name := range names {
+			if name == cn {
+				return nil
+			}
+		}
+		return status.Errorf(codes.PermissionDenied, "client '%s' is not allowed to publish to signal '%s'", cn, r.signal.Name)
+	}
+	return nil
+}
+
+// clientCommonName returns the common name of the verified client certificate of the caller
+func clientCommonName(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
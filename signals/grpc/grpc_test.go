@@ -0,0 +1,210 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk/publish"
+	"golang.org/x/net/context"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// serve serves the publish API of the grpc signal on a random local port
+func serve(t *testing.T, g *grpcSignal) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpclib.NewServer()
+	publish.RegisterPublishServiceServer(srv, g)
+	go srv.Serve(lis)
+	return lis.Addr().String(), srv.Stop
+}
+
+func newEvent(id string) *v1alpha1.Event {
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{EventType: "com.example.order.created", EventID: id},
+		Data:    []byte(`{"order":1}`),
+	}
+}
+
+func TestPublish(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "producers", Namespace: common.DefaultSensorControllerNamespace},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	})
+	g := &grpcSignal{kubeClient: kubeClient}
+	addr, stop := serve(t, g)
+	defer stop()
+
+	done := make(chan struct{})
+	signal := &v1alpha1.Signal{
+		Name: "orders",
+		GRPC: &v1alpha1.GRPCSignal{Token: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "producers"}, Key: "token"}},
+	}
+	events, err := g.Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Listen(signal, done); err == nil {
+		t.Error("expected an error for the signal which is already listening")
+	}
+
+	client, err := publish.NewClient(addr, publish.WithToken("s3cr3t"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- client.Publish(ctx, "orders", newEvent("1"))
+	}()
+	select {
+	case event := <-events:
+		if event.Context.EventID != "1" || event.Context.EventTime.IsZero() || event.Context.CloudEventsVersion == "" {
+			t.Errorf("unexpected event context %+v", event.Context)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("expected the event to be accepted but found %s", err)
+	}
+
+	// the events of a stream are routed by signal name
+	go func() {
+		stream, err := client.NewStream(ctx)
+		if err != nil {
+			errs <- err
+			return
+		}
+		for _, id := range []string{"2", "3"} {
+			if err := stream.Publish("orders", newEvent(id)); err != nil {
+				errs <- err
+				return
+			}
+		}
+		accepted, err := stream.Close()
+		if err == nil && accepted != 2 {
+			t.Errorf("expected 2 accepted events but found %d", accepted)
+		}
+		errs <- err
+	}()
+	for _, id := range []string{"2", "3"} {
+		select {
+		case event := <-events:
+			if event.Context.EventID != id {
+				t.Errorf("expected event %s but found %s", id, event.Context.EventID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the event")
+		}
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("expected the stream to be accepted but found %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		client *publish.Client
+		signal string
+		event  *v1alpha1.Event
+		code   codes.Code
+	}{
+		{name: "unknown signal", client: client, signal: "payments", event: newEvent("4"), code: codes.NotFound},
+		{name: "missing event id", client: client, signal: "orders", event: newEvent(""), code: codes.InvalidArgument},
+		{name: "invalid token", signal: "orders", event: newEvent("5"), code: codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		c := test.client
+		if c == nil {
+			c, err = publish.NewClient(addr, publish.WithToken("guess"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+		}
+		err := c.Publish(ctx, test.signal, test.event)
+		if s, _ := status.FromError(err); s.Code() != test.code {
+			t.Errorf("%s: expected code %s but found %s", test.name, test.code, err)
+		}
+	}
+
+	// the route is removed once the signal stops
+	close(done)
+	if _, ok := <-events; ok {
+		t.Error("expected the events to be closed")
+	}
+	err = client.Publish(ctx, "orders", newEvent("6"))
+	if s, _ := status.FromError(err); s.Code() != codes.NotFound {
+		t.Errorf("expected code %s for a stopped signal but found %s", codes.NotFound, err)
+	}
+}
+
+func TestAuthenticateClientCommonNames(t *testing.T) {
+	r := &route{signal: &v1alpha1.Signal{Name: "orders", GRPC: &v1alpha1.GRPCSignal{ClientCommonNames: []string{"shop"}}}}
+	withClient := func(cn string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+		})
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{name: "allowed client", ctx: withClient("shop"), code: codes.OK},
+		{name: "other client", ctx: withClient("billing"), code: codes.PermissionDenied},
+		{name: "no client certificate", ctx: context.Background(), code: codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		err := r.authenticate(test.ctx)
+		if s, _ := status.FromError(err); s.Code() != test.code {
+			t.Errorf("%s: expected code %s but found %v", test.name, test.code, err)
+		}
+	}
+}
+
+func TestAuthenticateAnonymous(t *testing.T) {
+	r := &route{signal: &v1alpha1.Signal{Name: "orders", GRPC: &v1alpha1.GRPCSignal{}}}
+	if s, _ := status.FromError(r.authenticate(context.Background())); s.Code() != codes.PermissionDenied {
+		t.Errorf("expected code %s for an anonymous caller but found %s", codes.PermissionDenied, s.Code())
+	}
+	r.signal.GRPC.AllowAnonymous = true
+	if err := r.authenticate(context.Background()); err != nil {
+		t.Errorf("expected an anonymous caller to be allowed but found %s", err)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/signals/grpc"
+	"github.com/micro/go-micro"
+	k8s "github.com/micro/kubernetes/go/micro"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EnvVarGRPCPort is the Env Var Key for the port of the publish API
+	EnvVarGRPCPort string = "GRPC_PORT"
+
+	// DefaultGRPCPort is the default port to use if the EnvVarGRPCPort is not set
+	DefaultGRPCPort int = 7073
+
+	// EnvVarTLSCert and EnvVarTLSKey are the Env Var Keys for the files of the certificate and the key of the server
+	// the publish API is served over TLS if they are set.
+	EnvVarTLSCert string = "GRPC_TLS_CERT"
+	EnvVarTLSKey  string = "GRPC_TLS_KEY"
+
+	// EnvVarTLSClientCA is the Env Var Key for the file of the CA of the client certificates, which enables mutual TLS
+	EnvVarTLSClientCA string = "GRPC_TLS_CLIENT_CA"
+)
+
+func main() {
+	svc := k8s.NewService(micro.Name("grpc"), micro.Metadata(sdk.SignalMetadata))
+	svc.Init()
+
+	// kubernetes configuration
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	rest, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		panic(err)
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(rest)
+
+	// get the container port from container
+	port := DefaultGRPCPort
+	if strPort, ok := os.LookupEnv(EnvVarGRPCPort); ok {
+		port, _ = strconv.Atoi(strPort)
+	}
+
+	var creds credentials.TransportCredentials
+	if certFile, ok := os.LookupEnv(EnvVarTLSCert); ok {
+		config, err := common.NewServerTLSConfig(certFile, os.Getenv(EnvVarTLSKey), os.Getenv(EnvVarTLSClientCA))
+		if err != nil {
+			panic(err)
+		}
+		creds = credentials.NewTLS(config)
+	}
+
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(grpc.New(kubeClient, port, creds)))
+
+	if err := svc.Run(); err != nil {
+		panic(err)
+	}
+}
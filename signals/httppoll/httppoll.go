@@ -0,0 +1,337 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httppoll
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EventType is the event type of the events of polled HTTP endpoints
+	EventType = "com.github.argoproj.httppoll"
+
+	// ContextExtensionStatusCodeKey is the event context extension key of the status code of the response
+	ContextExtensionStatusCodeKey = "statusCode"
+
+	// DefaultInterval is the default duration between polls of the endpoint
+	DefaultInterval = time.Minute
+
+	// DefaultTimeout is the default timeout of the requests
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultMaxBackoff is the default maximum duration between polls after failed requests
+	DefaultMaxBackoff = 5 * time.Minute
+
+	// the jitter factor of the durations between polls after failed requests
+	backoffJitter = 0.1
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// Listen() methods CAN retrieve the kubeClient from the httpPoll struct.
+type httpPoll struct {
+	kubeClient kubernetes.Interface
+}
+
+// New creates a new HTTP polling signal
+// the kubeClient is used to retrieve the credentials of the requests and can be nil
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &httpPoll{kubeClient: kubeClient}
+}
+
+func (h *httpPoll) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	creds, err := h.resolveCredentials(signal.HTTPPoll)
+	if err != nil {
+		return nil, err
+	}
+	p, err := newPoller(signal.HTTPPoll, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		for {
+			event, err := p.poll()
+			if err != nil {
+				log.Warnf("failed to poll %s: %s", signal.HTTPPoll.URL, err)
+			}
+			if event != nil {
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+			}
+			timer := time.NewTimer(p.delay(err))
+			select {
+			case <-timer.C:
+			case <-done:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+	log.Printf("signal '%s' polling [%s %s] every %s...", signal.Name, p.method, signal.HTTPPoll.URL, p.interval)
+	return events, nil
+}
+
+// credentials are the credentials of the requests resolved from the secrets of the signal
+type credentials struct {
+	username    string
+	password    string
+	bearerToken string
+}
+
+// resolveCredentials resolves the credentials of the requests from the secrets of the signal
+func (h *httpPoll) resolveCredentials(signal *v1alpha1.HTTPPollSignal) (*credentials, error) {
+	if signal.BasicAuth == nil && signal.BearerToken == nil {
+		return nil, nil
+	}
+	if h.kubeClient == nil {
+		return nil, fmt.Errorf("failed to retrieve http credentials: kubernetes client is not configured")
+	}
+	if signal.BearerToken != nil {
+		token, err := store.GetSecrets(h.kubeClient, common.DefaultSensorControllerNamespace, signal.BearerToken.Name, signal.BearerToken.Key)
+		if err != nil {
+			return nil, err
+		}
+		return &credentials{bearerToken: token}, nil
+	}
+	creds := &credentials{}
+	if signal.BasicAuth.Username != nil {
+		username, err := store.GetSecrets(h.kubeClient, common.DefaultSensorControllerNamespace, signal.BasicAuth.Username.Name, signal.BasicAuth.Username.Key)
+		if err != nil {
+			return nil, err
+		}
+		creds.username = username
+	}
+	if signal.BasicAuth.Password != nil {
+		password, err := store.GetSecrets(h.kubeClient, common.DefaultSensorControllerNamespace, signal.BasicAuth.Password.Name, signal.BasicAuth.Password.Key)
+		if err != nil {
+			return nil, err
+		}
+		creds.password = password
+	}
+	return creds, nil
+}
+
+// poller requests the endpoint and detects the changes of its response between polls
+type poller struct {
+	signal     *v1alpha1.HTTPPollSignal
+	creds      *credentials
+	client     *http.Client
+	method     string
+	interval   time.Duration
+	maxBackoff time.Duration
+	source     *v1alpha1.URI
+
+	// backoff is the duration between polls after the latest failed request, zero if the latest request succeeded
+	backoff time.Duration
+	// polled is set once the endpoint responded for the first time
+	polled bool
+	// digest is the SHA-256 digest of the body of the latest response
+	digest string
+	// etag and lastModified are the validators of the latest response used in conditional requests
+	etag         string
+	lastModified string
+	// satisfied is true if the conditions were satisfied by the latest response
+	satisfied bool
+}
+
+func newPoller(signal *v1alpha1.HTTPPollSignal, creds *credentials) (*poller, error) {
+	u, err := url.Parse(signal.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url %s. Cause: %+v", signal.URL, err.Error())
+	}
+	interval, err := parseDuration(signal.Interval, DefaultInterval)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := parseDuration(signal.Timeout, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	maxBackoff, err := parseDuration(signal.MaxBackoff, DefaultMaxBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if maxBackoff < interval {
+		maxBackoff = interval
+	}
+	method := http.MethodGet
+	if signal.Method != "" {
+		method = strings.ToUpper(signal.Method)
+	}
+	source := &v1alpha1.URI{
+		Scheme: u.Scheme,
+		Host:   u.Hostname(),
+		Path:   u.Path,
+		Query:  u.RawQuery,
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		source.Port = int32(port)
+	}
+	return &poller{
+		signal:     signal,
+		creds:      creds,
+		client:     &http.Client{Timeout: timeout},
+		method:     method,
+		interval:   interval,
+		maxBackoff: maxBackoff,
+		source:     source,
+	}, nil
+}
+
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration %s. Cause: %+v", value, err.Error())
+	}
+	return d, nil
+}
+
+// poll requests the endpoint and returns the event of the response if it changed since the last poll
+// the first response is not emitted unless it satisfies the conditions of the signal.
+func (p *poller) poll() (*v1alpha1.Event, error) {
+	req, err := http.NewRequest(p.method, p.signal.URL, strings.NewReader(p.signal.Body))
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range p.signal.Headers {
+		req.Header.Set(name, value)
+	}
+	if p.creds != nil {
+		if p.creds.bearerToken != "" {
+			req.Header.Set("Authorization", "Bearer "+p.creds.bearerToken)
+		} else {
+			req.SetBasicAuth(p.creds.username, p.creds.password)
+		}
+	}
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+	if p.lastModified != "" {
+		req.Header.Set("If-Modified-Since", p.lastModified)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body. Cause: %+v", err.Error())
+	}
+	sum := sha256.Sum256(body)
+	digest := hex.EncodeToString(sum[:])
+
+	var emit bool
+	if len(p.signal.Conditions) > 0 {
+		satisfied, err := satisfies(p.signal.Conditions, body)
+		if err != nil {
+			return nil, err
+		}
+		emit = satisfied && !p.satisfied
+		p.satisfied = satisfied
+	} else {
+		emit = p.polled && digest != p.digest
+	}
+	p.polled = true
+	p.digest = digest
+	p.etag = resp.Header.Get("ETag")
+	p.lastModified = resp.Header.Get("Last-Modified")
+	if !emit {
+		return nil, nil
+	}
+	return p.newEvent(resp, body, digest), nil
+}
+
+// delay returns the duration until the next poll
+// after failed requests the duration doubles, starting from the interval, up to the maximum backoff.
+func (p *poller) delay(err error) time.Duration {
+	if err == nil {
+		p.backoff = 0
+		return p.interval
+	}
+	if p.backoff == 0 {
+		p.backoff = p.interval
+	}
+	p.backoff *= 2
+	if p.backoff > p.maxBackoff {
+		p.backoff = p.maxBackoff
+	}
+	return wait.Jitter(p.backoff, backoffJitter)
+}
+
+// satisfies checks if the JSON body satisfies all the conditions
+// the conditions are evaluated like the data filters of signals.
+func satisfies(conditions []*v1alpha1.DataFilter, body []byte) (bool, error) {
+	if !gjson.ValidBytes(body) {
+		return false, fmt.Errorf("response body is not valid JSON")
+	}
+	return common.FilterData(conditions, body)
+}
+
+func (p *poller) newEvent(resp *http.Response, body []byte, digest string) *v1alpha1.Event {
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" && gjson.ValidBytes(body) {
+		contentType = "application/json"
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            digest,
+			EventTime:          metav1.Time{Time: time.Now().UTC()},
+			Source:             p.source,
+			ContentType:        contentType,
+			Extensions: map[string]string{
+				ContextExtensionStatusCodeKey: strconv.Itoa(resp.StatusCode),
+			},
+		},
+		Data: body,
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httppoll
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeEndpoint is an endpoint whose response body can be changed between polls
+type fakeEndpoint struct {
+	mu     sync.Mutex
+	body   string
+	status int
+	etag   string
+	// requests are the received requests
+	requests []*http.Request
+	bodies   []string
+}
+
+func (e *fakeEndpoint) set(status int, body string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.status = status
+	e.body = body
+}
+
+func (e *fakeEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b, _ := ioutil.ReadAll(r.Body)
+	e.requests = append(e.requests, r)
+	e.bodies = append(e.bodies, string(b))
+	if e.etag != "" && r.Header.Get("If-None-Match") == e.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if e.etag != "" {
+		w.Header().Set("ETag", e.etag)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	w.Write([]byte(e.body))
+}
+
+func TestPollerChanges(t *testing.T) {
+	endpoint := &fakeEndpoint{status: http.StatusOK, body: `{"status":"running"}`}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	signal := &v1alpha1.HTTPPollSignal{
+		URL:     server.URL + "/status",
+		Method:  "post",
+		Headers: map[string]string{"X-Request": "argo"},
+		Body:    `{"job":"etl"}`,
+	}
+	p, err := newPoller(signal, &credentials{username: "user", password: "pass"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first response is not emitted
+	event, err := p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Errorf("expected no event for the first response but found %v", event)
+	}
+	req := endpoint.requests[0]
+	if user, pass, ok := req.BasicAuth(); req.Method != http.MethodPost || !ok || user != "user" || pass != "pass" ||
+		req.Header.Get("X-Request") != "argo" || endpoint.bodies[0] != signal.Body {
+		t.Errorf("unexpected request %s %v with body %s", req.Method, req.Header, endpoint.bodies[0])
+	}
+
+	// the same response is not emitted
+	event, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Errorf("expected no event for the same response but found %v", event)
+	}
+
+	// the changed response is emitted
+	endpoint.set(http.StatusOK, `{"status":"done"}`)
+	event, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil {
+		t.Fatal("expected an event for the changed response")
+	}
+	if string(event.Data) != `{"status":"done"}` || event.Context.EventType != EventType ||
+		event.Context.ContentType != "application/json" || event.Context.Extensions[ContextExtensionStatusCodeKey] != "200" ||
+		event.Context.Source.Path != "/status" {
+		t.Errorf("unexpected event %v with data %s", event.Context, event.Data)
+	}
+}
+
+func TestPollerConditions(t *testing.T) {
+	endpoint := &fakeEndpoint{status: http.StatusOK, body: `{"status":"running","progress":50}`, etag: `"v1"`}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	signal := &v1alpha1.HTTPPollSignal{
+		URL: server.URL,
+		Conditions: []*v1alpha1.DataFilter{
+			{Path: "status", Type: v1alpha1.JSONTypeString, Value: "done"},
+			{Path: "progress", Type: v1alpha1.JSONTypeNumber, Value: "100"},
+		},
+	}
+	p, err := newPoller(signal, &credentials{bearerToken: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Errorf("expected no event for the unsatisfied conditions but found %v", event)
+	}
+	if auth := endpoint.requests[0].Header.Get("Authorization"); auth != "Bearer token" {
+		t.Errorf("expected the bearer token to be sent but found %s", auth)
+	}
+
+	// the unchanged response is not modified
+	event, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil || endpoint.requests[1].Header.Get("If-None-Match") != `"v1"` {
+		t.Errorf("expected a conditional request without event but found %v", event)
+	}
+
+	// the event is emitted once when the conditions become satisfied
+	endpoint.etag = `"v2"`
+	endpoint.set(http.StatusOK, `{"status":"done","progress":100}`)
+	event, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil {
+		t.Fatal("expected an event for the satisfied conditions")
+	}
+	endpoint.etag = `"v3"`
+	endpoint.set(http.StatusOK, `{"status":"done","progress":100,"finishedAt":"now"}`)
+	event, err = p.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Errorf("expected no event for the conditions which were already satisfied but found %v", event)
+	}
+
+	// invalid JSON fails the poll
+	endpoint.etag = `"v4"`
+	endpoint.set(http.StatusOK, `done`)
+	if _, err = p.poll(); err == nil {
+		t.Error("expected an error for the invalid JSON response")
+	}
+}
+
+func TestPollerBackoff(t *testing.T) {
+	endpoint := &fakeEndpoint{status: http.StatusInternalServerError}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	p, err := newPoller(&v1alpha1.HTTPPollSignal{URL: server.URL, Interval: "10s", MaxBackoff: "30s"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.poll()
+	if err == nil {
+		t.Fatal("expected an error for the failed request")
+	}
+	tests := []struct {
+		err      error
+		expected time.Duration
+	}{
+		{err: err, expected: 20 * time.Second},
+		{err: err, expected: 30 * time.Second},
+		{err: err, expected: 30 * time.Second},
+		{err: nil, expected: 10 * time.Second},
+		{err: err, expected: 20 * time.Second},
+	}
+	for i, test := range tests {
+		delay := p.delay(test.err)
+		if delay < test.expected || delay > test.expected+time.Duration(float64(test.expected)*backoffJitter) {
+			t.Errorf("%d: expected a delay of %s but found %s", i, test.expected, delay)
+		}
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "endpoint", Namespace: common.DefaultSensorControllerNamespace},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+	})
+	h := &httpPoll{kubeClient: kubeClient}
+	creds, err := h.resolveCredentials(&v1alpha1.HTTPPollSignal{
+		BasicAuth: &v1alpha1.HTTPBasicAuth{
+			Username: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "endpoint"}, Key: "username"},
+			Password: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "endpoint"}, Key: "password"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.username != "user" || creds.password != "pass" {
+		t.Errorf("unexpected credentials %+v", creds)
+	}
+
+	_, err = h.resolveCredentials(&v1alpha1.HTTPPollSignal{
+		BearerToken: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "missing"}, Key: "token"},
+	})
+	if err == nil {
+		t.Error("expected an error for the missing secret")
+	}
+}
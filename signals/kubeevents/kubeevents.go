@@ -0,0 +1,321 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// EventType is the event type of the events of kubernetes events
+	EventType = "io.k8s.core.event"
+
+	// ContextExtensionReasonKey is the event context extension key of the reason of the kubernetes event
+	ContextExtensionReasonKey = "reason"
+
+	// ContextExtensionTypeKey is the event context extension key of the type of the kubernetes event, i.e. Normal or Warning
+	ContextExtensionTypeKey = "type"
+
+	// ContextExtensionNamespaceKey is the event context extension key of the namespace of the kubernetes event
+	ContextExtensionNamespaceKey = "namespace"
+
+	// ContextExtensionInvolvedObjectKindKey and ContextExtensionInvolvedObjectNameKey are the event context extension
+	// keys of the kind and the name of the object the kubernetes event is about
+	ContextExtensionInvolvedObjectKindKey = "involvedObjectKind"
+	ContextExtensionInvolvedObjectNameKey = "involvedObjectName"
+
+	// ContextExtensionOccurrencesKey is the event context extension key of the number of occurrences of the kubernetes
+	// event since its previous emitted event
+	ContextExtensionOccurrencesKey = "occurrences"
+
+	// DefaultCollapseInterval is the default minimum duration between the emitted events of a repeated kubernetes event
+	DefaultCollapseInterval = 10 * time.Minute
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// Listen() methods CAN retrieve the kubeClient from the kubeEvents struct.
+type kubeEvents struct {
+	kubeClient kubernetes.Interface
+}
+
+// New creates a new kubernetes events signal
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &kubeEvents{kubeClient: kubeClient}
+}
+
+// occurrence is an added, updated or deleted kubernetes event
+type occurrence struct {
+	event   *corev1.Event
+	deleted bool
+}
+
+func (k *kubeEvents) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	interval := DefaultCollapseInterval
+	if signal.KubeEvents.CollapseInterval != "" {
+		var err error
+		interval, err = time.ParseDuration(signal.KubeEvents.CollapseInterval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse collapse interval %s. Cause: %+v", signal.KubeEvents.CollapseInterval, err.Error())
+		}
+	}
+	c := newCollapser(interval, time.Now())
+
+	// the informer handlers only pass on the occurrences, which are collapsed by a single goroutine
+	occurrences := make(chan occurrence)
+	pass := func(obj interface{}, deleted bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		event, ok := obj.(*corev1.Event)
+		if !ok || !matches(signal.KubeEvents, event) {
+			return
+		}
+		select {
+		case occurrences <- occurrence{event: event, deleted: deleted}:
+		case <-done:
+		}
+	}
+	_, informer := cache.NewInformer(
+		k.newListWatch(signal.KubeEvents),
+		&corev1.Event{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { pass(obj, false) },
+			UpdateFunc: func(_, obj interface{}) { pass(obj, false) },
+			DeleteFunc: func(obj interface{}) { pass(obj, true) },
+		},
+	)
+	go informer.Run(done)
+
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		for {
+			select {
+			case o := <-occurrences:
+				n, emit := c.collapse(o.event, o.deleted, time.Now())
+				if !emit {
+					continue
+				}
+				event, err := newEvent(o.event, n)
+				if err != nil {
+					log.Warnf("failed to create event of kubernetes event %s/%s: %s", o.event.Namespace, o.event.Name, err)
+					continue
+				}
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	log.Printf("signal '%s' watching kubernetes events [%s]...", signal.Name, fieldSelector(signal.KubeEvents))
+	return events, nil
+}
+
+// newListWatch returns the list watch of the kubernetes events selected by the signal
+func (k *kubeEvents) newListWatch(signal *v1alpha1.KubeEventsSignal) *cache.ListWatch {
+	selector := fieldSelector(signal).String()
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return k.kubeClient.CoreV1().Events(signal.Namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return k.kubeClient.CoreV1().Events(signal.Namespace).Watch(options)
+		},
+	}
+}
+
+// fieldSelector returns the field selector of the filters which can be selected by the API server
+// the filters with multiple values and name patterns are only applied by the signal.
+func fieldSelector(signal *v1alpha1.KubeEventsSignal) fields.Selector {
+	set := fields.Set{}
+	if len(signal.Reasons) == 1 {
+		set["reason"] = signal.Reasons[0]
+	}
+	if len(signal.Types) == 1 {
+		set["type"] = signal.Types[0]
+	}
+	if len(signal.SourceComponents) == 1 {
+		set["source"] = signal.SourceComponents[0]
+	}
+	if obj := signal.InvolvedObject; obj != nil {
+		if obj.Kind != "" {
+			set["involvedObject.kind"] = obj.Kind
+		}
+		if obj.Namespace != "" {
+			set["involvedObject.namespace"] = obj.Namespace
+		}
+		if obj.Name != "" && !strings.ContainsAny(obj.Name, `*?[\`) {
+			set["involvedObject.name"] = obj.Name
+		}
+	}
+	return fields.SelectorFromSet(set)
+}
+
+// matches checks if the kubernetes event passes the filters of the signal
+func matches(signal *v1alpha1.KubeEventsSignal, event *corev1.Event) bool {
+	if len(signal.Reasons) > 0 && !contains(signal.Reasons, event.Reason) {
+		return false
+	}
+	if len(signal.Types) > 0 && !contains(signal.Types, event.Type) {
+		return false
+	}
+	if len(signal.SourceComponents) > 0 && !contains(signal.SourceComponents, event.Source.Component) {
+		return false
+	}
+	if obj := signal.InvolvedObject; obj != nil {
+		if obj.Kind != "" && obj.Kind != event.InvolvedObject.Kind {
+			return false
+		}
+		if obj.Namespace != "" && obj.Namespace != event.InvolvedObject.Namespace {
+			return false
+		}
+		if obj.Name != "" {
+			if ok, _ := path.Match(obj.Name, event.InvolvedObject.Name); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// collapser collapses the repeated occurrences of kubernetes events using their counts
+type collapser struct {
+	interval time.Duration
+	// started is the time the signal started, the kubernetes events which last occurred before are not emitted
+	started time.Time
+	// emitted are the counts and times of the latest emitted events of the kubernetes events by UID
+	emitted map[types.UID]*emission
+}
+
+type emission struct {
+	count int32
+	time  time.Time
+}
+
+func newCollapser(interval time.Duration, started time.Time) *collapser {
+	return &collapser{
+		interval: interval,
+		started:  started,
+		emitted:  make(map[types.UID]*emission),
+	}
+}
+
+// collapse returns the number of occurrences of the kubernetes event since its latest emitted event and whether an event is emitted
+// an event is emitted for the first occurrence of a kubernetes event and for its repeated occurrences once the interval
+// since the latest emitted event elapsed.
+func (c *collapser) collapse(event *corev1.Event, deleted bool, now time.Time) (int32, bool) {
+	if deleted {
+		delete(c.emitted, event.UID)
+		return 0, false
+	}
+	count := event.Count
+	if count < 1 {
+		count = 1
+	}
+	prev, ok := c.emitted[event.UID]
+	if !ok {
+		if lastTimestamp(event).Before(c.started) {
+			// the existing event is the baseline of its repeated occurrences
+			c.emitted[event.UID] = &emission{count: count}
+			return 0, false
+		}
+		c.emitted[event.UID] = &emission{count: count, time: now}
+		return count, true
+	}
+	if count <= prev.count || now.Sub(prev.time) < c.interval {
+		return 0, false
+	}
+	n := count - prev.count
+	prev.count = count
+	prev.time = now
+	return n, true
+}
+
+// lastTimestamp returns the time of the latest occurrence of the kubernetes event
+func lastTimestamp(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+func newEvent(event *corev1.Event, occurrences int32) (*v1alpha1.Event, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%d", event.UID, event.Count),
+			EventTime:          metav1.Time{Time: lastTimestamp(event).UTC()},
+			Source:             &v1alpha1.URI{Host: event.Source.Host, Path: event.Source.Component},
+			ContentType:        "application/json",
+			Extensions: map[string]string{
+				ContextExtensionReasonKey:             event.Reason,
+				ContextExtensionTypeKey:               event.Type,
+				ContextExtensionNamespaceKey:          event.Namespace,
+				ContextExtensionInvolvedObjectKindKey: event.InvolvedObject.Kind,
+				ContextExtensionInvolvedObjectNameKey: event.InvolvedObject.Name,
+				ContextExtensionOccurrencesKey:        strconv.Itoa(int(occurrences)),
+			},
+		},
+		Data: b,
+	}, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newKubeEvent(name, reason string, count int32, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "etl-1234",
+			Namespace: "default",
+		},
+		Reason:        reason,
+		Type:          corev1.EventTypeWarning,
+		Source:        corev1.EventSource{Component: "default-scheduler"},
+		Count:         count,
+		LastTimestamp: metav1.Time{Time: last},
+	}
+}
+
+func TestMatches(t *testing.T) {
+	event := newKubeEvent("etl", "FailedScheduling", 1, time.Now())
+	tests := []struct {
+		name    string
+		signal  v1alpha1.KubeEventsSignal
+		matches bool
+	}{
+		{name: "no filters", matches: true},
+		{name: "reason", signal: v1alpha1.KubeEventsSignal{Reasons: []string{"BackOff", "FailedScheduling"}}, matches: true},
+		{name: "other reason", signal: v1alpha1.KubeEventsSignal{Reasons: []string{"BackOff"}}, matches: false},
+		{name: "type", signal: v1alpha1.KubeEventsSignal{Types: []string{corev1.EventTypeNormal}}, matches: false},
+		{name: "source component", signal: v1alpha1.KubeEventsSignal{SourceComponents: []string{"default-scheduler"}}, matches: true},
+		{
+			name:    "involved object",
+			signal:  v1alpha1.KubeEventsSignal{InvolvedObject: &v1alpha1.KubeEventsInvolvedObject{Kind: "Pod", Name: "etl-*", Namespace: "default"}},
+			matches: true,
+		},
+		{
+			name:    "other involved object",
+			signal:  v1alpha1.KubeEventsSignal{InvolvedObject: &v1alpha1.KubeEventsInvolvedObject{Kind: "Pod", Name: "web-*"}},
+			matches: false,
+		},
+	}
+	for _, test := range tests {
+		if matches(&test.signal, event) != test.matches {
+			t.Errorf("%s: expected the event to match: %t", test.name, test.matches)
+		}
+	}
+}
+
+func TestFieldSelector(t *testing.T) {
+	signal := &v1alpha1.KubeEventsSignal{
+		Reasons:        []string{"BackOff"},
+		Types:          []string{corev1.EventTypeWarning, corev1.EventTypeNormal},
+		InvolvedObject: &v1alpha1.KubeEventsInvolvedObject{Kind: "Pod", Name: "etl-*"},
+	}
+	// the types with multiple values and the name pattern are not selected by the API server
+	expected := fields.Set{"involvedObject.kind": "Pod", "reason": "BackOff"}.AsSelector()
+	selector := fieldSelector(signal)
+	if len(selector.Requirements()) != len(expected.Requirements()) || !selector.Matches(fields.Set{"involvedObject.kind": "Pod", "reason": "BackOff", "type": "Normal"}) {
+		t.Errorf("expected field selector %s but found %s", expected, selector)
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	started := time.Now()
+	c := newCollapser(time.Minute, started)
+	existing := newKubeEvent("existing", "BackOff", 5, started.Add(-time.Hour))
+	event := newKubeEvent("new", "BackOff", 1, started.Add(time.Second))
+
+	tests := []struct {
+		name        string
+		event       *corev1.Event
+		count       int32
+		deleted     bool
+		after       time.Duration
+		occurrences int32
+		emit        bool
+	}{
+		{name: "existing event", event: existing, count: 5, after: 0, emit: false},
+		{name: "repeated existing event", event: existing, count: 6, after: time.Second, occurrences: 1, emit: true},
+		{name: "new event", event: event, count: 1, after: time.Second, occurrences: 1, emit: true},
+		{name: "unchanged event", event: event, count: 1, after: 2 * time.Second, emit: false},
+		{name: "repeated within interval", event: event, count: 2, after: 30 * time.Second, emit: false},
+		{name: "repeated within interval again", event: event, count: 4, after: 50 * time.Second, emit: false},
+		{name: "repeated after interval", event: event, count: 5, after: 2 * time.Minute, occurrences: 4, emit: true},
+		{name: "deleted event", event: event, count: 5, deleted: true, after: 3 * time.Minute, emit: false},
+	}
+	for _, test := range tests {
+		e := test.event.DeepCopy()
+		e.Count = test.count
+		occurrences, emit := c.collapse(e, test.deleted, started.Add(test.after))
+		if emit != test.emit || occurrences != test.occurrences {
+			t.Errorf("%s: expected (%d, %t) but found (%d, %t)", test.name, test.occurrences, test.emit, occurrences, emit)
+		}
+	}
+	if _, ok := c.emitted[event.UID]; ok {
+		t.Error("expected the deleted event to be forgotten")
+	}
+}
+
+func TestListen(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	done := make(chan struct{})
+	defer close(done)
+	signal := &v1alpha1.Signal{
+		Name:       "scheduling",
+		KubeEvents: &v1alpha1.KubeEventsSignal{Reasons: []string{"FailedScheduling"}},
+	}
+	events, err := New(kubeClient).Listen(signal, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range []*corev1.Event{
+		newKubeEvent("backoff", "BackOff", 1, time.Now().Add(time.Second)),
+		newKubeEvent("scheduling", "FailedScheduling", 1, time.Now().Add(time.Second)),
+	} {
+		if _, err := kubeClient.CoreV1().Events("default").Create(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case event := <-events:
+		if event.Context.EventType != EventType || event.Context.Extensions[ContextExtensionReasonKey] != "FailedScheduling" ||
+			event.Context.Extensions[ContextExtensionInvolvedObjectNameKey] != "etl-1234" ||
+			event.Context.Extensions[ContextExtensionOccurrencesKey] != "1" {
+			t.Errorf("unexpected event context %+v", event.Context)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/jackc/pgx"
+)
+
+// the kinds of row changes
+const (
+	kindInsert = "insert"
+	kindUpdate = "update"
+	kindDelete = "delete"
+)
+
+// change is an inserted, updated or deleted row of a replicated table
+// the before and after images of the row map the names of the columns to their values.
+type change struct {
+	Kind   string                 `json:"kind"`
+	Schema string                 `json:"schema"`
+	Table  string                 `json:"table"`
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+}
+
+// transaction is a committed transaction decoded from the replication slot
+type transaction struct {
+	xid uint32
+	// lsn is the position of the slot which can be acknowledged once the changes of the transaction are processed
+	lsn        uint64
+	commitTime time.Time
+	changes    []change
+}
+
+// decoder decodes the messages of a logical decoding output plugin into transactions
+type decoder interface {
+	// pluginArgs returns the options of the output plugin to start the replication with
+	pluginArgs() []string
+
+	// decode decodes the message and returns the transaction once all its changes are decoded
+	decode(msg *pgx.WalMessage) (*transaction, error)
+}
+
+// newDecoder returns a new decoder of the output plugin of the replication
+// the pgoutput decoder is stateful so a new decoder is needed for every replication connection.
+func newDecoder(r *v1alpha1.PostgresReplication) decoder {
+	tables := newTableSet(r.Tables)
+	if r.Plugin == v1alpha1.PostgresReplicationPluginPGOutput {
+		return &pgoutputDecoder{publication: r.Publication, tables: tables, relations: make(map[uint32]*relation)}
+	}
+	return &wal2jsonDecoder{tables: tables}
+}
+
+// tableSet is a set of qualified table names, an empty set contains all the tables
+type tableSet map[string]bool
+
+func newTableSet(tables []string) tableSet {
+	set := make(tableSet)
+	for _, table := range tables {
+		if !strings.Contains(table, ".") {
+			table = "public." + table
+		}
+		set[table] = true
+	}
+	return set
+}
+
+func (s tableSet) contains(schema, table string) bool {
+	return len(s) == 0 || s[schema+"."+table]
+}
+
+// names returns the sorted qualified names of the tables
+func (s tableSet) names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// quoteOption quotes the value of an option of an output plugin
+func quoteOption(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+// wal2jsonDecoder decodes the messages of the wal2json output plugin in its default format,
+// where every message is a transaction with all its changes.
+// See https://github.com/eulerto/wal2json
+type wal2jsonDecoder struct {
+	tables tableSet
+}
+
+type wal2jsonTransaction struct {
+	Xid       uint32           `json:"xid"`
+	Timestamp string           `json:"timestamp"`
+	Change    []wal2jsonChange `json:"change"`
+}
+
+type wal2jsonChange struct {
+	Kind         string        `json:"kind"`
+	Schema       string        `json:"schema"`
+	Table        string        `json:"table"`
+	ColumnNames  []string      `json:"columnnames"`
+	ColumnValues []interface{} `json:"columnvalues"`
+	OldKeys      *struct {
+		KeyNames  []string      `json:"keynames"`
+		KeyValues []interface{} `json:"keyvalues"`
+	} `json:"oldkeys"`
+}
+
+func (d *wal2jsonDecoder) pluginArgs() []string {
+	args := []string{`"include-xids" 'on'`, `"include-timestamp" 'on'`}
+	if len(d.tables) > 0 {
+		args = append(args, `"add-tables" `+quoteOption(strings.Join(d.tables.names(), ",")))
+	}
+	return args
+}
+
+func (d *wal2jsonDecoder) decode(msg *pgx.WalMessage) (*transaction, error) {
+	var tx wal2jsonTransaction
+	dec := json.NewDecoder(bytes.NewReader(msg.WalData))
+	// the numbers are kept as they are, e.g. for bigint or numeric columns
+	dec.UseNumber()
+	if err := dec.Decode(&tx); err != nil {
+		return nil, fmt.Errorf("failed to decode wal2json message. Cause: %+v", err)
+	}
+	commitTime, err := parseTimestamp(tx.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	result := &transaction{xid: tx.Xid, lsn: msg.WalStart, commitTime: commitTime}
+	for _, c := range tx.Change {
+		if !d.tables.contains(c.Schema, c.Table) {
+			continue
+		}
+		ch := change{Kind: c.Kind, Schema: c.Schema, Table: c.Table}
+		switch c.Kind {
+		case kindInsert, kindUpdate, kindDelete:
+		default:
+			// e.g. the messages emitted with pg_logical_emit_message
+			continue
+		}
+		if c.Kind != kindDelete {
+			ch.After = zip(c.ColumnNames, c.ColumnValues)
+		}
+		if c.OldKeys != nil {
+			ch.Before = zip(c.OldKeys.KeyNames, c.OldKeys.KeyValues)
+		}
+		result.changes = append(result.changes, ch)
+	}
+	return result, nil
+}
+
+func zip(names []string, values []interface{}) map[string]interface{} {
+	row := make(map[string]interface{}, len(names))
+	for i, name := range names {
+		if i < len(values) {
+			row[name] = values[i]
+		}
+	}
+	return row
+}
+
+// parseTimestamp parses a timestamp with time zone in the output format of postgres
+func parseTimestamp(ts string) (time.Time, error) {
+	if ts == "" {
+		return time.Now().UTC(), nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05.999999999-07", "2006-01-02 15:04:05.999999999-07:00"} {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse timestamp %s", ts)
+}
+
+// the messages of the pgoutput logical replication protocol
+// See https://www.postgresql.org/docs/current/static/protocol-logicalrep-message-formats.html
+const (
+	pgoutputBegin    = 'B'
+	pgoutputCommit   = 'C'
+	pgoutputRelation = 'R'
+	pgoutputInsert   = 'I'
+	pgoutputUpdate   = 'U'
+	pgoutputDelete   = 'D'
+)
+
+// the oids of the types of which the text values are valid JSON values
+const (
+	oidBool    = 16
+	oidInt8    = 20
+	oidInt2    = 21
+	oidInt4    = 23
+	oidOid     = 26
+	oidJSON    = 114
+	oidFloat4  = 700
+	oidFloat8  = 701
+	oidNumeric = 1700
+	oidJSONB   = 3802
+)
+
+// the epoch of the timestamps of the pgoutput protocol
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// pgoutputDecoder decodes the messages of the pgoutput output plugin used by the built-in logical replication
+// the relations are sent before the first change of their rows in each replication connection.
+type pgoutputDecoder struct {
+	publication string
+	tables      tableSet
+	relations   map[uint32]*relation
+	current     *transaction
+}
+
+// relation describes a table of the changes of the pgoutput protocol
+type relation struct {
+	schema  string
+	table   string
+	columns []column
+}
+
+type column struct {
+	name string
+	oid  uint32
+}
+
+func (d *pgoutputDecoder) pluginArgs() []string {
+	return []string{`proto_version '1'`, `publication_names ` + quoteOption(d.publication)}
+}
+
+func (d *pgoutputDecoder) decode(msg *pgx.WalMessage) (*transaction, error) {
+	r := &reader{data: msg.WalData}
+	switch r.byte() {
+	case pgoutputBegin:
+		r.uint64() // the lsn of the commit
+		commitTime := r.timestamp()
+		d.current = &transaction{xid: r.uint32(), commitTime: commitTime}
+	case pgoutputCommit:
+		r.byte()   // flags
+		r.uint64() // the lsn of the commit
+		end := r.uint64()
+		if r.err != nil || d.current == nil {
+			break
+		}
+		tx := d.current
+		tx.lsn = end
+		d.current = nil
+		return tx, nil
+	case pgoutputRelation:
+		id := r.uint32()
+		rel := &relation{schema: r.string(), table: r.string()}
+		r.byte() // replica identity
+		n := int(r.uint16())
+		for i := 0; i < n && r.err == nil; i++ {
+			r.byte() // flags
+			col := column{name: r.string(), oid: r.uint32()}
+			r.uint32() // type modifier
+			rel.columns = append(rel.columns, col)
+		}
+		if r.err == nil {
+			d.relations[id] = rel
+		}
+	case pgoutputInsert, pgoutputUpdate, pgoutputDelete:
+		return nil, d.decodeChange(msg.WalData[0], r)
+	}
+	// the other messages, e.g. origin and type messages, are not needed
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode pgoutput message '%c'. Cause: %+v", msg.WalData[0], r.err)
+	}
+	return nil, nil
+}
+
+// decodeChange decodes an insert, update or delete message and adds the change to the current transaction
+func (d *pgoutputDecoder) decodeChange(typ byte, r *reader) error {
+	id := r.uint32()
+	rel, ok := d.relations[id]
+	if !ok {
+		return fmt.Errorf("failed to decode pgoutput message '%c': unknown relation %d", typ, id)
+	}
+	if d.current == nil {
+		return fmt.Errorf("failed to decode pgoutput message '%c': no transaction was started", typ)
+	}
+	ch := change{Schema: rel.schema, Table: rel.table}
+	switch typ {
+	case pgoutputInsert:
+		ch.Kind = kindInsert
+		r.byte() // new tuple
+		ch.After = r.tuple(rel)
+	case pgoutputUpdate:
+		ch.Kind = kindUpdate
+		if kind := r.byte(); kind == 'K' || kind == 'O' {
+			// the key or the old tuple, depending on the replica identity of the table
+			ch.Before = r.tuple(rel)
+			r.byte() // new tuple
+		}
+		ch.After = r.tuple(rel)
+	case pgoutputDelete:
+		ch.Kind = kindDelete
+		r.byte() // key or old tuple
+		ch.Before = r.tuple(rel)
+	}
+	if r.err != nil {
+		return fmt.Errorf("failed to decode pgoutput message '%c'. Cause: %+v", typ, r.err)
+	}
+	if d.tables.contains(rel.schema, rel.table) {
+		d.current.changes = append(d.current.changes, ch)
+	}
+	return nil
+}
+
+// reader reads the fields of a pgoutput message
+// the first error is kept and the following reads return zero values.
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.data) < n {
+		r.err = fmt.Errorf("unexpected end of message")
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *reader) byte() byte {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *reader) uint16() uint16 {
+	if b := r.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *reader) uint32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (r *reader) uint64() uint64 {
+	if b := r.next(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (r *reader) timestamp() time.Time {
+	return pgEpoch.Add(time.Duration(int64(r.uint64())) * time.Microsecond)
+}
+
+// string reads a null terminated string
+func (r *reader) string() string {
+	if r.err != nil {
+		return ""
+	}
+	i := bytes.IndexByte(r.data, 0)
+	if i < 0 {
+		r.err = fmt.Errorf("unterminated string")
+		return ""
+	}
+	s := string(r.data[:i])
+	r.data = r.data[i+1:]
+	return s
+}
+
+// tuple reads the values of the columns of a row of the relation
+// the unchanged TOASTed values are not sent by postgres, so they are missing from the row.
+func (r *reader) tuple(rel *relation) map[string]interface{} {
+	n := int(r.uint16())
+	row := make(map[string]interface{}, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		kind := r.byte()
+		var col column
+		if i < len(rel.columns) {
+			col = rel.columns[i]
+		} else {
+			col.name = fmt.Sprintf("column%d", i+1)
+		}
+		switch kind {
+		case 'n':
+			row[col.name] = nil
+		case 't':
+			size := int(r.uint32())
+			if value := r.next(size); r.err == nil {
+				row[col.name] = textValue(col.oid, string(value))
+			}
+		case 'u':
+		default:
+			r.err = fmt.Errorf("unknown tuple data '%c'", kind)
+		}
+	}
+	return row
+}
+
+// textValue converts the text value of a column to a JSON value
+// the values of the types without a JSON equivalent are kept as strings.
+func textValue(oid uint32, value string) interface{} {
+	switch oid {
+	case oidBool:
+		return value == "t"
+	case oidInt2, oidInt4, oidInt8, oidOid, oidFloat4, oidFloat8, oidNumeric:
+		// NaN and Infinity are not valid JSON numbers
+		if json.Valid([]byte(value)) {
+			return json.Number(value)
+		}
+	case oidJSON, oidJSONB:
+		if json.Valid([]byte(value)) {
+			return json.RawMessage(value)
+		}
+	}
+	return value
+}
@@ -0,0 +1,509 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	"github.com/jackc/pgx"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// NotificationEventType is the event type of the events of the notifications of the channels
+	NotificationEventType = "com.github.argoproj.postgres.notification"
+
+	// ChangeEventType is the event type of the events of the row changes of the replicated tables
+	ChangeEventType = "com.github.argoproj.postgres.change"
+
+	// ContextExtensionChannelKey is the event context extension key of the channel of the notification
+	ContextExtensionChannelKey = "channel"
+
+	// ContextExtensionPIDKey is the event context extension key of the process ID of the backend which sent the notification
+	ContextExtensionPIDKey = "pid"
+
+	// ContextExtensionKindKey is the event context extension key of the kind of the row change, i.e. insert, update or delete
+	ContextExtensionKindKey = "kind"
+
+	// ContextExtensionSchemaKey and ContextExtensionTableKey are the event context extension keys of the schema and the table
+	// of the row change
+	ContextExtensionSchemaKey = "schema"
+	ContextExtensionTableKey  = "table"
+
+	// ContextExtensionXidKey is the event context extension key of the ID of the transaction of the row change
+	ContextExtensionXidKey = "xid"
+
+	// the interval of the status updates sent to the server during the replication
+	standbyStatusInterval = 10 * time.Second
+
+	// the durations between reconnections after failures
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = time.Minute
+
+	// the code of the error of a replication slot which already exists
+	duplicateObjectCode = "42710"
+
+	// the maximum number of sent transactions waiting for the acceptance of their row changes
+	// the oldest ones are forgotten beyond it, as they are acknowledged with the later transactions.
+	maxPendingTransactions = 10000
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// Listen() methods CAN retrieve the kubeClient from the postgres struct.
+type postgres struct {
+	kubeClient kubernetes.Interface
+}
+
+// New creates a new postgres signal
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &postgres{kubeClient: kubeClient}
+}
+
+func (p *postgres) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	return p.ListenWithState(signal, nil, done)
+}
+
+// ListenWithState listens to the signal and acknowledges the row changes to the replication slot
+// as the states of the signal report their acceptance by the sensor.
+func (p *postgres) ListenWithState(signal *v1alpha1.Signal, states <-chan *v1alpha1.SignalState, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	config, err := p.resolveConfig(signal.Postgres)
+	if err != nil {
+		return nil, err
+	}
+	source := &v1alpha1.URI{Scheme: "postgres", Host: config.Host, Port: int32(config.Port), Path: "/" + config.Database}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-done
+		cancel()
+	}()
+
+	// the connections are established before returning so that invalid configurations fail the signal
+	var l *listener
+	if len(signal.Postgres.Channels) > 0 {
+		l = &listener{config: config, channels: signal.Postgres.Channels, source: source}
+		if err := l.connect(); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	var r *replicator
+	if signal.Postgres.Replication != nil {
+		var position *v1alpha1.PostgresPosition
+		if signal.State != nil {
+			position = signal.State.PostgresPosition
+		}
+		r, err = newReplicator(config, signal.Postgres.Replication, position, source)
+		if err == nil {
+			err = r.connect()
+		}
+		if err != nil {
+			cancel()
+			if l != nil {
+				l.conn.Close()
+			}
+			return nil, err
+		}
+	}
+
+	events := make(chan *v1alpha1.Event)
+	var wg sync.WaitGroup
+	if l != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.run(ctx, events)
+		}()
+	}
+	if r != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.run(ctx, events)
+		}()
+		go func() {
+			for {
+				select {
+				case state := <-states:
+					if state != nil && state.PostgresPosition != nil {
+						r.acknowledge(state.PostgresPosition)
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+	log.Printf("signal '%s' listening to postgres database [%s]...", signal.Name, source.Path)
+	return events, nil
+}
+
+// resolveConfig resolves the connection configuration of the database from the secret of the signal
+func (p *postgres) resolveConfig(signal *v1alpha1.PostgresSignal) (pgx.ConnConfig, error) {
+	if p.kubeClient == nil {
+		return pgx.ConnConfig{}, fmt.Errorf("failed to retrieve postgres connection string: kubernetes client is not configured")
+	}
+	connString, err := store.GetSecrets(p.kubeClient, common.DefaultSensorControllerNamespace, signal.ConnectionString.Name, signal.ConnectionString.Key)
+	if err != nil {
+		return pgx.ConnConfig{}, err
+	}
+	config, err := pgx.ParseConnectionString(connString)
+	if err != nil {
+		// the connection string is not part of the error as it usually contains a password
+		return pgx.ConnConfig{}, fmt.Errorf("failed to parse postgres connection string of secret %s", signal.ConnectionString.Name)
+	}
+	return config, nil
+}
+
+// listener listens to the notifications of the channels
+type listener struct {
+	config   pgx.ConnConfig
+	channels []string
+	source   *v1alpha1.URI
+	conn     *pgx.Conn
+}
+
+func (l *listener) connect() error {
+	conn, err := pgx.Connect(l.config)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres database %s. Cause: %+v", l.config.Database, err)
+	}
+	for _, channel := range l.channels {
+		if err := conn.Listen(channel); err != nil {
+			conn.Close()
+			return fmt.Errorf("failed to listen to postgres channel %s. Cause: %+v", channel, err)
+		}
+	}
+	l.conn = conn
+	return nil
+}
+
+// run sends the events of the notifications until the context is done
+// the listener reconnects when the connection fails, the notifications sent in the meantime are missed.
+func (l *listener) run(ctx context.Context, events chan<- *v1alpha1.Event) {
+	b := common.Backoff{Min: minReconnectBackoff, Max: maxReconnectBackoff}
+	defer func() {
+		if l.conn != nil {
+			l.conn.Close()
+		}
+	}()
+	for {
+		if l.conn == nil {
+			if err := l.connect(); err != nil {
+				log.Warnf("%s", err)
+				if !b.Wait(ctx) {
+					return
+				}
+				continue
+			}
+			b.Reset()
+		}
+		n, err := l.conn.WaitForNotification(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warnf("failed to wait for postgres notifications, reconnecting. Cause: %+v", err)
+			l.conn.Close()
+			l.conn = nil
+			continue
+		}
+		select {
+		case events <- newNotificationEvent(l.source, n):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// replicator consumes the row changes of a logical replication slot
+// The position of the slot is never acknowledged past the accepted position of the signal, i.e. the position of the
+// latest row change accepted by the sensor and persisted in the sensor status. A transaction is acknowledged once the
+// sensor accepted a row change of a later transaction or the last row change of the transaction.
+// The slot replays the transactions which were not acknowledged when the signal restarts, whose row changes
+// up to the accepted position of the signal are skipped.
+type replicator struct {
+	config      pgx.ConnConfig
+	replication *v1alpha1.PostgresReplication
+	source      *v1alpha1.URI
+
+	// acceptedLSN and acceptedRow are the accepted position of the signal when it started, if any
+	acceptedLSN uint64
+	acceptedRow int
+
+	// position is the position the replication starts from, i.e. the end of the latest emitted transaction
+	// the replication starts from the acknowledged position of the slot if it is zero.
+	position uint64
+
+	// mu guards the acknowledged position and the pending transactions, which are updated as the sensor accepts events
+	mu sync.Mutex
+	// confirmed is the acknowledged position of the slot
+	confirmed uint64
+	// pending are the positions of the sent transactions which are not acknowledged yet
+	pending []uint64
+
+	conn    *pgx.ReplicationConn
+	decoder decoder
+}
+
+func newReplicator(config pgx.ConnConfig, replication *v1alpha1.PostgresReplication, accepted *v1alpha1.PostgresPosition, source *v1alpha1.URI) (*replicator, error) {
+	r := &replicator{config: config, replication: replication, source: source}
+	if accepted != nil {
+		lsn, err := pgx.ParseLSN(accepted.LSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse accepted lsn %s. Cause: %+v", accepted.LSN, err)
+		}
+		r.acceptedLSN = lsn
+		r.acceptedRow = int(accepted.Row)
+		if isLastRow(accepted) {
+			r.confirmed = lsn
+		}
+	}
+	return r, nil
+}
+
+// isLastRow returns true if the position is the one of the last row change of its transaction
+func isLastRow(position *v1alpha1.PostgresPosition) bool {
+	return position.Rows > 0 && position.Row == position.Rows-1
+}
+
+func (r *replicator) connect() error {
+	conn, err := pgx.ReplicationConnect(r.config)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres database %s for replication. Cause: %+v", r.config.Database, err)
+	}
+	plugin := r.replication.Plugin
+	if plugin == "" {
+		plugin = v1alpha1.PostgresReplicationPluginWal2JSON
+	}
+	if r.replication.CreateSlot {
+		err := conn.CreateReplicationSlot(r.replication.Slot, string(plugin))
+		if pgErr, ok := err.(pgx.PgError); err != nil && !(ok && pgErr.Code == duplicateObjectCode) {
+			conn.Close()
+			return fmt.Errorf("failed to create replication slot %s. Cause: %+v", r.replication.Slot, err)
+		}
+	}
+	dec := newDecoder(r.replication)
+	if err := conn.StartReplication(r.replication.Slot, r.position, -1, dec.pluginArgs()...); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start replication of slot %s. Cause: %+v", r.replication.Slot, err)
+	}
+	r.conn = conn
+	r.decoder = dec
+	return r.sendStandbyStatus()
+}
+
+// sendStandbyStatus acknowledges the confirmed position of the slot, which also keeps the connection alive
+func (r *replicator) sendStandbyStatus() error {
+	r.mu.Lock()
+	confirmed := r.confirmed
+	r.mu.Unlock()
+	status, err := pgx.NewStandbyStatus(confirmed)
+	if err != nil {
+		return err
+	}
+	return r.conn.SendStandbyStatus(status)
+}
+
+// run sends the events of the row changes until the context is done
+// the replicator reconnects when the connection fails, from the end of the latest emitted transaction.
+func (r *replicator) run(ctx context.Context, events chan<- *v1alpha1.Event) {
+	b := common.Backoff{Min: minReconnectBackoff, Max: maxReconnectBackoff}
+	defer func() {
+		if r.conn != nil {
+			r.conn.Close()
+		}
+	}()
+	for {
+		if r.conn == nil {
+			if err := r.connect(); err != nil {
+				log.Warnf("%s", err)
+				if !b.Wait(ctx) {
+					return
+				}
+				continue
+			}
+			b.Reset()
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, standbyStatusInterval)
+		msg, err := r.conn.WaitForReplicationMessage(waitCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == context.DeadlineExceeded || msg != nil && msg.ServerHeartbeat != nil && msg.ServerHeartbeat.ReplyRequested == 1 {
+			err = r.sendStandbyStatus()
+		}
+		if err != nil {
+			log.Warnf("replication of slot %s failed, reconnecting. Cause: %+v", r.replication.Slot, err)
+			r.conn.Close()
+			r.conn = nil
+			continue
+		}
+		if msg == nil || msg.WalMessage == nil {
+			continue
+		}
+		tx, err := r.decoder.decode(msg.WalMessage)
+		if err != nil {
+			log.Warnf("failed to decode the message at %s of slot %s: %s", pgx.FormatLSN(msg.WalMessage.WalStart), r.replication.Slot, err)
+			continue
+		}
+		if tx == nil {
+			continue
+		}
+		first := r.firstRow(tx)
+		for _, event := range newChangeEvents(r.source, tx, first) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+		r.position = tx.lsn
+		r.sent(tx, first)
+	}
+}
+
+// sent records the transaction whose events were sent, which waits for the acceptance of its row changes
+// unless they were all accepted before the signal restarted.
+func (r *replicator) sent(tx *transaction, first int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if first >= len(tx.changes) && tx.lsn <= r.acceptedLSN {
+		if tx.lsn > r.confirmed {
+			r.confirmed = tx.lsn
+		}
+		return
+	}
+	r.pending = append(r.pending, tx.lsn)
+	if len(r.pending) > maxPendingTransactions {
+		r.pending = r.pending[1:]
+	}
+}
+
+// acknowledge advances the acknowledged position of the slot to the accepted position of the signal
+// the transactions sent before the one of the accepted row change are acknowledged, as well as the transaction
+// of the accepted row change if it is its last row change.
+func (r *replicator) acknowledge(accepted *v1alpha1.PostgresPosition) {
+	lsn, err := pgx.ParseLSN(accepted.LSN)
+	if err != nil {
+		log.Warnf("failed to parse accepted lsn %s of slot %s: %s", accepted.LSN, r.replication.Slot, err)
+		return
+	}
+	last := isLastRow(accepted)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for len(r.pending) > 0 && (r.pending[0] < lsn || last && r.pending[0] == lsn) {
+		if r.pending[0] > r.confirmed {
+			r.confirmed = r.pending[0]
+		}
+		r.pending = r.pending[1:]
+	}
+	if last && lsn > r.confirmed {
+		r.confirmed = lsn
+	}
+}
+
+// firstRow returns the index of the first row change of the transaction which was not accepted by the sensor
+func (r *replicator) firstRow(tx *transaction) int {
+	switch {
+	case tx.lsn < r.acceptedLSN:
+		return len(tx.changes)
+	case tx.lsn == r.acceptedLSN:
+		return r.acceptedRow + 1
+	default:
+		return 0
+	}
+}
+
+func newNotificationEvent(source *v1alpha1.URI, n *pgx.Notification) *v1alpha1.Event {
+	contentType := "text/plain"
+	if json.Valid([]byte(n.Payload)) {
+		contentType = "application/json"
+	}
+	now := time.Now().UTC()
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          NotificationEventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%d-%d", n.Channel, n.PID, now.UnixNano()),
+			EventTime:          metav1.Time{Time: now},
+			Source:             source,
+			ContentType:        contentType,
+			Extensions: map[string]string{
+				ContextExtensionChannelKey: n.Channel,
+				ContextExtensionPIDKey:     strconv.FormatUint(uint64(n.PID), 10),
+			},
+		},
+		Data: []byte(n.Payload),
+	}
+}
+
+// newChangeEvents returns the events of the row changes of the transaction from the row change at index first
+// the events carry the position of their row change, i.e. the position of the transaction and the index of the row change.
+func newChangeEvents(source *v1alpha1.URI, tx *transaction, first int) []*v1alpha1.Event {
+	lsn := pgx.FormatLSN(tx.lsn)
+	var events []*v1alpha1.Event
+	for i := first; i < len(tx.changes); i++ {
+		c := tx.changes[i]
+		b, err := json.Marshal(c)
+		if err != nil {
+			log.Warnf("failed to create event of %s of %s.%s: %s", c.Kind, c.Schema, c.Table, err)
+			continue
+		}
+		extensions := map[string]string{
+			ContextExtensionKindKey:             c.Kind,
+			ContextExtensionSchemaKey:           c.Schema,
+			ContextExtensionTableKey:            c.Table,
+			ContextExtensionXidKey:              strconv.FormatUint(uint64(tx.xid), 10),
+			sdk.ContextExtensionPostgresLSNKey:  lsn,
+			sdk.ContextExtensionPostgresRowKey:  strconv.Itoa(i),
+			sdk.ContextExtensionPostgresRowsKey: strconv.Itoa(len(tx.changes)),
+		}
+		events = append(events, &v1alpha1.Event{
+			Context: v1alpha1.EventContext{
+				EventType:          ChangeEventType,
+				EventTypeVersion:   "v1",
+				CloudEventsVersion: sdk.CloudEventsVersion,
+				EventID:            fmt.Sprintf("%s-%d", lsn, i),
+				EventTime:          metav1.Time{Time: tx.commitTime},
+				Source:             source,
+				ContentType:        "application/json",
+				Extensions:         extensions,
+			},
+			Data: b,
+		})
+	}
+	return events
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/jackc/pgx"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWal2JSONDecode(t *testing.T) {
+	d := newDecoder(&v1alpha1.PostgresReplication{Tables: []string{"orders"}})
+	args := d.pluginArgs()
+	if args[len(args)-1] != `"add-tables" 'public.orders'` {
+		t.Errorf("expected the tables in the plugin args but found %v", args)
+	}
+
+	data := `{"xid":563,"timestamp":"2018-08-03 09:52:26.739266+02","change":[
+		{"kind":"insert","schema":"public","table":"orders","columnnames":["id","total"],"columntypes":["bigint","numeric"],"columnvalues":[1,12.50]},
+		{"kind":"update","schema":"public","table":"orders","columnnames":["id","total"],"columntypes":["bigint","numeric"],"columnvalues":[1,13.00],
+			"oldkeys":{"keynames":["id"],"keytypes":["bigint"],"keyvalues":[1]}},
+		{"kind":"delete","schema":"public","table":"customers","oldkeys":{"keynames":["id"],"keytypes":["bigint"],"keyvalues":[7]}},
+		{"kind":"delete","schema":"public","table":"orders","oldkeys":{"keynames":["id"],"keytypes":["bigint"],"keyvalues":[1]}}
+	]}`
+	tx, err := d.decode(&pgx.WalMessage{WalStart: 0x16B3748, WalData: []byte(data)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.xid != 563 || tx.lsn != 0x16B3748 || !tx.commitTime.Equal(time.Date(2018, 8, 3, 7, 52, 26, 739266000, time.UTC)) {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+	expected := []change{
+		{Kind: "insert", Schema: "public", Table: "orders", After: map[string]interface{}{"id": json.Number("1"), "total": json.Number("12.50")}},
+		{
+			Kind: "update", Schema: "public", Table: "orders",
+			Before: map[string]interface{}{"id": json.Number("1")},
+			After:  map[string]interface{}{"id": json.Number("1"), "total": json.Number("13.00")},
+		},
+		{Kind: "delete", Schema: "public", Table: "orders", Before: map[string]interface{}{"id": json.Number("1")}},
+	}
+	if !reflect.DeepEqual(tx.changes, expected) {
+		t.Errorf("expected changes %+v but found %+v", expected, tx.changes)
+	}
+
+	if _, err := d.decode(&pgx.WalMessage{WalData: []byte("{")}); err == nil {
+		t.Error("expected an error for an invalid message")
+	}
+}
+
+// pgoutputMessage builds the messages of the pgoutput protocol
+type pgoutputMessage []byte
+
+func (m pgoutputMessage) byte(b byte) pgoutputMessage {
+	return append(m, b)
+}
+
+func (m pgoutputMessage) uint16(v uint16) pgoutputMessage {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return append(m, b...)
+}
+
+func (m pgoutputMessage) uint32(v uint32) pgoutputMessage {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return append(m, b...)
+}
+
+func (m pgoutputMessage) uint64(v uint64) pgoutputMessage {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return append(m, b...)
+}
+
+func (m pgoutputMessage) string(s string) pgoutputMessage {
+	return append(append(m, s...), 0)
+}
+
+func (m pgoutputMessage) text(s string) pgoutputMessage {
+	return m.byte('t').uint32(uint32(len(s))).append(s)
+}
+
+func (m pgoutputMessage) append(s string) pgoutputMessage {
+	return append(m, s...)
+}
+
+func TestPGOutputDecode(t *testing.T) {
+	d := newDecoder(&v1alpha1.PostgresReplication{Plugin: v1alpha1.PostgresReplicationPluginPGOutput, Publication: "orders", Tables: []string{"public.orders"}})
+	if args := d.pluginArgs(); !reflect.DeepEqual(args, []string{`proto_version '1'`, `publication_names 'orders'`}) {
+		t.Errorf("unexpected plugin args %v", args)
+	}
+
+	commitTime := time.Date(2018, 8, 3, 9, 52, 26, 739266000, time.UTC)
+	relation := func(id uint32, table string) pgoutputMessage {
+		return pgoutputMessage{}.byte('R').uint32(id).string("public").string(table).byte('d').uint16(4).
+			byte(1).string("id").uint32(oidInt8).uint32(0xFFFFFFFF).
+			byte(0).string("paid").uint32(oidBool).uint32(0xFFFFFFFF).
+			byte(0).string("details").uint32(oidJSONB).uint32(0xFFFFFFFF).
+			byte(0).string("note").uint32(25).uint32(0xFFFFFFFF)
+	}
+	messages := []pgoutputMessage{
+		pgoutputMessage{}.byte('B').uint64(0x16B3700).uint64(uint64(commitTime.Sub(pgEpoch) / time.Microsecond)).uint32(563),
+		relation(16384, "orders"),
+		relation(16390, "customers"),
+		pgoutputMessage{}.byte('I').uint32(16384).byte('N').uint16(4).text("1").text("f").text(`{"items":2}`).byte('n'),
+		pgoutputMessage{}.byte('I').uint32(16390).byte('N').uint16(4).text("7").text("t").byte('n').byte('n'),
+		pgoutputMessage{}.byte('U').uint32(16384).byte('O').uint16(4).text("1").text("f").text(`{"items":2}`).byte('n').
+			byte('N').uint16(4).text("1").text("t").byte('u').text("paid late"),
+		pgoutputMessage{}.byte('D').uint32(16384).byte('K').uint16(4).text("1").byte('n').byte('n').byte('n'),
+	}
+	for _, m := range messages {
+		tx, err := d.decode(&pgx.WalMessage{WalData: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx != nil {
+			t.Fatalf("unexpected transaction %+v before the commit", tx)
+		}
+	}
+	tx, err := d.decode(&pgx.WalMessage{WalData: pgoutputMessage{}.byte('C').byte(0).uint64(0x16B3700).uint64(0x16B3748).uint64(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx == nil || tx.xid != 563 || tx.lsn != 0x16B3748 || !tx.commitTime.Equal(commitTime) {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+	expected := []change{
+		{
+			Kind: "insert", Schema: "public", Table: "orders",
+			After: map[string]interface{}{"id": json.Number("1"), "paid": false, "details": json.RawMessage(`{"items":2}`), "note": nil},
+		},
+		{
+			Kind: "update", Schema: "public", Table: "orders",
+			Before: map[string]interface{}{"id": json.Number("1"), "paid": false, "details": json.RawMessage(`{"items":2}`), "note": nil},
+			// the unchanged TOASTed details are not sent
+			After: map[string]interface{}{"id": json.Number("1"), "paid": true, "note": "paid late"},
+		},
+		{
+			Kind: "delete", Schema: "public", Table: "orders",
+			Before: map[string]interface{}{"id": json.Number("1"), "paid": nil, "details": nil, "note": nil},
+		},
+	}
+	if !reflect.DeepEqual(tx.changes, expected) {
+		t.Errorf("expected changes %+v but found %+v", expected, tx.changes)
+	}
+
+	if _, err := d.decode(&pgx.WalMessage{WalData: pgoutputMessage{}.byte('I').uint32(1).byte('N').uint16(0)}); err == nil {
+		t.Error("expected an error for an unknown relation")
+	}
+	if _, err := d.decode(&pgx.WalMessage{WalData: pgoutputMessage{}.byte('R').uint32(1).string("public")}); err == nil {
+		t.Error("expected an error for a truncated message")
+	}
+}
+
+func TestNewChangeEvents(t *testing.T) {
+	source := &v1alpha1.URI{Scheme: "postgres", Host: "db", Port: 5432, Path: "/shop"}
+	tx := &transaction{
+		xid:        563,
+		lsn:        0x16B3748,
+		commitTime: time.Now().UTC(),
+		changes: []change{
+			{Kind: "insert", Schema: "public", Table: "orders", After: map[string]interface{}{"id": 1}},
+			{Kind: "delete", Schema: "public", Table: "orders", Before: map[string]interface{}{"id": 1}},
+		},
+	}
+	events := newChangeEvents(source, tx, 0)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events but found %d", len(events))
+	}
+	first, last := events[0].Context, events[1].Context
+	if first.EventType != ChangeEventType || first.Extensions[ContextExtensionKindKey] != "insert" ||
+		first.Extensions[ContextExtensionTableKey] != "orders" || first.Extensions[ContextExtensionXidKey] != "563" {
+		t.Errorf("unexpected event context %+v", first)
+	}
+	// every row change carries its position
+	for i, context := range []v1alpha1.EventContext{first, last} {
+		lsn, row, rows := context.Extensions[sdk.ContextExtensionPostgresLSNKey], context.Extensions[sdk.ContextExtensionPostgresRowKey], context.Extensions[sdk.ContextExtensionPostgresRowsKey]
+		if lsn != "0/16B3748" || row != strconv.Itoa(i) || rows != "2" {
+			t.Errorf("expected the position 0/16B3748 and row %d of 2 but found %s and %s of %s", i, lsn, row, rows)
+		}
+	}
+	if first.EventID == last.EventID {
+		t.Errorf("expected unique event ids but found %s", first.EventID)
+	}
+	if id := gjson.GetBytes(events[1].Data, "before.id").Int(); id != 1 || gjson.GetBytes(events[1].Data, "after").Type != gjson.Null {
+		t.Errorf("unexpected event data %s", events[1].Data)
+	}
+}
+
+func TestNewReplicator(t *testing.T) {
+	replication := &v1alpha1.PostgresReplication{Slot: "argo_events"}
+	r, err := newReplicator(pgx.ConnConfig{}, replication, &v1alpha1.PostgresPosition{LSN: "0/16B3748", Row: 1, Rows: 3}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the replication starts from the acknowledged position of the slot
+	if r.acceptedLSN != 0x16B3748 || r.acceptedRow != 1 || r.confirmed != 0 || r.position != 0 {
+		t.Errorf("unexpected positions of replicator %+v", r)
+	}
+	// the transaction of the accepted position is acknowledged once its last row change is accepted
+	r, err = newReplicator(pgx.ConnConfig{}, replication, &v1alpha1.PostgresPosition{LSN: "0/16B3748", Row: 2, Rows: 3}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.confirmed != 0x16B3748 {
+		t.Errorf("expected the confirmed position 0/16B3748 but found %s", pgx.FormatLSN(r.confirmed))
+	}
+	if _, err := newReplicator(pgx.ConnConfig{}, replication, &v1alpha1.PostgresPosition{LSN: "latest"}, nil); err == nil {
+		t.Error("expected an error for an invalid accepted lsn")
+	}
+}
+
+func TestReplayTransaction(t *testing.T) {
+	replication := &v1alpha1.PostgresReplication{Slot: "argo_events"}
+	tx := &transaction{
+		xid:        563,
+		lsn:        0x16B3748,
+		commitTime: time.Now().UTC(),
+		changes: []change{
+			{Kind: "insert", Schema: "public", Table: "orders", After: map[string]interface{}{"id": 1}},
+			{Kind: "insert", Schema: "public", Table: "orders", After: map[string]interface{}{"id": 2}},
+		},
+	}
+	// accept reports the position of the event as the sensor controller does, which is passed as signal state on restart
+	accept := func(event *v1alpha1.Event) *v1alpha1.PostgresPosition {
+		row, err := strconv.ParseInt(event.Context.Extensions[sdk.ContextExtensionPostgresRowKey], 10, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows, err := strconv.ParseInt(event.Context.Extensions[sdk.ContextExtensionPostgresRowsKey], 10, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v1alpha1.PostgresPosition{LSN: event.Context.Extensions[sdk.ContextExtensionPostgresLSNKey], Row: int32(row), Rows: int32(rows)}
+	}
+	replay := func(accepted *v1alpha1.PostgresPosition) []*v1alpha1.Event {
+		r, err := newReplicator(pgx.ConnConfig{}, replication, accepted, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return newChangeEvents(r.source, tx, r.firstRow(tx))
+	}
+
+	events := replay(nil)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events but found %d", len(events))
+	}
+	// the sensor only accepts the first row change before it repeats, the second one is replayed
+	events = replay(accept(events[0]))
+	if len(events) != 1 || gjson.GetBytes(events[0].Data, "after.id").Int() != 2 {
+		t.Fatalf("expected the event of the second row change but found %v", events)
+	}
+	if events := replay(accept(events[0])); len(events) != 0 {
+		t.Errorf("expected no events once the transaction is accepted but found %d", len(events))
+	}
+	// the transactions before the accepted position are skipped
+	if events := replay(&v1alpha1.PostgresPosition{LSN: "0/16B3750"}); len(events) != 0 {
+		t.Errorf("expected no events of a previous transaction but found %d", len(events))
+	}
+}
+
+func TestAcknowledge(t *testing.T) {
+	r, err := newReplicator(pgx.ConnConfig{}, &v1alpha1.PostgresReplication{Slot: "argo_events"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := []change{{Kind: "insert", Schema: "public", Table: "orders"}, {Kind: "insert", Schema: "public", Table: "orders"}}
+	r.sent(&transaction{lsn: 0x100, changes: changes}, 0)
+	r.sent(&transaction{lsn: 0x200, changes: changes}, 0)
+	// sending the events does not acknowledge the transactions
+	if r.confirmed != 0 {
+		t.Errorf("expected no confirmed position but found %s", pgx.FormatLSN(r.confirmed))
+	}
+	// the sensor accepted the first row change of the second transaction
+	r.acknowledge(&v1alpha1.PostgresPosition{LSN: "0/200", Row: 0, Rows: 2})
+	if r.confirmed != 0x100 {
+		t.Errorf("expected the confirmed position 0/100 but found %s", pgx.FormatLSN(r.confirmed))
+	}
+	r.acknowledge(&v1alpha1.PostgresPosition{LSN: "0/200", Row: 1, Rows: 2})
+	if r.confirmed != 0x200 || len(r.pending) != 0 {
+		t.Errorf("expected the confirmed position 0/200 but found %s and pending %v", pgx.FormatLSN(r.confirmed), r.pending)
+	}
+	// the transactions accepted before the signal restarted are acknowledged as they are replayed
+	r, err = newReplicator(pgx.ConnConfig{}, &v1alpha1.PostgresReplication{Slot: "argo_events"}, &v1alpha1.PostgresPosition{LSN: "0/200", Row: 0, Rows: 2}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &transaction{lsn: 0x100, changes: changes}
+	r.sent(tx, r.firstRow(tx))
+	tx = &transaction{lsn: 0x200, changes: changes}
+	r.sent(tx, r.firstRow(tx))
+	if r.confirmed != 0x100 || !reflect.DeepEqual(r.pending, []uint64{0x200}) {
+		t.Errorf("expected the confirmed position 0/100 but found %s and pending %v", pgx.FormatLSN(r.confirmed), r.pending)
+	}
+}
+
+func TestResolveConfig(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "postgres", Namespace: common.DefaultSensorControllerNamespace},
+		Data: map[string][]byte{
+			"url":     []byte("postgres://argo:secret@db:5433/shop?sslmode=disable"),
+			"invalid": []byte("postgres://db/shop?sslmode=sometimes"),
+		},
+	})
+	p := &postgres{kubeClient: kubeClient}
+	config, err := p.resolveConfig(&v1alpha1.PostgresSignal{
+		ConnectionString: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "postgres"}, Key: "url"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Host != "db" || config.Port != 5433 || config.Database != "shop" || config.User != "argo" || config.Password != "secret" {
+		t.Errorf("unexpected connection config %+v", config)
+	}
+	_, err = p.resolveConfig(&v1alpha1.PostgresSignal{
+		ConnectionString: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "postgres"}, Key: "invalid"},
+	})
+	if err == nil {
+		t.Error("expected an error for an invalid connection string")
+	}
+}
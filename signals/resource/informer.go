@@ -0,0 +1,211 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+// informer is a shared informer of a resource which is stopped once all of its handlers are removed
+type informer struct {
+	cache.SharedIndexInformer
+	stopCh   chan struct{}
+	handlers int
+
+	mu sync.Mutex
+	// existing contains the UIDs of the resources returned by the initial list of the informer
+	existing map[types.UID]bool
+}
+
+// isExisting returns true if the resource was returned by the initial list of the informer
+func (inf *informer) isExisting(uid types.UID) bool {
+	inf.mu.Lock()
+	defer inf.mu.Unlock()
+	return inf.existing[uid]
+}
+
+// informerCache shares informers between the signals listening on the same resources
+// the reflectors of the informers track the resourceVersion of the resources and re-list them
+// when a watch expires or its resourceVersion is too old.
+type informerCache struct {
+	mu        sync.Mutex
+	informers map[string]*informer
+}
+
+func newInformerCache() *informerCache {
+	return &informerCache{informers: make(map[string]*informer)}
+}
+
+// resourceHandler handles the resource events of an informer for a single signal
+type resourceHandler struct {
+	informer *informer
+	// includeExisting is true if resources which existed before the handler was added should be handled
+	includeExisting bool
+	// existing contains the UIDs of the resources in the informer store when the handler was added
+	existing map[types.UID]bool
+	handle   func(eventType watch.EventType, oldObj, obj *unstructured.Unstructured)
+
+	mu      sync.Mutex
+	removed bool
+}
+
+// addHandler adds a handler for the resource events of the informer with the given key
+// the informer is created and started if it does not exist yet and
+// the old resource is only passed to handle for MODIFIED events.
+func (c *informerCache) addHandler(key string, client dynamic.ResourceInterface, labelSelector string, includeExisting bool, handle func(eventType watch.EventType, oldObj, obj *unstructured.Unstructured)) *resourceHandler {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	inf, ok := c.informers[key]
+	if !ok {
+		inf = c.newInformer(client, labelSelector)
+		c.informers[key] = inf
+		log.Infof("starting informer for %s", key)
+		go inf.Run(inf.stopCh)
+	}
+	inf.handlers++
+
+	h := &resourceHandler{
+		informer:        inf,
+		includeExisting: includeExisting,
+		existing:        make(map[types.UID]bool),
+		handle:          handle,
+	}
+	for _, obj := range inf.GetStore().List() {
+		if accessor, err := meta.Accessor(obj); err == nil {
+			h.existing[accessor.GetUID()] = true
+		}
+	}
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    h.onAdd,
+		UpdateFunc: h.onUpdate,
+		DeleteFunc: h.onDelete,
+	})
+	return h
+}
+
+// removeHandler stops the handler from handling further events and stops the informer if it has no more handlers
+// NOTE: handlers cannot be removed from shared informers so they are only disabled
+func (c *informerCache) removeHandler(key string, h *resourceHandler) {
+	h.mu.Lock()
+	h.removed = true
+	h.mu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	inf, ok := c.informers[key]
+	if !ok || inf != h.informer {
+		return
+	}
+	inf.handlers--
+	if inf.handlers == 0 {
+		log.Infof("stopping informer for %s", key)
+		close(inf.stopCh)
+		delete(c.informers, key)
+	}
+}
+
+func (c *informerCache) newInformer(client dynamic.ResourceInterface, labelSelector string) *informer {
+	inf := &informer{
+		stopCh:   make(chan struct{}),
+		existing: make(map[types.UID]bool),
+	}
+	initialList := true
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = labelSelector
+			list, err := client.List(options)
+			if err != nil {
+				return nil, err
+			}
+			inf.mu.Lock()
+			defer inf.mu.Unlock()
+			if initialList {
+				initialList = false
+				_ = meta.EachListItem(list, func(obj runtime.Object) error {
+					if accessor, err := meta.Accessor(obj); err == nil {
+						inf.existing[accessor.GetUID()] = true
+					}
+					return nil
+				})
+			}
+			return list, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = labelSelector
+			return client.Watch(options)
+		},
+	}
+	inf.SharedIndexInformer = cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	return inf
+}
+
+func (h *resourceHandler) onAdd(obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	if !h.includeExisting && (h.existing[u.GetUID()] || h.informer.isExisting(u.GetUID())) {
+		return
+	}
+	h.dispatch(watch.Added, nil, u)
+}
+
+func (h *resourceHandler) onUpdate(oldObj, newObj interface{}) {
+	oldU, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	newU, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	// re-lists notify updates for unchanged resources
+	if oldU.GetResourceVersion() == newU.GetResourceVersion() {
+		return
+	}
+	h.dispatch(watch.Modified, oldU, newU)
+}
+
+func (h *resourceHandler) onDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	h.dispatch(watch.Deleted, nil, u)
+}
+
+func (h *resourceHandler) dispatch(eventType watch.EventType, oldObj, obj *unstructured.Unstructured) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.removed {
+		return
+	}
+	h.handle(eventType, oldObj, obj)
+}
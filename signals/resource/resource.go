@@ -17,35 +17,77 @@ limitations under the License.
 package resource
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sdk"
 )
 
+const (
+	// ContextExtensionWatchTypeKey is the event context extension key for the watch event type of the resource
+	// i.e. ADDED, MODIFIED or DELETED
+	ContextExtensionWatchTypeKey = "watchType"
+
+	// ContextExtensionNamespaceKey is the event context extension key for the namespace of the resource
+	// it is not set for cluster-scoped resources
+	ContextExtensionNamespaceKey = "namespace"
+
+	// ContextExtensionLabelPrefix is the prefix of the event context extension keys of the labels of the resource
+	ContextExtensionLabelPrefix = "label."
+
+	// ContextExtensionAnnotationPrefix is the prefix of the event context extension keys of the annotations of the resource
+	ContextExtensionAnnotationPrefix = "annotation."
+
+	// EventTypePrefix is the prefix of the event types of resources
+	// the event type is suffixed with the group, kind and watch event type of the resource e.g. io.k8s.core.pod.added
+	EventTypePrefix = "io.k8s"
+)
+
 // Note: micro requires stateless operation so the Listen() method should not use the
 // receive struct to save or modify state.
 // Listen() methods CAN retrieve the kubeConfig from the resource struct.
+// The informers are shared between Listen() calls watching the same resources.
 type resource struct {
 	kubeConfig *rest.Config
+	informers  *informerCache
 }
 
 // New creates a new resource signaler
 func New(kubeConfig *rest.Config) sdk.Listener {
-	return &resource{kubeConfig: kubeConfig}
+	return &resource{
+		kubeConfig: kubeConfig,
+		informers:  newInformerCache(),
+	}
+}
+
+// watchedResource is a resource which can be watched
+type watchedResource struct {
+	// key uniquely identifies the resource and namespace of the watch
+	key    string
+	client dynamic.ResourceInterface
+	// name is the plural name of the resource
+	name string
+	// namespace is the namespace of the watch, or empty for all namespaces or cluster-scoped resources
+	namespace string
 }
 
 func (r *resource) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
@@ -54,46 +96,240 @@ func (r *resource) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan
 		return nil, err
 	}
 
-	options := metav1.ListOptions{Watch: true}
+	var labelSelector string
+	fieldSelector := fields.Everything()
 	if signal.Resource.Filter != nil {
-		options.LabelSelector = labels.Set(signal.Resource.Filter.Labels).AsSelector().String()
+		labelSelector = labels.Set(signal.Resource.Filter.Labels).AsSelector().String()
+		if signal.Resource.Filter.FieldSelector != "" {
+			fieldSelector, err = fields.ParseSelector(signal.Resource.Filter.FieldSelector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse field selector %s. Cause: %+v", signal.Resource.Filter.FieldSelector, err.Error())
+			}
+		}
 	}
 
-	wg := sync.WaitGroup{}
-	watches := make([]watch.Interface, 0)
-	events := make(chan *v1alpha1.Event)
+	// keys and handlers of the shared informers
+	keys := make([]string, 0, len(resources)+1)
+	handlers := make([]*resourceHandler, 0, len(resources)+1)
+	removeHandlers := func() {
+		for i := range handlers {
+			r.informers.removeHandler(keys[i], handlers[i])
+		}
+	}
 
-	// start up listeners
-	for i := 0; i < len(resources); i++ {
-		resource := resources[i]
-		watch, err := resource.Watch(options)
+	// the namespaces matching the namespace selector are tracked by an informer of the namespaces
+	var namespaces cache.Store
+	if signal.Resource.NamespaceSelector != "" {
+		nsKey, nsHandler, err := r.watchNamespaces(signal.Resource.NamespaceSelector, done)
 		if err != nil {
 			return nil, err
 		}
-		watches = append(watches, watch)
-
-		wg.Add(1)
-		go r.listen(events, watch, signal.Resource.Filter, &wg)
+		keys = append(keys, nsKey)
+		handlers = append(handlers, nsHandler)
+		if !cache.WaitForCacheSync(done, nsHandler.informer.HasSynced) {
+			removeHandlers()
+			return nil, fmt.Errorf("failed to sync namespaces matching selector %s", signal.Resource.NamespaceSelector)
+		}
+		namespaces = nsHandler.informer.GetStore()
 	}
 
-	// wait for stop signal
-	go func() {
-		<-done
-		for _, watch := range watches {
-			watch.Stop()
+	source := apiServerURI(r.kubeConfig)
+	events := make(chan *v1alpha1.Event)
+	handle := func(eventType watch.EventType, oldObj, obj *unstructured.Unstructured) {
+		if namespaces != nil {
+			if _, exists, _ := namespaces.GetByKey(obj.GetNamespace()); !exists {
+				log.Printf("FILTERED: resource namespace '%s' does not match namespace selector '%s'", obj.GetNamespace(), signal.Resource.NamespaceSelector)
+				return
+			}
 		}
-	}()
+		if !passFilters(obj, eventType, signal.Resource.Filter, fieldSelector) {
+			return
+		}
+		var changes []fieldChange
+		if len(signal.Resource.FieldChanges) > 0 {
+			changes = detectChanges(eventType, oldObj, obj, signal.Resource.FieldChanges)
+			if len(changes) == 0 {
+				log.Printf("FILTERED: resource '%s' fields did not change", obj.GetName())
+				return
+			}
+		}
+		event, err := newEvent(signal.Resource, source, eventType, obj, changes)
+		if err != nil {
+			log.Warnf("failed to create event for resource '%s': %s", obj.GetName(), err)
+			return
+		}
+		select {
+		case events <- event:
+		case <-done:
+		}
+	}
+
+	// start up handlers on the shared informers
+	for _, res := range resources {
+		key := res.key + "?labelSelector=" + labelSelector
+		keys = append(keys, key)
+		handlers = append(handlers, r.informers.addHandler(key, res.client, labelSelector, signal.Resource.IncludeExisting, handle))
+	}
 
-	// wait for all watches to complete, then close the events channel
+	// wait for stop signal, then remove the handlers and close the events channel
 	go func() {
-		wg.Wait()
+		<-done
+		removeHandlers()
 		close(events)
 	}()
 
 	return events, nil
 }
 
-func (r *resource) discoverResources(obj *v1alpha1.ResourceSignal) ([]dynamic.ResourceInterface, error) {
+// fieldChange is the change of a field of a resource
+type fieldChange struct {
+	Path string          `json:"path"`
+	Old  json.RawMessage `json:"old"`
+	New  json.RawMessage `json:"new"`
+}
+
+// changeEventData is the data of an event of a resource whose field changes are detected
+type changeEventData struct {
+	Object  json.RawMessage `json:"object"`
+	Changes []fieldChange   `json:"changes"`
+}
+
+// newEvent creates the event of the resource
+// if changes is not nil, the event data contains the resource and the changes of its fields.
+func newEvent(signal *v1alpha1.ResourceSignal, source *v1alpha1.URI, eventType watch.EventType, obj *unstructured.Unstructured, changes []fieldChange) (*v1alpha1.Event, error) {
+	b, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if changes != nil {
+		b, err = json.Marshal(changeEventData{Object: b, Changes: changes})
+		if err != nil {
+			return nil, err
+		}
+	}
+	gvk := obj.GroupVersionKind()
+	event := &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          resolveEventType(gvk, eventType),
+			EventTypeVersion:   gvk.Version,
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%s", obj.GetUID(), obj.GetResourceVersion()),
+			EventTime:          metav1.Time{Time: time.Now().UTC()},
+			ContentType:        "application/json",
+			Extensions: map[string]string{
+				ContextExtensionWatchTypeKey: string(eventType),
+			},
+		},
+		Data: b,
+	}
+	if source != nil {
+		src := *source
+		src.Path = obj.GetSelfLink()
+		event.Context.Source = &src
+	}
+	if obj.GetNamespace() != "" {
+		event.Context.Extensions[ContextExtensionNamespaceKey] = obj.GetNamespace()
+	}
+	if signal.IncludeLabels {
+		for k, v := range obj.GetLabels() {
+			event.Context.Extensions[ContextExtensionLabelPrefix+k] = v
+		}
+	}
+	if signal.IncludeAnnotations {
+		for k, v := range obj.GetAnnotations() {
+			event.Context.Extensions[ContextExtensionAnnotationPrefix+k] = v
+		}
+	}
+	return event, nil
+}
+
+// resolveEventType returns the event type of the watch event of a resource of the group version kind
+func resolveEventType(gvk schema.GroupVersionKind, eventType watch.EventType) string {
+	group := gvk.Group
+	if group == "" {
+		group = "core"
+	}
+	return strings.ToLower(fmt.Sprintf("%s.%s.%s.%s", EventTypePrefix, group, gvk.Kind, eventType))
+}
+
+// apiServerURI returns the URI of the API server of the kube config
+// returns nil if the host of the kube config cannot be parsed.
+func apiServerURI(kubeConfig *rest.Config) *v1alpha1.URI {
+	if kubeConfig == nil || kubeConfig.Host == "" {
+		return nil
+	}
+	host := kubeConfig.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		log.Warnf("failed to parse api server host %s: %s", kubeConfig.Host, err)
+		return nil
+	}
+	uri := &v1alpha1.URI{
+		Scheme: u.Scheme,
+		Host:   u.Hostname(),
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		uri.Port = int32(port)
+	}
+	return uri
+}
+
+// detectChanges returns the changes of the fields between the old and the new resource
+// the old resource of ADDED events and the new resource of DELETED events are empty.
+// Changes of fields whose new value is not the expected value are ignored.
+func detectChanges(eventType watch.EventType, oldObj, obj *unstructured.Unstructured, fieldChanges []v1alpha1.ResourceFieldChange) []fieldChange {
+	switch eventType {
+	case watch.Added:
+		oldObj = nil
+	case watch.Deleted:
+		oldObj, obj = obj, nil
+	}
+	oldJSON := marshalResource(oldObj)
+	newJSON := marshalResource(obj)
+
+	changes := make([]fieldChange, 0)
+	for _, fc := range fieldChanges {
+		oldVal := gjson.GetBytes(oldJSON, fc.Path)
+		newVal := gjson.GetBytes(newJSON, fc.Path)
+		if oldVal.Exists() == newVal.Exists() && oldVal.Raw == newVal.Raw {
+			continue
+		}
+		if fc.Value != "" && (!newVal.Exists() || newVal.String() != fc.Value) {
+			continue
+		}
+		changes = append(changes, fieldChange{
+			Path: fc.Path,
+			Old:  rawValue(oldVal),
+			New:  rawValue(newVal),
+		})
+	}
+	return changes
+}
+
+// marshalResource returns the JSON of the resource or an empty JSON object if the resource is nil
+func marshalResource(obj *unstructured.Unstructured) []byte {
+	if obj == nil {
+		return []byte("{}")
+	}
+	b, err := obj.MarshalJSON()
+	if err != nil {
+		return []byte("{}")
+	}
+	return b
+}
+
+// rawValue returns the raw JSON of the value or null if the value does not exist
+func rawValue(res gjson.Result) json.RawMessage {
+	if !res.Exists() {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(res.Raw)
+}
+
+func (r *resource) discoverResources(obj *v1alpha1.ResourceSignal) ([]watchedResource, error) {
 	dynClientPool := dynamic.NewDynamicClientPool(r.kubeConfig)
 	disco, err := discovery.NewDiscoveryClientForConfig(r.kubeConfig)
 	if err != nil {
@@ -105,7 +341,7 @@ func (r *resource) discoverResources(obj *v1alpha1.ResourceSignal) ([]dynamic.Re
 	if err != nil {
 		return nil, err
 	}
-	resources := make([]dynamic.ResourceInterface, 0)
+	resources := make([]watchedResource, 0)
 	for i := range resourceInterfaces.APIResources {
 		apiResource := resourceInterfaces.APIResources[i]
 		gvk := schema.FromAPIVersionAndKind(resourceInterfaces.GroupVersion, apiResource.Kind)
@@ -125,44 +361,106 @@ func (r *resource) discoverResources(obj *v1alpha1.ResourceSignal) ([]dynamic.Re
 			if err != nil {
 				return nil, err
 			}
-			resources = append(resources, client.Resource(&apiResource, obj.Namespace))
+			for _, namespace := range resolveNamespaces(obj, apiResource.Namespaced) {
+				res := watchedResource{
+					key:       resourceKey(resourceInterfaces.GroupVersion, apiResource.Name, namespace),
+					client:    client.Resource(&apiResource, namespace),
+					name:      apiResource.Name,
+					namespace: namespace,
+				}
+				if err := checkAccess(res); err != nil {
+					return nil, err
+				}
+				resources = append(resources, res)
+			}
 		}
 	}
 	return resources, nil
 }
 
-func resolveGroupVersion(obj *v1alpha1.ResourceSignal) string {
-	if obj.Version == "v1" {
-		return obj.Version
+// watchNamespaces adds a handler to the shared informer of the namespaces matching the label selector
+// returns the key of the informer and the handler.
+func (r *resource) watchNamespaces(selector string, done <-chan struct{}) (string, *resourceHandler, error) {
+	if _, err := labels.Parse(selector); err != nil {
+		return "", nil, fmt.Errorf("failed to parse namespace selector %s. Cause: %+v", selector, err.Error())
 	}
-	return obj.Group + "/" + obj.Version
+	dynClientPool := dynamic.NewDynamicClientPool(r.kubeConfig)
+	client, err := dynClientPool.ClientForGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"})
+	if err != nil {
+		return "", nil, err
+	}
+	res := watchedResource{
+		key:    resourceKey("v1", "namespaces", metav1.NamespaceAll),
+		client: client.Resource(&metav1.APIResource{Name: "namespaces", Kind: "Namespace"}, metav1.NamespaceAll),
+		name:   "namespaces",
+	}
+	if err := checkAccess(res); err != nil {
+		return "", nil, err
+	}
+	key := res.key + "?labelSelector=" + selector
+	h := r.informers.addHandler(key, res.client, selector, true, func(watch.EventType, *unstructured.Unstructured, *unstructured.Unstructured) {})
+	return key, h, nil
 }
 
-func (r *resource) listen(events chan *v1alpha1.Event, w watch.Interface, filter *v1alpha1.ResourceFilter, wg *sync.WaitGroup) {
-	for item := range w.ResultChan() {
-		itemObj := item.Object.(*unstructured.Unstructured)
-		b, _ := itemObj.MarshalJSON()
-		event := &v1alpha1.Event{
-			Context: v1alpha1.EventContext{
-				EventTime: metav1.Time{Time: time.Now().UTC()},
-			},
-			Data: b,
-		}
+// resolveNamespaces returns the namespaces in which the resources are watched
+// all namespaces are watched for cluster-scoped resources and for namespace selectors which are evaluated client side.
+func resolveNamespaces(obj *v1alpha1.ResourceSignal, namespaced bool) []string {
+	if !namespaced || obj.NamespaceSelector != "" {
+		return []string{metav1.NamespaceAll}
+	}
+	if len(obj.Namespaces) > 0 {
+		return obj.Namespaces
+	}
+	return []string{obj.Namespace}
+}
 
-		if item.Type == watch.Error {
-			err := errors.FromObject(item.Object)
-			log.Panic(err)
-		}
+// resourceKey returns the key of the resources of the namespace
+func resourceKey(groupVersion, name, namespace string) string {
+	if namespace == metav1.NamespaceAll {
+		return fmt.Sprintf("%s/%s", groupVersion, name)
+	}
+	return fmt.Sprintf("%s/%s/namespaces/%s", groupVersion, name, namespace)
+}
 
-		if passFilters(itemObj, filter) {
-			events <- event
-		}
+// checkAccess checks the resources can be listed in order to report RBAC errors up front
+// instead of the informers retrying to list the resources.
+func checkAccess(res watchedResource) error {
+	scope := "all namespaces"
+	if res.namespace != metav1.NamespaceAll {
+		scope = "namespace " + res.namespace
+	}
+	_, err := res.client.List(metav1.ListOptions{Limit: 1})
+	if err == nil {
+		return nil
+	}
+	if errors.IsForbidden(err) {
+		return fmt.Errorf("failed to list %s in %s: the service account is not allowed to list and watch %s in %s, check its RBAC roles. Cause: %+v", res.name, scope, res.name, scope, err)
+	}
+	return fmt.Errorf("failed to list %s in %s. Cause: %+v", res.name, scope, err)
+}
+
+func resolveGroupVersion(obj *v1alpha1.ResourceSignal) string {
+	if obj.Version == "v1" {
+		return obj.Version
 	}
-	wg.Done()
+	return obj.Group + "/" + obj.Version
 }
 
 // helper method to return a flag indicating if the object passed the client side filters
-func passFilters(obj *unstructured.Unstructured, filter *v1alpha1.ResourceFilter) bool {
+func passFilters(obj *unstructured.Unstructured, eventType watch.EventType, filter *v1alpha1.ResourceFilter, fieldSelector fields.Selector) bool {
+	if filter == nil {
+		return true
+	}
+	// check event type
+	if !checkEventType(filter.EventTypes, eventType) {
+		log.Printf("FILTERED: resource event type '%s' does not match event types '%s'", eventType, filter.EventTypes)
+		return false
+	}
+	// check fields
+	if !checkFields(fieldSelector, obj) {
+		log.Printf("FILTERED: resource fields do not match field selector '%s'", fieldSelector)
+		return false
+	}
 	// check prefix
 	if !strings.HasPrefix(obj.GetName(), filter.Prefix) {
 		log.Printf("FILTERED: resource name '%s' does not match prefix '%s'", obj.GetName(), filter.Prefix)
@@ -187,6 +485,34 @@ func passFilters(obj *unstructured.Unstructured, filter *v1alpha1.ResourceFilter
 	return true
 }
 
+// utility method to check the event type is one of the expected event types
+func checkEventType(expected []v1alpha1.ResourceEventType, actual watch.EventType) bool {
+	if len(expected) == 0 {
+		return true
+	}
+	for _, eventType := range expected {
+		if string(eventType) == string(actual) {
+			return true
+		}
+	}
+	return false
+}
+
+// utility method to check the fields of the object match the field selector
+func checkFields(selector fields.Selector, obj *unstructured.Unstructured) bool {
+	if selector == nil || selector.Empty() {
+		return true
+	}
+	set := fields.Set{}
+	for _, req := range selector.Requirements() {
+		val, ok, err := unstructured.NestedFieldCopy(obj.Object, strings.Split(req.Field, ".")...)
+		if err == nil && ok && val != nil {
+			set[req.Field] = fmt.Sprint(val)
+		}
+	}
+	return selector.Matches(set)
+}
+
 // utility method to check the actual map matches the expected by values
 func checkMap(expected, actual map[string]string) bool {
 	if actual != nil {
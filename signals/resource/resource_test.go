@@ -0,0 +1,380 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+func TestPassFilters(t *testing.T) {
+	pod := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":      "workflow-1234",
+				"namespace": "default",
+				"labels": map[string]interface{}{
+					"app": "workflow",
+				},
+			},
+			"status": map[string]interface{}{
+				"phase": "Succeeded",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		eventType     watch.EventType
+		filter        *v1alpha1.ResourceFilter
+		fieldSelector string
+		want          bool
+	}{
+		{"no filter", watch.Added, nil, "", true},
+		{"empty filter", watch.Modified, &v1alpha1.ResourceFilter{}, "", true},
+		{"matching event type", watch.Deleted, &v1alpha1.ResourceFilter{EventTypes: []v1alpha1.ResourceEventType{v1alpha1.ResourceEventTypeDeleted}}, "", true},
+		{"other event type", watch.Added, &v1alpha1.ResourceFilter{EventTypes: []v1alpha1.ResourceEventType{v1alpha1.ResourceEventTypeDeleted}}, "", false},
+		{"matching fields", watch.Modified, &v1alpha1.ResourceFilter{}, "status.phase=Succeeded,metadata.namespace=default", true},
+		{"other fields", watch.Modified, &v1alpha1.ResourceFilter{}, "status.phase=Failed", false},
+		{"missing field", watch.Modified, &v1alpha1.ResourceFilter{}, "spec.nodeName!=node-1", true},
+		{"matching prefix and labels", watch.Added, &v1alpha1.ResourceFilter{Prefix: "workflow", Labels: map[string]string{"app": "workflow"}}, "", true},
+		{"other prefix", watch.Added, &v1alpha1.ResourceFilter{Prefix: "job"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := fields.ParseSelector(tt.fieldSelector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := passFilters(pod, tt.eventType, tt.filter, selector); got != tt.want {
+				t.Errorf("passFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeResource is a dynamic.ResourceInterface which lists the items and serves the watches of the test
+type fakeResource struct {
+	dynamic.ResourceInterface
+	mu      sync.Mutex
+	items   []unstructured.Unstructured
+	lists   int
+	watches chan *watch.FakeWatcher
+	listErr error
+}
+
+func (f *fakeResource) List(opts metav1.ListOptions) (runtime.Object, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lists++
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	list := &unstructured.UnstructuredList{Items: f.items}
+	list.SetResourceVersion("1")
+	return list, nil
+}
+
+func (f *fakeResource) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	w := watch.NewFake()
+	f.watches <- w
+	return w, nil
+}
+
+func newPod(name, uid, resourceVersion string) *unstructured.Unstructured {
+	pod := &unstructured.Unstructured{}
+	pod.SetAPIVersion("v1")
+	pod.SetKind("Pod")
+	pod.SetNamespace("default")
+	pod.SetName(name)
+	pod.SetUID(types.UID(uid))
+	pod.SetResourceVersion(resourceVersion)
+	return pod
+}
+
+func TestInformerCache(t *testing.T) {
+	client := &fakeResource{
+		items:   []unstructured.Unstructured{*newPod("existing", "1", "1")},
+		watches: make(chan *watch.FakeWatcher, 10),
+	}
+	informers := newInformerCache()
+	handled := make(chan string, 10)
+	h := informers.addHandler("pods", client, "", false, func(eventType watch.EventType, oldObj, obj *unstructured.Unstructured) {
+		handled <- fmt.Sprintf("%s %s", eventType, obj.GetName())
+	})
+
+	expect := func(expected string) {
+		select {
+		case actual := <-handled:
+			if actual != expected {
+				t.Errorf("handled event\nexpected: %s\nactual: %s", expected, actual)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected event '%s' but found none", expected)
+		}
+	}
+
+	w := <-client.watches
+	w.Add(newPod("new", "2", "2"))
+	expect("ADDED new")
+	w.Modify(newPod("existing", "1", "3"))
+	expect("MODIFIED existing")
+
+	// the informer re-lists the resources when the resource version of the watch is too old
+	client.mu.Lock()
+	client.items = []unstructured.Unstructured{*newPod("existing", "1", "3"), *newPod("new", "2", "2")}
+	client.mu.Unlock()
+	w.Error(&metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusGone,
+		Reason:  metav1.StatusReasonExpired,
+		Message: "too old resource version",
+	})
+	w = <-client.watches
+	w.Delete(newPod("new", "2", "4"))
+	expect("DELETED new")
+
+	client.mu.Lock()
+	if client.lists < 2 {
+		t.Errorf("expected the resources to be re-listed")
+	}
+	client.mu.Unlock()
+
+	informers.removeHandler("pods", h)
+	if len(informers.informers) != 0 {
+		t.Errorf("expected the informer to be stopped")
+	}
+}
+
+func TestDetectChanges(t *testing.T) {
+	running := newPod("workflow", "1", "1")
+	_ = unstructured.SetNestedField(running.Object, "Running", "status", "phase")
+	_ = unstructured.SetNestedField(running.Object, int64(1), "spec", "replicas")
+	failed := running.DeepCopy()
+	_ = unstructured.SetNestedField(failed.Object, "Failed", "status", "phase")
+
+	tests := []struct {
+		name         string
+		eventType    watch.EventType
+		oldObj       *unstructured.Unstructured
+		obj          *unstructured.Unstructured
+		fieldChanges []v1alpha1.ResourceFieldChange
+		want         []string
+	}{
+		{
+			name:         "changed field",
+			eventType:    watch.Modified,
+			oldObj:       running,
+			obj:          failed,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "status.phase"}, {Path: "spec.replicas"}},
+			want:         []string{`status.phase "Running" "Failed"`},
+		},
+		{
+			name:         "changed field with expected value",
+			eventType:    watch.Modified,
+			oldObj:       running,
+			obj:          failed,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "status.phase", Value: "Failed"}},
+			want:         []string{`status.phase "Running" "Failed"`},
+		},
+		{
+			name:         "changed field with unexpected value",
+			eventType:    watch.Modified,
+			oldObj:       failed,
+			obj:          running,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "status.phase", Value: "Failed"}},
+			want:         []string{},
+		},
+		{
+			name:         "unchanged field",
+			eventType:    watch.Modified,
+			oldObj:       running,
+			obj:          failed,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "spec.replicas"}},
+			want:         []string{},
+		},
+		{
+			name:         "added resource",
+			eventType:    watch.Added,
+			obj:          running,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "spec.replicas"}},
+			want:         []string{`spec.replicas null 1`},
+		},
+		{
+			name:         "deleted resource",
+			eventType:    watch.Deleted,
+			obj:          failed,
+			fieldChanges: []v1alpha1.ResourceFieldChange{{Path: "status.phase"}},
+			want:         []string{`status.phase "Failed" null`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := detectChanges(tt.eventType, tt.oldObj, tt.obj, tt.fieldChanges)
+			got := make([]string, len(changes))
+			for i, c := range changes {
+				got[i] = fmt.Sprintf("%s %s %s", c.Path, c.Old, c.New)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEventWithChanges(t *testing.T) {
+	pod := newPod("workflow", "1", "1")
+	event, err := newEvent(&v1alpha1.ResourceSignal{}, nil, watch.Modified, pod, []fieldChange{{Path: "status.phase", Old: []byte(`"Running"`), New: []byte(`"Failed"`)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data changeEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Changes) != 1 || string(data.Changes[0].New) != `"Failed"` {
+		t.Errorf("unexpected changes %s", event.Data)
+	}
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data.Object); err != nil || obj.GetName() != "workflow" {
+		t.Errorf("unexpected object %s", data.Object)
+	}
+}
+
+func TestResolveNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		signal     v1alpha1.ResourceSignal
+		namespaced bool
+		want       []string
+	}{
+		{"namespace", v1alpha1.ResourceSignal{Namespace: "default"}, true, []string{"default"}},
+		{"all namespaces", v1alpha1.ResourceSignal{}, true, []string{""}},
+		{"namespaces", v1alpha1.ResourceSignal{Namespaces: []string{"a", "b"}}, true, []string{"a", "b"}},
+		{"namespace selector", v1alpha1.ResourceSignal{NamespaceSelector: "team=data"}, true, []string{""}},
+		{"cluster-scoped", v1alpha1.ResourceSignal{Namespace: "default"}, false, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveNamespaces(&tt.signal, tt.namespaced); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveNamespaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckAccess(t *testing.T) {
+	res := watchedResource{
+		key:       resourceKey("v1", "pods", "default"),
+		client:    &fakeResource{},
+		name:      "pods",
+		namespace: "default",
+	}
+	if err := checkAccess(res); err != nil {
+		t.Errorf("expected access to pods, got %s", err)
+	}
+
+	res.client = &fakeResource{listErr: errors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", fmt.Errorf("RBAC: access denied"))}
+	err := checkAccess(res)
+	if err == nil || !strings.Contains(err.Error(), "not allowed to list and watch pods in namespace default") {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestNewEventNamespace(t *testing.T) {
+	event, err := newEvent(&v1alpha1.ResourceSignal{}, nil, watch.Added, newPod("workflow", "1", "1"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns := event.Context.Extensions[ContextExtensionNamespaceKey]; ns != "default" {
+		t.Errorf("expected namespace extension 'default', got '%s'", ns)
+	}
+
+	node := &unstructured.Unstructured{}
+	node.SetName("node-1")
+	event, err = newEvent(&v1alpha1.ResourceSignal{}, nil, watch.Added, node, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := event.Context.Extensions[ContextExtensionNamespaceKey]; ok {
+		t.Errorf("expected no namespace extension for cluster-scoped resources")
+	}
+}
+
+func TestNewEventContext(t *testing.T) {
+	pod := newPod("workflow", "1234", "42")
+	pod.SetSelfLink("/api/v1/namespaces/default/pods/workflow")
+	pod.SetLabels(map[string]string{"app": "workflow"})
+	pod.SetAnnotations(map[string]string{"owner": "data"})
+	signal := &v1alpha1.ResourceSignal{IncludeLabels: true, IncludeAnnotations: true}
+	source := apiServerURI(&rest.Config{Host: "https://10.0.0.1:6443"})
+
+	event, err := newEvent(signal, source, watch.Modified, pod, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := event.Context
+	if ctx.EventType != "io.k8s.core.pod.modified" {
+		t.Errorf("unexpected event type %s", ctx.EventType)
+	}
+	if ctx.EventTypeVersion != "v1" {
+		t.Errorf("unexpected event type version %s", ctx.EventTypeVersion)
+	}
+	if ctx.EventID != "1234-42" {
+		t.Errorf("unexpected event id %s", ctx.EventID)
+	}
+	if ctx.ContentType != "application/json" {
+		t.Errorf("unexpected content type %s", ctx.ContentType)
+	}
+	expectedSource := v1alpha1.URI{Scheme: "https", Host: "10.0.0.1", Port: 6443, Path: "/api/v1/namespaces/default/pods/workflow"}
+	if ctx.Source == nil || *ctx.Source != expectedSource {
+		t.Errorf("unexpected source %v", ctx.Source)
+	}
+	if source.Path != "" {
+		t.Errorf("expected the api server source to be unmodified")
+	}
+	if ctx.Extensions["label.app"] != "workflow" || ctx.Extensions["annotation.owner"] != "data" {
+		t.Errorf("expected labels and annotations in extensions, got %v", ctx.Extensions)
+	}
+
+	workflow := &unstructured.Unstructured{}
+	workflow.SetAPIVersion("argoproj.io/v1alpha1")
+	workflow.SetKind("Workflow")
+	if eventType := resolveEventType(workflow.GroupVersionKind(), watch.Added); eventType != "io.k8s.argoproj.io.workflow.added" {
+		t.Errorf("unexpected event type %s", eventType)
+	}
+}
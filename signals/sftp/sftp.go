@@ -0,0 +1,337 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sftp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	pkgsftp "github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EventType is the event type of the events of new and changed files
+	EventType = "com.github.argoproj.sftp"
+
+	// DefaultInterval is the default duration between polls of the directory
+	DefaultInterval = time.Minute
+
+	// the default port of the SFTP servers
+	defaultPort = "22"
+
+	// the timeout of the connections to the SFTP servers
+	dialTimeout = 30 * time.Second
+)
+
+// eventData is the data of the event of a new or changed file
+type eventData struct {
+	Name    string    `json:"name"`
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mtime"`
+	// ArchivePath is the path the file is moved to once the event is emitted
+	ArchivePath string `json:"archivePath,omitempty"`
+}
+
+// sftpSignal polls the directories of SFTP servers
+// Listen() methods CAN retrieve the kubeClient from the sftpSignal struct.
+type sftpSignal struct {
+	kubeClient kubernetes.Interface
+}
+
+// New creates a new sftp signal
+// the kubeClient is used to retrieve the password or private key of the servers
+func New(kubeClient kubernetes.Interface) sdk.Listener {
+	return &sftpSignal{kubeClient: kubeClient}
+}
+
+func (s *sftpSignal) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	interval := DefaultInterval
+	if signal.SFTP.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(signal.SFTP.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval %s. Cause: %+v", signal.SFTP.Interval, err.Error())
+		}
+	}
+	config, err := s.clientConfig(signal.SFTP)
+	if err != nil {
+		return nil, err
+	}
+	p := newPoller(signal.SFTP, config)
+
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		defer p.close()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			files, err := p.poll()
+			if err != nil {
+				log.Warnf("failed to poll sftp directory %s%s: %s", p.addr, signal.SFTP.Directory, err)
+			}
+			for _, file := range files {
+				event, err := p.newEvent(file)
+				if err != nil {
+					log.Warnf("failed to create event of sftp file %s: %s", file.Name(), err)
+					continue
+				}
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+				if signal.SFTP.ArchiveDirectory != "" {
+					if err := p.archive(file.Name()); err != nil {
+						log.Warnf("failed to archive sftp file %s: %s", file.Name(), err)
+					}
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+	log.Printf("signal '%s' polling sftp directory %s%s every %s...", signal.Name, p.addr, signal.SFTP.Directory, interval)
+	return events, nil
+}
+
+// clientConfig resolves the configuration of the SSH connections from the secrets and the host key of the sftp signal
+func (s *sftpSignal) clientConfig(signal *v1alpha1.SFTPSignal) (*ssh.ClientConfig, error) {
+	if s.kubeClient == nil {
+		return nil, fmt.Errorf("failed to retrieve sftp credentials: kubernetes client is not configured")
+	}
+	config := &ssh.ClientConfig{
+		User:    signal.Username,
+		Timeout: dialTimeout,
+	}
+	if signal.PrivateKey != nil {
+		key, err := store.GetSecrets(s.kubeClient, common.DefaultSensorControllerNamespace, signal.PrivateKey.Name, signal.PrivateKey.Key)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ssh.ParsePrivateKey([]byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sftp private key. Cause: %+v", err.Error())
+		}
+		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+	}
+	if signal.Password != nil {
+		password, err := store.GetSecrets(s.kubeClient, common.DefaultSensorControllerNamespace, signal.Password.Name, signal.Password.Key)
+		if err != nil {
+			return nil, err
+		}
+		config.Auth = append(config.Auth, ssh.Password(password))
+	}
+	if len(config.Auth) == 0 {
+		return nil, fmt.Errorf("failed to authenticate with sftp server: one of password and privateKey must be specified")
+	}
+	switch {
+	case signal.HostKey != "":
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(signal.HostKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sftp host key. Cause: %+v", err.Error())
+		}
+		config.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	case signal.InsecureIgnoreHostKey:
+		config.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+	default:
+		return nil, fmt.Errorf("failed to verify sftp host key: hostKey must be specified unless insecureIgnoreHostKey is set")
+	}
+	return config, nil
+}
+
+// poller polls the files of a directory of an SFTP server
+// the connection to the server is kept between polls and re-established on the next poll after failures.
+type poller struct {
+	signal *v1alpha1.SFTPSignal
+	config *ssh.ClientConfig
+	addr   string
+	source *v1alpha1.URI
+
+	conn   *ssh.Client
+	client *pkgsftp.Client
+
+	// files are the fingerprints of the files of the latest poll by name
+	files map[string]string
+	// emitted are the fingerprints of the files whose events were emitted by name
+	emitted map[string]string
+}
+
+func newPoller(signal *v1alpha1.SFTPSignal, config *ssh.ClientConfig) *poller {
+	addr := signal.Address
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, defaultPort)
+	}
+	host, port, _ := net.SplitHostPort(addr)
+	source := &v1alpha1.URI{Scheme: "sftp", Host: host, Path: signal.Directory}
+	if p, err := strconv.ParseInt(port, 10, 32); err == nil {
+		source.Port = int32(p)
+	}
+	return &poller{
+		signal:  signal,
+		config:  config,
+		addr:    addr,
+		source:  source,
+		files:   make(map[string]string),
+		emitted: make(map[string]string),
+	}
+}
+
+// connect connects to the SFTP server
+func (p *poller) connect() error {
+	conn, err := ssh.Dial("tcp", p.addr, p.config)
+	if err != nil {
+		return fmt.Errorf("failed to connect to sftp server %s. Cause: %+v", p.addr, err)
+	}
+	client, err := pkgsftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start sftp session with %s. Cause: %+v", p.addr, err)
+	}
+	p.conn = conn
+	p.client = client
+	return nil
+}
+
+func (p *poller) close() {
+	if p.client != nil {
+		p.client.Close()
+		p.conn.Close()
+		p.client = nil
+		p.conn = nil
+	}
+}
+
+// poll returns the files matching the patterns which are new or whose size or modification time changed
+// since their events were emitted, ordered by their modification time.
+// the files are only returned once they are unchanged since the previous poll, so that files which are still
+// being uploaded are neither emitted nor archived.
+func (p *poller) poll() ([]os.FileInfo, error) {
+	if p.client == nil {
+		if err := p.connect(); err != nil {
+			return nil, err
+		}
+	}
+	infos, err := p.client.ReadDir(p.signal.Directory)
+	if err != nil {
+		p.close()
+		return nil, err
+	}
+	files := make(map[string]string, len(infos))
+	var changed []os.FileInfo
+	for _, info := range infos {
+		if !info.Mode().IsRegular() || !p.matches(info.Name()) {
+			continue
+		}
+		fingerprint := fmt.Sprintf("size:%d-modified:%d", info.Size(), info.ModTime().UnixNano())
+		files[info.Name()] = fingerprint
+		if p.files[info.Name()] == fingerprint && p.emitted[info.Name()] != fingerprint {
+			p.emitted[info.Name()] = fingerprint
+			changed = append(changed, info)
+		}
+	}
+	p.files = files
+	for name := range p.emitted {
+		if _, ok := files[name]; !ok {
+			delete(p.emitted, name)
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		if changed[i].ModTime().Equal(changed[j].ModTime()) {
+			return changed[i].Name() < changed[j].Name()
+		}
+		return changed[i].ModTime().Before(changed[j].ModTime())
+	})
+	return changed, nil
+}
+
+// matches returns true if the name of the file matches one of the patterns or if there are no patterns
+func (p *poller) matches(name string) bool {
+	if len(p.signal.Patterns) == 0 {
+		return true
+	}
+	for _, pattern := range p.signal.Patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// archive moves the file to the archive directory
+// the file replaces an existing file of the same name if the server supports the posix-rename extension.
+func (p *poller) archive(name string) error {
+	if p.client == nil {
+		return fmt.Errorf("not connected to sftp server %s", p.addr)
+	}
+	oldPath := path.Join(p.signal.Directory, name)
+	newPath := path.Join(p.signal.ArchiveDirectory, name)
+	if err := p.client.PosixRename(oldPath, newPath); err != nil {
+		if err := p.client.Rename(oldPath, newPath); err != nil {
+			return err
+		}
+	}
+	delete(p.files, name)
+	delete(p.emitted, name)
+	return nil
+}
+
+func (p *poller) newEvent(file os.FileInfo) (*v1alpha1.Event, error) {
+	data := eventData{
+		Name:    file.Name(),
+		Path:    path.Join(p.signal.Directory, file.Name()),
+		Size:    file.Size(),
+		ModTime: file.ModTime().UTC(),
+	}
+	if p.signal.ArchiveDirectory != "" {
+		data.ArchivePath = path.Join(p.signal.ArchiveDirectory, file.Name())
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%s", data.Path, p.files[file.Name()]),
+			EventTime:          metav1.Time{Time: data.ModTime},
+			Source:             p.source,
+			ContentType:        "application/json",
+		},
+		Data: b,
+	}, nil
+}
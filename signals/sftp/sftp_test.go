@@ -0,0 +1,367 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sftp
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	pkgsftp "github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// testServer is an in-process SFTP server serving the local file system
+type testServer struct {
+	addr       string
+	hostKey    ssh.PublicKey
+	privateKey []byte
+}
+
+func newTestServer(t *testing.T) *testServer {
+	hostSigner := newSigner(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == "argo" && string(password) == "secret" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid password for %s", conn.User())
+		},
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() == "argo" && bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown public key for %s", conn.User())
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn, config)
+		}
+	}()
+	return &testServer{
+		addr:       l.Addr().String(),
+		hostKey:    hostSigner.PublicKey(),
+		privateKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+	}
+}
+
+func newSigner(t *testing.T) ssh.Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// serveConn serves the sftp subsystem on the sessions of the connection
+func serveConn(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					go func() {
+						defer channel.Close()
+						server, err := pkgsftp.NewServer(channel)
+						if err != nil {
+							return
+						}
+						server.Serve()
+					}()
+				}
+			}
+		}()
+	}
+}
+
+func writeFile(t *testing.T, name, content string, modTime time.Time) {
+	if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(name, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func receive(t *testing.T, events <-chan *v1alpha1.Event) *eventData {
+	select {
+	case event := <-events:
+		var data eventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			t.Fatal(err)
+		}
+		if event.Context.EventType != EventType || !event.Context.EventTime.Time.Equal(data.ModTime) {
+			t.Errorf("unexpected event context %+v", event.Context)
+		}
+		return &data
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+		return nil
+	}
+}
+
+func TestListen(t *testing.T) {
+	server := newTestServer(t)
+	dir, err := ioutil.TempDir("", "sftp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	inbox, archive := filepath.Join(dir, "inbox"), filepath.Join(dir, "archive")
+	for _, d := range []string{inbox, archive} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sftp", Namespace: common.DefaultSensorControllerNamespace},
+		Data: map[string][]byte{
+			"password": []byte("secret"),
+			"key":      server.privateKey,
+		},
+	})
+	hostKey := string(ssh.MarshalAuthorizedKey(server.hostKey))
+	mtime := time.Date(2018, 10, 11, 22, 14, 15, 0, time.UTC)
+
+	t.Run("password with archive", func(t *testing.T) {
+		writeFile(t, filepath.Join(inbox, "report.csv"), "id,total\n1,42\n", mtime)
+		writeFile(t, filepath.Join(inbox, "notes.txt"), "ignored", mtime)
+		done := make(chan struct{})
+		defer close(done)
+		events, err := New(kubeClient).Listen(&v1alpha1.Signal{
+			Name: "reports",
+			SFTP: &v1alpha1.SFTPSignal{
+				Address:          server.addr,
+				Directory:        inbox,
+				Patterns:         []string{"*.csv"},
+				Username:         "argo",
+				Password:         &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "password"},
+				HostKey:          hostKey,
+				Interval:         "50ms",
+				ArchiveDirectory: archive,
+			},
+		}, done)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := receive(t, events)
+		expected := eventData{
+			Name:        "report.csv",
+			Path:        filepath.Join(inbox, "report.csv"),
+			Size:        14,
+			ModTime:     mtime,
+			ArchivePath: filepath.Join(archive, "report.csv"),
+		}
+		if *data != expected {
+			t.Errorf("expected event data %+v but found %+v", expected, *data)
+		}
+		// the file is archived once the event is received and the next event is the one of the next file
+		writeFile(t, filepath.Join(inbox, "invoices.csv"), "id\n", mtime.Add(time.Hour))
+		if data := receive(t, events); data.Name != "invoices.csv" {
+			t.Errorf("expected the event of the next file but found %+v", data)
+		}
+		if _, err := os.Stat(filepath.Join(archive, "report.csv")); err != nil {
+			t.Errorf("expected the file to be archived: %s", err)
+		}
+		if _, err := os.Stat(filepath.Join(inbox, "notes.txt")); err != nil {
+			t.Errorf("expected the file which does not match to be left in place: %s", err)
+		}
+	})
+
+	t.Run("private key with changes", func(t *testing.T) {
+		done := make(chan struct{})
+		defer close(done)
+		events, err := New(kubeClient).Listen(&v1alpha1.Signal{
+			Name: "notes",
+			SFTP: &v1alpha1.SFTPSignal{
+				Address:    server.addr,
+				Directory:  inbox,
+				Patterns:   []string{"*.txt"},
+				Username:   "argo",
+				PrivateKey: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "key"},
+				HostKey:    hostKey,
+				Interval:   "50ms",
+			},
+		}, done)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data := receive(t, events); data.Name != "notes.txt" || data.Size != 7 || data.ArchivePath != "" {
+			t.Errorf("unexpected event data %+v", data)
+		}
+		writeFile(t, filepath.Join(inbox, "notes.txt"), "changed", mtime.Add(time.Minute))
+		if data := receive(t, events); data.Name != "notes.txt" || !data.ModTime.Equal(mtime.Add(time.Minute)) {
+			t.Errorf("expected the event of the changed file but found %+v", data)
+		}
+		select {
+		case event := <-events:
+			t.Errorf("unexpected event %s", event.Data)
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+
+	t.Run("file being uploaded", func(t *testing.T) {
+		upload := filepath.Join(dir, "upload")
+		if err := os.Mkdir(upload, 0755); err != nil {
+			t.Fatal(err)
+		}
+		signal := &v1alpha1.SFTPSignal{
+			Address:   server.addr,
+			Directory: upload,
+			Username:  "argo",
+			Password:  &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "password"},
+			HostKey:   hostKey,
+		}
+		config, err := New(kubeClient).(*sftpSignal).clientConfig(signal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := newPoller(signal, config)
+		defer p.close()
+		poll := func() []string {
+			files, err := p.poll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+			return names
+		}
+		// the file is only returned once it is unchanged between two polls
+		writeFile(t, filepath.Join(upload, "large.csv"), "id\n", mtime)
+		if names := poll(); len(names) != 0 {
+			t.Errorf("expected no files on the first sight of the file but found %v", names)
+		}
+		writeFile(t, filepath.Join(upload, "large.csv"), "id\n1\n", mtime.Add(time.Second))
+		if names := poll(); len(names) != 0 {
+			t.Errorf("expected no files while the file changes but found %v", names)
+		}
+		if names := poll(); !reflect.DeepEqual(names, []string{"large.csv"}) {
+			t.Errorf("expected the unchanged file but found %v", names)
+		}
+		if names := poll(); len(names) != 0 {
+			t.Errorf("expected the file to be returned once but found %v", names)
+		}
+	})
+
+	t.Run("unknown host key", func(t *testing.T) {
+		signal := &v1alpha1.SFTPSignal{
+			Address:   server.addr,
+			Directory: inbox,
+			Username:  "argo",
+			Password:  &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "password"},
+			HostKey:   string(ssh.MarshalAuthorizedKey(newSigner(t).PublicKey())),
+		}
+		config, err := New(kubeClient).(*sftpSignal).clientConfig(signal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := newPoller(signal, config).poll(); err == nil {
+			t.Error("expected an error for the unknown host key")
+		}
+	})
+}
+
+func TestClientConfig(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sftp", Namespace: common.DefaultSensorControllerNamespace},
+		Data:       map[string][]byte{"key": []byte("invalid")},
+	})
+	s := New(kubeClient).(*sftpSignal)
+	invalid := []*v1alpha1.SFTPSignal{
+		{Username: "argo", PrivateKey: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "key"}, InsecureIgnoreHostKey: true},
+		{Username: "argo", Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "sftp"}, Key: "unknown"}, InsecureIgnoreHostKey: true},
+		{Username: "argo", InsecureIgnoreHostKey: true},
+	}
+	for _, signal := range invalid {
+		if _, err := s.clientConfig(signal); err == nil {
+			t.Errorf("expected an error for signal %+v", signal)
+		}
+	}
+}
+
+func TestNewPoller(t *testing.T) {
+	p := newPoller(&v1alpha1.SFTPSignal{Address: "sftp.partner.com", Directory: "/outgoing"}, nil)
+	if p.addr != "sftp.partner.com:22" || p.source.Host != "sftp.partner.com" || p.source.Port != 22 || p.source.Path != "/outgoing" {
+		t.Errorf("unexpected address %s and source %+v", p.addr, p.source)
+	}
+	p = newPoller(&v1alpha1.SFTPSignal{Address: "sftp.partner.com:2222"}, nil)
+	if p.addr != "sftp.partner.com:2222" || p.source.Port != 2222 {
+		t.Errorf("unexpected address %s and source %+v", p.addr, p.source)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smtp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path"
+	"strings"
+)
+
+// email is a parsed email message
+type email struct {
+	header mail.Header
+
+	// text is the text body of the message, the HTML body is used if the message has no plain text body
+	text        []byte
+	contentType string
+
+	attachments []attachment
+}
+
+// attachment is an attachment of a message
+type attachment struct {
+	filename    string
+	contentType string
+	data        []byte
+}
+
+// parseEmail parses the headers, the body and the attachments of the message
+func parseEmail(b []byte) (*email, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	e := &email{header: msg.Header}
+	var html []byte
+	if err := e.walk(textproto.MIMEHeader(msg.Header), msg.Body, &html); err != nil {
+		return nil, err
+	}
+	switch {
+	case e.contentType != "":
+	case html != nil:
+		e.text, e.contentType = html, "text/html"
+	default:
+		e.contentType = "text/plain"
+	}
+	return e, nil
+}
+
+// walk walks the parts of a multipart body, the first plain text and HTML parts which are not attachments are the bodies
+// of the message and the parts with a file name are its attachments.
+func (e *email) walk(header textproto.MIMEHeader, body io.Reader, html *[]byte) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read part of %s body: %s", mediaType, err)
+			}
+			if err := e.walk(part.Header, part, html); err != nil {
+				return err
+			}
+		}
+	}
+	data, err := ioutil.ReadAll(decode(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return fmt.Errorf("failed to decode %s part: %s", mediaType, err)
+	}
+	disposition, dparams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dparams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	switch {
+	case disposition == "attachment" || filename != "":
+		e.attachments = append(e.attachments, attachment{filename: sanitizeFilename(filename), contentType: mediaType, data: data})
+	case mediaType == "text/plain" && e.contentType == "":
+		e.text, e.contentType = data, mediaType
+	case mediaType == "text/html" && *html == nil:
+		*html = data
+	}
+	return nil
+}
+
+// decode decodes the body with its content transfer encoding
+// the quoted-printable parts of multipart bodies are decoded by the multipart reader.
+func decode(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// sanitizeFilename returns the base name of the file name of an attachment, which is used in the key of its object
+func sanitizeFilename(filename string) string {
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	filename = path.Base(strings.Replace(filename, "\\", "/", -1))
+	if filename == "." || filename == "/" || filename == ".." {
+		return ""
+	}
+	return filename
+}
+
+// headers returns the decoded values of the headers of the message by their lower case names
+// the values of repeated headers are joined with a comma.
+func (e *email) headers() map[string]string {
+	dec := new(mime.WordDecoder)
+	headers := make(map[string]string, len(e.header))
+	for name, values := range e.header {
+		decoded := make([]string, len(values))
+		for i, value := range values {
+			if d, err := dec.DecodeHeader(value); err == nil {
+				value = d
+			}
+			decoded[i] = value
+		}
+		headers[strings.ToLower(name)] = strings.Join(decoded, ", ")
+	}
+	return headers
+}
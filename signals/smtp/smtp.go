@@ -0,0 +1,495 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smtp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/store"
+	minio "github.com/minio/minio-go"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EventType is the event type of the events of the messages
+	EventType = "com.github.argoproj.smtp"
+
+	// ContextExtensionHeaderPrefix is the prefix of the event context extension keys of the headers of the message,
+	// the names of the headers are in lower case, e.g. header.subject
+	ContextExtensionHeaderPrefix = "header."
+
+	// ContextExtensionSenderKey is the event context extension key of the envelope sender of the message
+	ContextExtensionSenderKey = "sender"
+
+	// ContextExtensionRecipientsKey is the event context extension key of the envelope recipients of the message
+	// which are recipients of the signal, separated by a comma
+	ContextExtensionRecipientsKey = "recipients"
+
+	// ContextExtensionAttachmentPrefix is the prefix of the event context extension keys of the keys of the objects of the
+	// uploaded attachments by their index, e.g. attachment.0
+	ContextExtensionAttachmentPrefix = "attachment."
+
+	// the maximum size of the messages, larger messages are rejected
+	maxMessageSize = 25 * 1024 * 1024
+
+	// the maximum number of recipients of a message
+	maxRecipients = 100
+
+	// the duration a session waits for a command or the data of a message before it is closed
+	commandTimeout = 5 * time.Minute
+)
+
+// uploader uploads the attachments of the messages, i.e. the minio client
+type uploader interface {
+	PutObject(bucket, object string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (int64, error)
+}
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// like webhooks, the smtp signals share one smtp server since the port is fixed at runtime.
+// The routes of the recipients of the listening signals are registered with the server and removed when the signals stop.
+type smtp struct {
+	kubeClient kubernetes.Interface
+	hostname   string
+
+	// routes are the routes of the listening signals by recipient address
+	routes sync.Map
+
+	// newUploader creates the uploader of the attachments of a signal
+	newUploader func(loc *v1alpha1.ArtifactLocation) (uploader, error)
+}
+
+// route receives the messages of a signal
+type route struct {
+	signal   *v1alpha1.Signal
+	uploader uploader
+	stream   *common.EventStream
+}
+
+// New creates a new smtp listener serving the smtp server on the specified port
+// the kubeClient is used to retrieve the credentials of the S3 locations of the attachments.
+func New(kubeClient kubernetes.Interface, port int) sdk.Listener {
+	s := newSMTP(kubeClient)
+	go func() {
+		l, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
+		if err != nil {
+			log.Panicf("smtp server failed to listen: %v", err)
+		}
+		log.Printf("starting smtp server listening on: %s", l.Addr())
+		if err := s.serve(l); err != nil {
+			log.Panicf("smtp server encountered error serving: %v", err)
+		}
+	}()
+	return s
+}
+
+func newSMTP(kubeClient kubernetes.Interface) *smtp {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	s := &smtp{kubeClient: kubeClient, hostname: hostname}
+	s.newUploader = s.newS3Uploader
+	return s
+}
+
+// newS3Uploader creates the minio client of the S3 location of the attachments
+func (s *smtp) newS3Uploader(loc *v1alpha1.ArtifactLocation) (uploader, error) {
+	if s.kubeClient == nil {
+		return nil, fmt.Errorf("failed to upload attachments: kubernetes client is not configured")
+	}
+	creds, err := store.GetCredentials(s.kubeClient, common.DefaultSensorControllerNamespace, loc)
+	if err != nil {
+		return nil, err
+	}
+	return store.NewMinioClient(loc.S3, *creds)
+}
+
+func (s *smtp) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	r := &route{signal: signal, stream: common.NewEventStream(done)}
+	if loc := signal.SMTP.Attachments; loc != nil {
+		if loc.S3 == nil {
+			return nil, fmt.Errorf("attachments must be an s3 location")
+		}
+		u, err := s.newUploader(loc)
+		if err != nil {
+			return nil, err
+		}
+		r.uploader = u
+	}
+	recipients := make([]string, 0, len(signal.SMTP.Recipients))
+	for _, recipient := range signal.SMTP.Recipients {
+		address, err := parseAddress(recipient)
+		if err != nil {
+			s.deleteRoutes(recipients)
+			return nil, fmt.Errorf("invalid recipient '%s'", recipient)
+		}
+		if existing, loaded := s.routes.LoadOrStore(address, r); loaded {
+			if existing != r {
+				s.deleteRoutes(recipients)
+				return nil, fmt.Errorf("recipient %s is already used by signal '%s'", address, existing.(*route).signal.Name)
+			}
+			continue
+		}
+		recipients = append(recipients, address)
+	}
+
+	go func() {
+		<-done
+		s.deleteRoutes(recipients)
+		r.stream.Close()
+		log.Printf("signal '%s' stopped listening for mail", signal.Name)
+	}()
+	log.Printf("signal '%s' listening for mail to %s...", signal.Name, strings.Join(recipients, ", "))
+	return r.stream.Events(), nil
+}
+
+func (s *smtp) deleteRoutes(recipients []string) {
+	for _, recipient := range recipients {
+		s.routes.Delete(recipient)
+	}
+}
+
+// serve accepts the connections of the listener and serves their sessions
+func (s *smtp) serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+// session is the state of the mail transaction of a connection
+type session struct {
+	helo       bool
+	sender     string
+	routes     []*route
+	recipients map[*route][]string
+	count      int
+}
+
+func (ss *session) reset() {
+	ss.sender = ""
+	ss.routes = nil
+	ss.recipients = nil
+	ss.count = 0
+}
+
+// handleConn serves the smtp session of the connection until it is closed
+// See https://tools.ietf.org/html/rfc5321#section-4.1
+func (s *smtp) handleConn(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) bool {
+		return text.PrintfLine(format, args...) == nil
+	}
+	if !reply("220 %s ESMTP argo-events", s.hostname) {
+		return
+	}
+	ss := &session{}
+	for {
+		conn.SetDeadline(time.Now().Add(commandTimeout))
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			verb, arg = line[:i], strings.TrimSpace(line[i+1:])
+		}
+		var ok bool
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			ss.reset()
+			ss.helo = true
+			ok = reply("250 %s", s.hostname)
+		case "EHLO":
+			ss.reset()
+			ss.helo = true
+			ok = reply("250-%s\r\n250-SIZE %d\r\n250 8BITMIME", s.hostname, maxMessageSize)
+		case "MAIL":
+			ok = s.mail(ss, arg, reply)
+		case "RCPT":
+			ok = s.rcpt(ss, arg, reply)
+		case "DATA":
+			ok = s.data(ss, conn, text, reply)
+		case "RSET":
+			ss.reset()
+			ok = reply("250 2.0.0 OK")
+		case "NOOP":
+			ok = reply("250 2.0.0 OK")
+		case "VRFY":
+			ok = reply("252 2.5.0 Cannot verify user")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			ok = reply("502 5.5.2 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// mail starts a mail transaction with the sender of the MAIL command, e.g. FROM:<billing@vendor.com> SIZE=1024
+func (s *smtp) mail(ss *session, arg string, reply func(string, ...interface{}) bool) bool {
+	if !ss.helo {
+		return reply("503 5.5.1 Send HELO or EHLO first")
+	}
+	if ss.sender != "" {
+		return reply("503 5.5.1 Sender already specified")
+	}
+	addr, params, ok := parsePath(arg, "FROM:")
+	if !ok {
+		return reply("501 5.5.4 Syntax: MAIL FROM:<address>")
+	}
+	for _, param := range params {
+		if strings.HasPrefix(strings.ToUpper(param), "SIZE=") {
+			if size, err := strconv.Atoi(param[len("SIZE="):]); err == nil && size > maxMessageSize {
+				return reply("552 5.3.4 Message size exceeds fixed limit")
+			}
+		}
+	}
+	sender := "<>"
+	if addr != "" {
+		address, err := parseAddress(addr)
+		if err != nil {
+			return reply("553 5.1.7 Invalid sender address")
+		}
+		sender = address
+	}
+	ss.sender = sender
+	return reply("250 2.1.0 OK")
+}
+
+// rcpt adds the recipient of the RCPT command to the mail transaction, e.g. TO:<reports@events.example.com>
+// the recipient must be a recipient of a listening signal which accepts the mail of the sender.
+func (s *smtp) rcpt(ss *session, arg string, reply func(string, ...interface{}) bool) bool {
+	if ss.sender == "" {
+		return reply("503 5.5.1 Send MAIL first")
+	}
+	addr, _, ok := parsePath(arg, "TO:")
+	if !ok || addr == "" {
+		return reply("501 5.5.4 Syntax: RCPT TO:<address>")
+	}
+	address, err := parseAddress(addr)
+	if err != nil {
+		return reply("553 5.1.3 Invalid recipient address")
+	}
+	value, ok := s.routes.Load(address)
+	if !ok {
+		return reply("550 5.1.1 Mailbox unavailable")
+	}
+	r := value.(*route)
+	if !allowed(r.signal.SMTP.Senders, ss.sender) {
+		log.Warnf("rejected mail of %s to %s: sender is not allowed by signal '%s'", ss.sender, address, r.signal.Name)
+		return reply("550 5.7.1 Sender is not allowed")
+	}
+	if ss.count >= maxRecipients {
+		return reply("452 4.5.3 Too many recipients")
+	}
+	if ss.recipients == nil {
+		ss.recipients = make(map[*route][]string)
+	}
+	if _, ok := ss.recipients[r]; !ok {
+		ss.routes = append(ss.routes, r)
+	}
+	ss.recipients[r] = append(ss.recipients[r], address)
+	ss.count++
+	return reply("250 2.1.5 OK")
+}
+
+// data reads the message of the mail transaction and delivers it to the signals of its recipients
+// the message is only accepted once it was delivered to all the signals.
+func (s *smtp) data(ss *session, conn net.Conn, text *textproto.Conn, reply func(string, ...interface{}) bool) bool {
+	if len(ss.routes) == 0 {
+		return reply("503 5.5.1 Send RCPT first")
+	}
+	if !reply("354 Start mail input; end with <CRLF>.<CRLF>") {
+		return false
+	}
+	dr := text.DotReader()
+	b, err := ioutil.ReadAll(io.LimitReader(dr, maxMessageSize+1))
+	if err != nil {
+		return false
+	}
+	defer ss.reset()
+	if len(b) > maxMessageSize {
+		// discard the remainder of the message
+		if _, err := io.Copy(ioutil.Discard, dr); err != nil {
+			return false
+		}
+		return reply("552 5.3.4 Message size exceeds fixed limit")
+	}
+	e, err := parseEmail(b)
+	if err != nil {
+		log.Warnf("received invalid message from %s: %s", ss.sender, err)
+		return reply("554 5.6.0 Invalid message: %s", err)
+	}
+	remote := conn.RemoteAddr()
+	for _, r := range ss.routes {
+		event, err := r.newEvent(e, ss.sender, ss.recipients[r], remote)
+		if err != nil {
+			log.Warnf("failed to create event of message from %s for signal '%s': %s", ss.sender, r.signal.Name, err)
+			return reply("451 4.3.0 Failed to process message")
+		}
+		if !r.stream.Send(event, nil) {
+			return reply("451 4.3.0 Recipient is no longer available")
+		}
+	}
+	return reply("250 2.0.0 OK")
+}
+
+// newEvent creates the event of the message and uploads its attachments
+func (r *route) newEvent(e *email, sender string, recipients []string, remote net.Addr) (*v1alpha1.Event, error) {
+	now := time.Now().UTC()
+	eventID := strings.Trim(e.header.Get("Message-Id"), "<> ")
+	if eventID == "" {
+		eventID = fmt.Sprintf("%s-%d", sender, now.UnixNano())
+	}
+	eventTime := now
+	if date, err := e.header.Date(); err == nil {
+		eventTime = date.UTC()
+	}
+	extensions := map[string]string{
+		ContextExtensionSenderKey:     sender,
+		ContextExtensionRecipientsKey: strings.Join(recipients, ","),
+	}
+	for name, value := range e.headers() {
+		extensions[ContextExtensionHeaderPrefix+name] = value
+	}
+	if r.uploader != nil {
+		keys, err := r.upload(eventID, e.attachments)
+		if err != nil {
+			return nil, err
+		}
+		for i, key := range keys {
+			extensions[ContextExtensionAttachmentPrefix+strconv.Itoa(i)] = key
+		}
+	}
+	source := &v1alpha1.URI{Scheme: "smtp"}
+	if host, _, err := net.SplitHostPort(remote.String()); err == nil {
+		source.Host = host
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            eventID,
+			EventTime:          metav1.Time{Time: eventTime},
+			Source:             source,
+			ContentType:        e.contentType,
+			Extensions:         extensions,
+		},
+		Data: e.text,
+	}, nil
+}
+
+// upload uploads the attachments of the message to the S3 location of the signal
+// the keys of the objects are the key of the location, the id of the message and the file name of the attachment,
+// e.g. reports/1234@vendor.com/report.pdf.
+func (r *route) upload(messageID string, attachments []attachment) ([]string, error) {
+	s3 := r.signal.SMTP.Attachments.S3
+	keys := make([]string, 0, len(attachments))
+	seen := make(map[string]bool)
+	for i, a := range attachments {
+		name := a.filename
+		if name == "" || seen[name] {
+			name = fmt.Sprintf("%d-%s", i, name)
+			if a.filename == "" {
+				name = fmt.Sprintf("attachment-%d", i)
+			}
+		}
+		seen[name] = true
+		key := path.Join(s3.Key, strings.Replace(messageID, "/", "_", -1), name)
+		contentType := a.contentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		_, err := r.uploader.PutObject(s3.Bucket, key, bytes.NewReader(a.data), int64(len(a.data)), minio.PutObjectOptions{ContentType: contentType})
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload attachment %s to %s/%s. Cause: %+v", a.filename, s3.Bucket, key, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parsePath parses the path and the parameters of the argument of a MAIL or RCPT command, e.g. FROM:<a@example.com> SIZE=1
+func parsePath(arg, prefix string) (string, []string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+	arg = strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(arg, "<") {
+		return "", nil, false
+	}
+	end := strings.IndexByte(arg, '>')
+	if end < 0 {
+		return "", nil, false
+	}
+	return arg[1:end], strings.Fields(arg[end+1:]), true
+}
+
+// parseAddress parses the address and returns it in lower case, e.g. Reports@Example.com
+func parseAddress(address string) (string, error) {
+	a, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(a.Address), nil
+}
+
+// allowed checks if the sender is allowed by the allow-list of senders, whose entries are addresses or domains, e.g. @vendor.com
+// an empty allow-list allows all the senders.
+func allowed(senders []string, sender string) bool {
+	if len(senders) == 0 {
+		return true
+	}
+	for _, allowed := range senders {
+		allowed = strings.ToLower(allowed)
+		if strings.HasPrefix(allowed, "@") {
+			if strings.HasSuffix(sender, allowed) {
+				return true
+			}
+		} else if sender == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smtp
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	netsmtp "net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	minio "github.com/minio/minio-go"
+)
+
+// fakeUploader records the uploaded objects by key
+type fakeUploader struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (f *fakeUploader) PutObject(bucket, object string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (int64, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return 0, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[bucket+"/"+object] = string(b)
+	return int64(len(b)), nil
+}
+
+const report = "From: Vendor Reports <reports@vendor.com>\r\n" +
+	"To: reports@events.example.com\r\n" +
+	"Subject: =?UTF-8?Q?Daily_report_=E2=9C=93?=\r\n" +
+	"Message-ID: <1234@vendor.com>\r\n" +
+	"Date: Thu, 11 Oct 2018 22:14:15 +0000\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=\"b1\"\r\n" +
+	"\r\n" +
+	"--b1\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"b2\"\r\n" +
+	"\r\n" +
+	"--b2\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"The report of the day is attached =E2=9C=93\r\n" +
+	"--b2\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>The report of the day is attached</p>\r\n" +
+	"--b2--\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/csv; name=\"report.csv\"\r\n" +
+	"Content-Disposition: attachment; filename=\"../report.csv\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"aWQsdG90YWwKMSw0Mgo=\r\n" +
+	"--b1--\r\n"
+
+func TestParseEmail(t *testing.T) {
+	e, err := parseEmail([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.text) != "The report of the day is attached ✓" || e.contentType != "text/plain" {
+		t.Errorf("unexpected %s body %q", e.contentType, e.text)
+	}
+	if len(e.attachments) != 1 {
+		t.Fatalf("expected 1 attachment but found %d", len(e.attachments))
+	}
+	if a := e.attachments[0]; a.filename != "report.csv" || a.contentType != "text/csv" || string(a.data) != "id,total\n1,42\n" {
+		t.Errorf("unexpected attachment %+v", a)
+	}
+	if subject := e.headers()["subject"]; subject != "Daily report ✓" {
+		t.Errorf("expected the decoded subject but found %s", subject)
+	}
+
+	e, err = parseEmail([]byte("Subject: alert\r\nContent-Type: text/html\r\n\r\n<b>disk full</b>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.text) != "<b>disk full</b>" || e.contentType != "text/html" {
+		t.Errorf("expected the html body but found %s body %q", e.contentType, e.text)
+	}
+}
+
+func TestListen(t *testing.T) {
+	s := newSMTP(nil)
+	fake := &fakeUploader{objects: make(map[string]string)}
+	s.newUploader = func(loc *v1alpha1.ArtifactLocation) (uploader, error) {
+		return fake, nil
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go s.serve(l)
+
+	done := make(chan struct{})
+	defer close(done)
+	events, err := s.Listen(&v1alpha1.Signal{
+		Name: "reports",
+		SMTP: &v1alpha1.SMTPSignal{
+			Recipients: []string{"Reports@Events.example.com"},
+			Senders:    []string{"@vendor.com", "ops@example.com"},
+			Attachments: &v1alpha1.ArtifactLocation{
+				S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "reports"}, Key: "inbox"},
+			},
+		},
+	}, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Listen(&v1alpha1.Signal{Name: "other", SMTP: &v1alpha1.SMTPSignal{Recipients: []string{"reports@events.example.com"}}}, done); err == nil {
+		t.Error("expected an error for the recipient of another signal")
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- netsmtp.SendMail(l.Addr().String(), nil, "reports@vendor.com", []string{"reports@events.example.com"}, []byte(report))
+	}()
+	var event *v1alpha1.Event
+	select {
+	case event = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("expected the mail to be accepted but found %s", err)
+	}
+	if event.Context.EventID != "1234@vendor.com" || !event.Context.EventTime.Time.Equal(time.Date(2018, 10, 11, 22, 14, 15, 0, time.UTC)) {
+		t.Errorf("unexpected event context %+v", event.Context)
+	}
+	if string(event.Data) != "The report of the day is attached ✓" {
+		t.Errorf("expected the text body but found %q", event.Data)
+	}
+	ext := event.Context.Extensions
+	expected := map[string]string{
+		ContextExtensionSenderKey:                "reports@vendor.com",
+		ContextExtensionRecipientsKey:            "reports@events.example.com",
+		ContextExtensionHeaderPrefix + "subject": "Daily report ✓",
+		ContextExtensionAttachmentPrefix + "0":   "inbox/1234@vendor.com/report.csv",
+	}
+	for key, value := range expected {
+		if ext[key] != value {
+			t.Errorf("expected extension %s to be %s but found %s", key, value, ext[key])
+		}
+	}
+	if object := fake.objects["reports/inbox/1234@vendor.com/report.csv"]; object != "id,total\n1,42\n" {
+		t.Errorf("expected the attachment to be uploaded but found %q", object)
+	}
+
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		status string
+	}{
+		{name: "unknown recipient", from: "reports@vendor.com", to: "billing@events.example.com", status: "5.1.1"},
+		{name: "sender not allowed", from: "reports@evilvendor.com", to: "reports@events.example.com", status: "5.7.1"},
+		{name: "null sender not allowed", from: "", to: "reports@events.example.com", status: "5.7.1"},
+	}
+	for _, test := range tests {
+		err := netsmtp.SendMail(l.Addr().String(), nil, test.from, []string{test.to}, []byte(report))
+		if e, ok := err.(*textproto.Error); !ok || e.Code != 550 || !strings.HasPrefix(e.Msg, test.status) {
+			t.Errorf("%s: expected rejection 550 %s but found %v", test.name, test.status, err)
+		}
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	senders := []string{"@Vendor.com", "ops@example.com"}
+	tests := map[string]bool{
+		"reports@vendor.com":     true,
+		"ops@example.com":        true,
+		"dev@example.com":        false,
+		"reports@evilvendor.com": false,
+		"<>":                     false,
+	}
+	for sender, expected := range tests {
+		if actual := allowed(senders, sender); actual != expected {
+			t.Errorf("expected sender %s to be allowed %v but found %v", sender, expected, actual)
+		}
+	}
+	if !allowed(nil, "anyone@example.com") {
+		t.Error("expected all the senders to be allowed by an empty allow-list")
+	}
+}
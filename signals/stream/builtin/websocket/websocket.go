@@ -0,0 +1,296 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	ws "github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EventType is the event type of the events of the received messages
+	EventType = "com.github.argoproj.websocket"
+
+	// ContextExtensionMessageTypeKey is the event context extension key of the type of the message, i.e. text or binary
+	ContextExtensionMessageTypeKey = "messageType"
+
+	// headerPrefix is the prefix of the attributes of the headers of the handshake request, e.g. header.Authorization
+	headerPrefix = "header."
+
+	// subscribeKey is the attribute of the message sent after connecting, and subscribePrefix is the prefix of the
+	// attributes of the messages sent in the order of their index, e.g. subscribe.0 and subscribe.1
+	subscribeKey    = "subscribe"
+	subscribePrefix = "subscribe."
+
+	// the attributes of the bounds of the backoff of the reconnections and of the interval of the pings
+	minBackoffKey   = "minBackoff"
+	maxBackoffKey   = "maxBackoff"
+	pingIntervalKey = "pingInterval"
+
+	defaultMinBackoff   = time.Second
+	defaultMaxBackoff   = time.Minute
+	defaultPingInterval = 30 * time.Second
+
+	// the timeout of the writes of the subscribe messages and of the control messages
+	writeTimeout = 10 * time.Second
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+type websocket struct{}
+
+// New creates a new websocket listener
+func New() sdk.Listener {
+	return new(websocket)
+}
+
+// client receives the messages of the websocket of a signal
+type client struct {
+	url          string
+	header       http.Header
+	subscribes   []string
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	pingInterval time.Duration
+	source       *v1alpha1.URI
+
+	conn        *ws.Conn
+	connectedAt time.Time
+}
+
+func (*websocket) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	c, err := newClient(signal.Stream)
+	if err != nil {
+		return nil, err
+	}
+	// the first connection is established before returning so that invalid configurations fail the signal
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-done
+		cancel()
+	}()
+	events := make(chan *v1alpha1.Event)
+	go func() {
+		defer close(events)
+		c.run(ctx, events)
+		log.Printf("signal '%s' stopped listening to websocket %s", signal.Name, c.url)
+	}()
+	log.Printf("signal '%s' listening for messages of websocket %s...", signal.Name, c.url)
+	return events, nil
+}
+
+// newClient creates the client of the websocket of the stream from its attributes
+func newClient(stream *v1alpha1.Stream) (*client, error) {
+	u, err := url.Parse(stream.URL)
+	if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") {
+		return nil, fmt.Errorf("invalid websocket url '%s', the scheme must be ws or wss", stream.URL)
+	}
+	c := &client{
+		url:          stream.URL,
+		header:       http.Header{},
+		minBackoff:   defaultMinBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		pingInterval: defaultPingInterval,
+		source:       &v1alpha1.URI{Scheme: u.Scheme, Host: u.Hostname(), Path: u.Path, Query: u.RawQuery},
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		c.source.Port = int32(port)
+	}
+	var indexes []int
+	subscribes := make(map[int]string)
+	for key, value := range stream.Attributes {
+		switch {
+		case strings.HasPrefix(key, headerPrefix):
+			c.header.Add(strings.TrimPrefix(key, headerPrefix), value)
+		case strings.HasPrefix(key, subscribePrefix):
+			i, err := strconv.Atoi(strings.TrimPrefix(key, subscribePrefix))
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid subscribe attribute '%s', the index must be a number", key)
+			}
+			indexes = append(indexes, i)
+			subscribes[i] = value
+		}
+	}
+	if subscribe, ok := stream.Attributes[subscribeKey]; ok {
+		c.subscribes = append(c.subscribes, subscribe)
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		c.subscribes = append(c.subscribes, subscribes[i])
+	}
+	for key, d := range map[string]*time.Duration{minBackoffKey: &c.minBackoff, maxBackoffKey: &c.maxBackoff, pingIntervalKey: &c.pingInterval} {
+		if value, ok := stream.Attributes[key]; ok {
+			duration, err := time.ParseDuration(value)
+			if err != nil || duration < 0 {
+				return nil, fmt.Errorf("invalid %s attribute '%s'", key, value)
+			}
+			*d = duration
+		}
+	}
+	if c.minBackoff == 0 || c.maxBackoff < c.minBackoff {
+		return nil, fmt.Errorf("invalid backoff, %s must be positive and not exceed %s", minBackoffKey, maxBackoffKey)
+	}
+	return c, nil
+}
+
+// connect connects to the websocket and sends the subscribe messages
+func (c *client) connect() error {
+	conn, resp, err := ws.DefaultDialer.Dial(c.url, c.header)
+	if err != nil {
+		if resp != nil {
+			return fmt.Errorf("failed to connect to websocket %s: handshake failed with status %s", c.url, resp.Status)
+		}
+		return fmt.Errorf("failed to connect to websocket %s. Cause: %+v", c.url, err)
+	}
+	for _, subscribe := range c.subscribes {
+		conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if err := conn.WriteMessage(ws.TextMessage, []byte(subscribe)); err != nil {
+			conn.Close()
+			return fmt.Errorf("failed to send subscribe message to websocket %s. Cause: %+v", c.url, err)
+		}
+	}
+	c.conn = conn
+	c.connectedAt = time.Now().UTC()
+	return nil
+}
+
+// run sends the events of the messages until the context is done
+// the client reconnects with backoff when the connection fails and sends the subscribe messages again,
+// the messages sent in the meantime are missed.
+func (c *client) run(ctx context.Context, events chan<- *v1alpha1.Event) {
+	b := common.Backoff{Min: c.minBackoff, Max: c.maxBackoff}
+	for {
+		if c.conn == nil {
+			if err := c.connect(); err != nil {
+				log.Warnf("%s", err)
+				if !b.Wait(ctx) {
+					return
+				}
+				continue
+			}
+			log.Printf("reconnected to websocket %s", c.url)
+			b.Reset()
+		}
+		err := c.receive(ctx, c.conn, events)
+		c.conn.Close()
+		c.conn = nil
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warnf("lost connection to websocket %s, reconnecting: %s", c.url, err)
+		if !b.Wait(ctx) {
+			return
+		}
+	}
+}
+
+// receive sends the events of the messages of the connection until it fails or the context is done
+// the connection is kept alive with pings, and fails if neither a message nor a pong is received for two ping intervals.
+func (c *client) receive(ctx context.Context, conn *ws.Conn, events chan<- *v1alpha1.Event) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		var ticks <-chan time.Time
+		if c.pingInterval > 0 {
+			ticker := time.NewTicker(c.pingInterval)
+			defer ticker.Stop()
+			ticks = ticker.C
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				conn.WriteControl(ws.CloseMessage, ws.FormatCloseMessage(ws.CloseNormalClosure, ""), time.Now().Add(writeTimeout))
+				conn.Close()
+				return
+			case <-stop:
+				return
+			case <-ticks:
+				if err := conn.WriteControl(ws.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+					conn.Close()
+					return
+				}
+			}
+		}
+	}()
+	if c.pingInterval > 0 {
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * c.pingInterval))
+		})
+	}
+
+	var seq uint64
+	for {
+		if c.pingInterval > 0 {
+			conn.SetReadDeadline(time.Now().Add(2 * c.pingInterval))
+		}
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		seq++
+		select {
+		case events <- c.newEvent(typ, data, seq):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *client) newEvent(typ int, data []byte, seq uint64) *v1alpha1.Event {
+	messageType := "binary"
+	contentType := "application/octet-stream"
+	if typ == ws.TextMessage {
+		messageType = "text"
+		contentType = "text/plain"
+		if json.Valid(data) {
+			contentType = "application/json"
+		}
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%d-%d", c.connectedAt.UnixNano(), seq),
+			EventTime:          metav1.Time{Time: time.Now().UTC()},
+			Source:             c.source,
+			ContentType:        contentType,
+			Extensions: map[string]string{
+				ContextExtensionMessageTypeKey: messageType,
+			},
+		},
+		Data: data,
+	}
+}
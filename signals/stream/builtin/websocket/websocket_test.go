@@ -0,0 +1,155 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	ws "github.com/gorilla/websocket"
+)
+
+func TestNewClient(t *testing.T) {
+	c, err := newClient(&v1alpha1.Stream{
+		Type: "WEBSOCKET",
+		URL:  "wss://stream.example.com:8443/ws?v=1",
+		Attributes: map[string]string{
+			"header.Authorization": "Bearer token",
+			"subscribe.10":         `{"subscribe":"ETH-USD"}`,
+			"subscribe.2":          `{"subscribe":"BTC-USD"}`,
+			"subscribe":            `{"auth":"token"}`,
+			"minBackoff":           "100ms",
+			"pingInterval":         "0s",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{`{"auth":"token"}`, `{"subscribe":"BTC-USD"}`, `{"subscribe":"ETH-USD"}`}
+	if !reflect.DeepEqual(c.subscribes, expected) {
+		t.Errorf("expected subscribes %v but found %v", expected, c.subscribes)
+	}
+	if c.header.Get("Authorization") != "Bearer token" {
+		t.Errorf("expected the authorization header but found %v", c.header)
+	}
+	if c.minBackoff != 100*time.Millisecond || c.maxBackoff != defaultMaxBackoff || c.pingInterval != 0 {
+		t.Errorf("unexpected durations %s, %s and %s", c.minBackoff, c.maxBackoff, c.pingInterval)
+	}
+	if c.source.Host != "stream.example.com" || c.source.Port != 8443 || c.source.Path != "/ws" {
+		t.Errorf("unexpected source %+v", c.source)
+	}
+
+	invalid := []*v1alpha1.Stream{
+		{Type: "WEBSOCKET", URL: "http://stream.example.com"},
+		{Type: "WEBSOCKET", URL: "ws://stream.example.com", Attributes: map[string]string{"subscribe.first": "{}"}},
+		{Type: "WEBSOCKET", URL: "ws://stream.example.com", Attributes: map[string]string{"maxBackoff": "10ms"}},
+	}
+	for _, stream := range invalid {
+		if _, err := newClient(stream); err == nil {
+			t.Errorf("expected an error for stream %+v", stream)
+		}
+	}
+}
+
+func TestListen(t *testing.T) {
+	upgrader := ws.Upgrader{}
+	subscribes := make(chan []string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var received []string
+		for i := 0; i < 2; i++ {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			received = append(received, string(msg))
+		}
+		subscribes <- received
+		conn.WriteMessage(ws.TextMessage, []byte(`{"price":42}`))
+		conn.WriteMessage(ws.BinaryMessage, []byte{0x01, 0x02})
+		// the connection is dropped after the messages, so the client reconnects
+	}))
+	defer srv.Close()
+
+	stream := &v1alpha1.Stream{
+		Type: "WEBSOCKET",
+		URL:  "ws" + strings.TrimPrefix(srv.URL, "http"),
+		Attributes: map[string]string{
+			"header.Authorization": "Bearer token",
+			"subscribe.0":          "auth",
+			"subscribe.1":          "BTC-USD",
+			"minBackoff":           "10ms",
+			"maxBackoff":           "20ms",
+		},
+	}
+	done := make(chan struct{})
+	events, err := New().Listen(&v1alpha1.Signal{Name: "prices", Stream: stream}, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for connection := 0; connection < 2; connection++ {
+		select {
+		case received := <-subscribes:
+			if !reflect.DeepEqual(received, []string{"auth", "BTC-USD"}) {
+				t.Errorf("expected the subscribe messages to be sent in order but found %v", received)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the subscribe messages")
+		}
+		for _, expected := range []struct {
+			data        string
+			messageType string
+			contentType string
+		}{
+			{data: `{"price":42}`, messageType: "text", contentType: "application/json"},
+			{data: "\x01\x02", messageType: "binary", contentType: "application/octet-stream"},
+		} {
+			select {
+			case event := <-events:
+				if string(event.Data) != expected.data || event.Context.ContentType != expected.contentType ||
+					event.Context.Extensions[ContextExtensionMessageTypeKey] != expected.messageType {
+					t.Errorf("unexpected event %+v with data %q", event.Context, event.Data)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the event")
+			}
+		}
+	}
+
+	close(done)
+	for range events {
+	}
+
+	stream.Attributes["header.Authorization"] = "Bearer guess"
+	if _, err := New().Listen(&v1alpha1.Signal{Name: "prices", Stream: stream}, make(chan struct{})); err == nil {
+		t.Error("expected an error for the failed handshake")
+	}
+}
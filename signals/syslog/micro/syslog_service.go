@@ -0,0 +1,80 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/tls"
+	"os"
+	"strconv"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/sdk"
+	"github.com/argoproj/argo-events/signals/syslog"
+	"github.com/micro/go-micro"
+	k8s "github.com/micro/kubernetes/go/micro"
+)
+
+const (
+	// EnvVarUDPPort, EnvVarTCPPort and EnvVarTLSPort are the Env Var Keys for the ports of the protocols
+	// a port of 0 disables its protocol.
+	EnvVarUDPPort string = "SYSLOG_UDP_PORT"
+	EnvVarTCPPort string = "SYSLOG_TCP_PORT"
+	EnvVarTLSPort string = "SYSLOG_TLS_PORT"
+
+	// DefaultUDPPort, DefaultTCPPort and DefaultTLSPort are the default ports to use if the Env Vars are not set
+	DefaultUDPPort int = 5514
+	DefaultTCPPort int = 5514
+	DefaultTLSPort int = 6514
+
+	// EnvVarTLSCert and EnvVarTLSKey are the Env Var Keys for the files of the certificate and the key of the server
+	// messages are only received over TLS if they are set.
+	EnvVarTLSCert string = "SYSLOG_TLS_CERT"
+	EnvVarTLSKey  string = "SYSLOG_TLS_KEY"
+
+	// EnvVarTLSClientCA is the Env Var Key for the file of the CA of the client certificates, which enables mutual TLS
+	EnvVarTLSClientCA string = "SYSLOG_TLS_CLIENT_CA"
+)
+
+func main() {
+	svc := k8s.NewService(micro.Name("syslog"), micro.Metadata(sdk.SignalMetadata))
+	svc.Init()
+
+	var tlsConfig *tls.Config
+	if certFile, ok := os.LookupEnv(EnvVarTLSCert); ok {
+		var err error
+		tlsConfig, err = common.NewServerTLSConfig(certFile, os.Getenv(EnvVarTLSKey), os.Getenv(EnvVarTLSClientCA))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	listener := syslog.New(port(EnvVarUDPPort, DefaultUDPPort), port(EnvVarTCPPort, DefaultTCPPort), port(EnvVarTLSPort, DefaultTLSPort), tlsConfig)
+	sdk.RegisterSignalServiceHandler(svc.Server(), sdk.NewMicroSignalServer(listener))
+
+	if err := svc.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// port returns the port of the Env Var, or the default port if it is not set
+func port(envVar string, defaultPort int) int {
+	if strPort, ok := os.LookupEnv(envVar); ok {
+		port, _ := strconv.Atoi(strPort)
+		return port
+	}
+	return defaultPort
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// nilValue is the value of the fields of RFC 5424 messages which are not present
+const nilValue = "-"
+
+// byteOrderMark is the UTF-8 byte order mark which starts the UTF-8 messages of RFC 5424
+const byteOrderMark = "\ufeff"
+
+// severities are the severities of the messages by their numerical code
+var severities = []v1alpha1.SyslogSeverity{
+	v1alpha1.SyslogSeverityEmergency,
+	v1alpha1.SyslogSeverityAlert,
+	v1alpha1.SyslogSeverityCritical,
+	v1alpha1.SyslogSeverityError,
+	v1alpha1.SyslogSeverityWarning,
+	v1alpha1.SyslogSeverityNotice,
+	v1alpha1.SyslogSeverityInfo,
+	v1alpha1.SyslogSeverityDebug,
+}
+
+// facilities are the keywords of the facilities of the messages by their numerical code
+var facilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "audit", "alert", "clock",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+// message is a parsed syslog message
+type message struct {
+	Facility       string                       `json:"facility"`
+	Severity       v1alpha1.SyslogSeverity      `json:"severity"`
+	Version        int                          `json:"version,omitempty"`
+	Timestamp      *time.Time                   `json:"timestamp,omitempty"`
+	Hostname       string                       `json:"hostname,omitempty"`
+	AppName        string                       `json:"appName,omitempty"`
+	ProcID         string                       `json:"procID,omitempty"`
+	MsgID          string                       `json:"msgID,omitempty"`
+	StructuredData map[string]map[string]string `json:"structuredData,omitempty"`
+	Message        string                       `json:"message"`
+
+	// severity is the numerical code of the severity
+	severity int
+}
+
+// parse parses an RFC 5424 or an RFC 3164 message
+// the timestamps of RFC 3164 messages have no year and time zone, they are in the year of now and in UTC.
+func parse(b []byte, now time.Time) (*message, error) {
+	s := strings.TrimRight(string(b), "\r\n\x00")
+	if !strings.HasPrefix(s, "<") {
+		return nil, fmt.Errorf("message does not start with a priority")
+	}
+	end := strings.IndexByte(s, '>')
+	if end < 2 || end > 4 {
+		return nil, fmt.Errorf("invalid priority")
+	}
+	pri, err := strconv.Atoi(s[1:end])
+	if err != nil || pri < 0 || pri >= len(facilities)*len(severities) {
+		return nil, fmt.Errorf("invalid priority %s", s[1:end])
+	}
+	m := &message{
+		Facility: facilities[pri/len(severities)],
+		Severity: severities[pri%len(severities)],
+		severity: pri % len(severities),
+	}
+	s = s[end+1:]
+	if version, rest, ok := parseVersion(s); ok {
+		m.Version = version
+		return m, parseRFC5424(m, rest)
+	}
+	parseRFC3164(m, s, now)
+	return m, nil
+}
+
+// parseVersion parses the version which follows the priority of RFC 5424 messages
+func parseVersion(s string) (int, string, bool) {
+	i := strings.IndexByte(s, ' ')
+	if i < 1 || i > 2 || s[0] == '0' {
+		return 0, "", false
+	}
+	version, err := strconv.Atoi(s[:i])
+	if err != nil {
+		return 0, "", false
+	}
+	return version, s[i+1:], true
+}
+
+// parseRFC5424 parses the header, the structured data and the message of an RFC 5424 message
+// See https://tools.ietf.org/html/rfc5424#section-6
+func parseRFC5424(m *message, s string) error {
+	fields := strings.SplitN(s, " ", 6)
+	if len(fields) < 6 {
+		return fmt.Errorf("invalid RFC 5424 header")
+	}
+	if fields[0] != nilValue {
+		t, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %s", fields[0])
+		}
+		m.Timestamp = &t
+	}
+	m.Hostname = nilOrValue(fields[1])
+	m.AppName = nilOrValue(fields[2])
+	m.ProcID = nilOrValue(fields[3])
+	m.MsgID = nilOrValue(fields[4])
+
+	rest := fields[5]
+	if strings.HasPrefix(rest, nilValue) {
+		rest = rest[len(nilValue):]
+	} else {
+		sd, r, err := parseStructuredData(rest)
+		if err != nil {
+			return err
+		}
+		m.StructuredData = sd
+		rest = r
+	}
+	if rest != "" && rest[0] != ' ' {
+		return fmt.Errorf("invalid structured data")
+	}
+	m.Message = strings.TrimPrefix(strings.TrimPrefix(rest, " "), byteOrderMark)
+	return nil
+}
+
+// parseStructuredData parses the structured data elements at the start of s, e.g. [origin ip="192.0.2.1"]
+// returns the parameters by the id of their element and the remainder of s.
+func parseStructuredData(s string) (map[string]map[string]string, string, error) {
+	if !strings.HasPrefix(s, "[") {
+		return nil, "", fmt.Errorf("invalid structured data")
+	}
+	sd := make(map[string]map[string]string)
+	for strings.HasPrefix(s, "[") {
+		s = s[1:]
+		end := strings.IndexAny(s, " ]")
+		if end < 1 {
+			return nil, "", fmt.Errorf("invalid structured data element")
+		}
+		id := s[:end]
+		params, ok := sd[id]
+		if !ok {
+			params = make(map[string]string)
+			sd[id] = params
+		}
+		s = s[end:]
+		for strings.HasPrefix(s, " ") {
+			s = s[1:]
+			eq := strings.Index(s, "=\"")
+			if eq < 1 {
+				return nil, "", fmt.Errorf("invalid parameter of structured data element %s", id)
+			}
+			name := s[:eq]
+			value, rest, err := parseParamValue(s[eq+2:])
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid parameter %s of structured data element %s", name, id)
+			}
+			params[name] = value
+			s = rest
+		}
+		if !strings.HasPrefix(s, "]") {
+			return nil, "", fmt.Errorf("unterminated structured data element %s", id)
+		}
+		s = s[1:]
+	}
+	return sd, s, nil
+}
+
+// parseParamValue parses a parameter value up to its closing quote, where '"', '\' and ']' are escaped with '\'
+func parseParamValue(s string) (string, string, error) {
+	var value bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return value.String(), s[i+1:], nil
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\' || s[i+1] == ']') {
+				i++
+				c = s[i]
+			}
+			value.WriteByte(c)
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated parameter value")
+}
+
+// parseRFC3164 parses the optional timestamp and hostname, and the tag and the content of an RFC 3164 message
+// messages which do not follow the format are kept as their content.
+// See https://tools.ietf.org/html/rfc3164#section-4.1
+func parseRFC3164(m *message, s string, now time.Time) {
+	if t, rest, ok := parseBSDTimestamp(s, now); ok {
+		m.Timestamp = &t
+		s = rest
+		// the hostname is omitted by some senders, the token is then the tag
+		if i := strings.IndexByte(s, ' '); i > 0 && !strings.HasSuffix(s[:i], ":") {
+			m.Hostname = s[:i]
+			s = s[i+1:]
+		}
+	}
+	// the tag is the name of the program, optionally followed by the id of its process, e.g. sshd[1234]:
+	if i := strings.IndexByte(s, ' '); i > 1 && s[i-1] == ':' {
+		tag := s[:i-1]
+		if open := strings.IndexByte(tag, '['); open > 0 && strings.HasSuffix(tag, "]") {
+			m.ProcID = tag[open+1 : len(tag)-1]
+			tag = tag[:open]
+		}
+		m.AppName = tag
+		s = s[i+1:]
+	}
+	m.Message = s
+}
+
+// parseBSDTimestamp parses the timestamp at the start of an RFC 3164 message, e.g. Oct 11 22:14:15
+// RFC 3339 timestamps, which are sent by some senders instead, are also supported.
+func parseBSDTimestamp(s string, now time.Time) (time.Time, string, bool) {
+	if len(s) > len(time.Stamp) && s[len(time.Stamp)] == ' ' {
+		if t, err := time.Parse(time.Stamp, s[:len(time.Stamp)]); err == nil {
+			now = now.UTC()
+			t = time.Date(now.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+			// messages of the end of the previous year are received at the start of the year
+			if t.Sub(now) > 24*time.Hour {
+				t = t.AddDate(-1, 0, 0)
+			}
+			return t, s[len(time.Stamp)+1:], true
+		}
+	}
+	if i := strings.IndexByte(s, ' '); i > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, s[:i]); err == nil {
+			return t, s[i+1:], true
+		}
+	}
+	return time.Time{}, s, false
+}
+
+func nilOrValue(field string) string {
+	if field == nilValue {
+		return ""
+	}
+	return field
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syslog
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sdk"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EventType is the event type of the events of the syslog messages
+	EventType = "com.github.argoproj.syslog"
+
+	// ContextExtensionFacilityKey and ContextExtensionSeverityKey are the event context extension keys of the facility
+	// and the severity of the message, e.g. local0 and warning
+	ContextExtensionFacilityKey = "facility"
+	ContextExtensionSeverityKey = "severity"
+
+	// ContextExtensionHostnameKey is the event context extension key of the hostname of the message
+	ContextExtensionHostnameKey = "hostname"
+
+	// ContextExtensionAppNameKey and ContextExtensionProcIDKey are the event context extension keys of the name and the
+	// process ID of the application which sent the message, i.e. the tag of RFC 3164 messages
+	ContextExtensionAppNameKey = "appName"
+	ContextExtensionProcIDKey  = "procID"
+
+	// ContextExtensionMsgIDKey is the event context extension key of the type of RFC 5424 messages
+	ContextExtensionMsgIDKey = "msgID"
+
+	// ContextExtensionProtocolKey is the event context extension key of the protocol the message was received with
+	ContextExtensionProtocolKey = "protocol"
+
+	// ContextExtensionStructuredDataPrefix is the prefix of the event context extension keys of the parameters of
+	// the structured data of RFC 5424 messages, e.g. sd.origin.ip
+	ContextExtensionStructuredDataPrefix = "sd."
+
+	// the maximum size of the messages, larger messages are dropped
+	maxMessageSize = 64 * 1024
+)
+
+// Note: micro requires stateless operation so the Listen() method should not use the
+// receive struct to save or modify state.
+// the syslog signals share the listeners of the service since the ports are fixed at runtime.
+// The routes of the listening signals are registered with the listeners and removed when the signals stop.
+type syslog struct {
+	// routes are the routes of the listening signals by signal name
+	routes sync.Map
+}
+
+// route receives the messages of a signal
+type route struct {
+	signal   *v1alpha1.Signal
+	severity int
+	pattern  *regexp.Regexp
+	stream   *common.EventStream
+}
+
+// New creates a new syslog listener receiving the messages on the specified ports
+// a port of 0 disables its protocol, and TLS is disabled if the tlsConfig is nil.
+func New(udpPort, tcpPort, tlsPort int, tlsConfig *tls.Config) sdk.Listener {
+	s := &syslog{}
+	if udpPort > 0 {
+		go func() {
+			conn, err := net.ListenPacket("udp", fmt.Sprintf(":%v", udpPort))
+			if err != nil {
+				log.Panicf("syslog udp server failed to listen: %v", err)
+			}
+			log.Printf("starting syslog udp server listening on: %s", conn.LocalAddr())
+			if err := s.serveUDP(conn); err != nil {
+				log.Panicf("syslog udp server encountered error serving: %v", err)
+			}
+		}()
+	}
+	if tcpPort > 0 {
+		go func() {
+			l, err := net.Listen("tcp", fmt.Sprintf(":%v", tcpPort))
+			if err != nil {
+				log.Panicf("syslog tcp server failed to listen: %v", err)
+			}
+			log.Printf("starting syslog tcp server listening on: %s", l.Addr())
+			if err := s.serveStream(l, v1alpha1.SyslogProtocolTCP); err != nil {
+				log.Panicf("syslog tcp server encountered error serving: %v", err)
+			}
+		}()
+	}
+	if tlsPort > 0 && tlsConfig != nil {
+		go func() {
+			l, err := tls.Listen("tcp", fmt.Sprintf(":%v", tlsPort), tlsConfig)
+			if err != nil {
+				log.Panicf("syslog tls server failed to listen: %v", err)
+			}
+			log.Printf("starting syslog tls server listening on: %s", l.Addr())
+			if err := s.serveStream(l, v1alpha1.SyslogProtocolTLS); err != nil {
+				log.Panicf("syslog tls server encountered error serving: %v", err)
+			}
+		}()
+	}
+	return s
+}
+
+func (s *syslog) Listen(signal *v1alpha1.Signal, done <-chan struct{}) (<-chan *v1alpha1.Event, error) {
+	r := &route{signal: signal, severity: len(severities) - 1, stream: common.NewEventStream(done)}
+	if signal.Syslog.Severity != "" {
+		r.severity = severityCode(signal.Syslog.Severity)
+		if r.severity < 0 {
+			return nil, fmt.Errorf("unknown severity '%s'", signal.Syslog.Severity)
+		}
+	}
+	if signal.Syslog.Pattern != "" {
+		pattern, err := regexp.Compile(signal.Syslog.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile pattern '%s'. Cause: %+v", signal.Syslog.Pattern, err)
+		}
+		r.pattern = pattern
+	}
+	if _, loaded := s.routes.LoadOrStore(signal.Name, r); loaded {
+		return nil, fmt.Errorf("signal '%s' is already listening", signal.Name)
+	}
+
+	go func() {
+		<-done
+		s.routes.Delete(signal.Name)
+		r.stream.Close()
+		log.Printf("signal '%s' stopped listening for syslog messages", signal.Name)
+	}()
+	log.Printf("signal '%s' listening for syslog messages...", signal.Name)
+	return r.stream.Events(), nil
+}
+
+// serveUDP receives the messages of the datagrams of the connection, each datagram is a message
+func (s *syslog) serveUDP(conn net.PacketConn) error {
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		s.receive(buf[:n], v1alpha1.SyslogProtocolUDP, addr)
+	}
+}
+
+// serveStream accepts the connections of the TCP or TLS listener and receives the messages of the connections
+func (s *syslog) serveStream(l net.Listener, protocol v1alpha1.SyslogProtocol) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn, protocol)
+	}
+}
+
+// handleConn receives the messages of the connection until it is closed
+func (s *syslog) handleConn(conn net.Conn, protocol v1alpha1.SyslogProtocol) {
+	defer conn.Close()
+	r := bufio.NewReaderSize(conn, maxMessageSize)
+	for {
+		b, err := readFrame(r)
+		if err != nil {
+			if err != io.EOF {
+				log.Warnf("failed to read syslog message from %s: %s", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		s.receive(b, protocol, conn.RemoteAddr())
+	}
+}
+
+// readFrame reads a message of a TCP or TLS connection
+// the messages are framed with octet counting, i.e. prefixed by their length, or terminated by a line feed.
+// See https://tools.ietf.org/html/rfc6587#section-3.4
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if first[0] >= '1' && first[0] <= '9' {
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return nil, fmt.Errorf("invalid message length: %s", err)
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+		if err != nil || n > maxMessageSize {
+			return nil, fmt.Errorf("invalid message length %s", strings.TrimSuffix(length, " "))
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	line, err := r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		return nil, fmt.Errorf("message exceeds %d bytes", maxMessageSize)
+	}
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return nil, err
+	}
+	b := make([]byte, len(line))
+	copy(b, line)
+	return b, nil
+}
+
+// receive parses the message and sends its event to the signals whose filters match the message
+func (s *syslog) receive(b []byte, protocol v1alpha1.SyslogProtocol, addr net.Addr) {
+	now := time.Now().UTC()
+	m, err := parse(b, now)
+	if err != nil {
+		log.Warnf("received invalid syslog message from %s: %s", addr, err)
+		return
+	}
+	event, err := newEvent(m, protocol, addr, now)
+	if err != nil {
+		log.Warnf("failed to create event of syslog message of %s: %s", addr, err)
+		return
+	}
+	s.routes.Range(func(key, value interface{}) bool {
+		r := value.(*route)
+		if !r.matches(m, protocol) {
+			log.Debugf("FILTERED: syslog message of %s does not match the filters of signal '%s'", addr, r.signal.Name)
+			return true
+		}
+		r.stream.Send(event, nil)
+		return true
+	})
+}
+
+// matches checks if the message was received with one of the protocols of the signal and matches its filters
+func (r *route) matches(m *message, protocol v1alpha1.SyslogProtocol) bool {
+	sl := r.signal.Syslog
+	if len(sl.Protocols) > 0 {
+		found := false
+		for _, p := range sl.Protocols {
+			if p == protocol {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if m.severity > r.severity {
+		return false
+	}
+	if sl.Contains != "" && !strings.Contains(m.Message, sl.Contains) {
+		return false
+	}
+	return r.pattern == nil || r.pattern.MatchString(m.Message)
+}
+
+// severityCode returns the numerical code of the severity, or -1 if the severity is unknown
+func severityCode(severity v1alpha1.SyslogSeverity) int {
+	for code, s := range severities {
+		if s == severity {
+			return code
+		}
+	}
+	return -1
+}
+
+func newEvent(m *message, protocol v1alpha1.SyslogProtocol, addr net.Addr, now time.Time) (*v1alpha1.Event, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	eventTime := now
+	if m.Timestamp != nil {
+		eventTime = m.Timestamp.UTC()
+	}
+	extensions := map[string]string{
+		ContextExtensionFacilityKey: m.Facility,
+		ContextExtensionSeverityKey: string(m.Severity),
+		ContextExtensionProtocolKey: string(protocol),
+	}
+	for key, value := range map[string]string{
+		ContextExtensionHostnameKey: m.Hostname,
+		ContextExtensionAppNameKey:  m.AppName,
+		ContextExtensionProcIDKey:   m.ProcID,
+		ContextExtensionMsgIDKey:    m.MsgID,
+	} {
+		if value != "" {
+			extensions[key] = value
+		}
+	}
+	for id, params := range m.StructuredData {
+		for name, value := range params {
+			extensions[ContextExtensionStructuredDataPrefix+id+"."+name] = value
+		}
+	}
+	source := &v1alpha1.URI{Scheme: string(protocol)}
+	host := m.Hostname
+	if addr != nil {
+		if h, port, err := net.SplitHostPort(addr.String()); err == nil {
+			source.Host = h
+			if p, err := strconv.ParseInt(port, 10, 32); err == nil {
+				source.Port = int32(p)
+			}
+			if host == "" {
+				host = h
+			}
+		}
+	}
+	return &v1alpha1.Event{
+		Context: v1alpha1.EventContext{
+			EventType:          EventType,
+			EventTypeVersion:   "v1",
+			CloudEventsVersion: sdk.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%s-%d", host, now.UnixNano()),
+			EventTime:          metav1.Time{Time: eventTime},
+			Source:             source,
+			ContentType:        "application/json",
+			Extensions:         extensions,
+		},
+		Data: b,
+	}, nil
+}
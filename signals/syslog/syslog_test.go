@@ -0,0 +1,290 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syslog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestParse(t *testing.T) {
+	now := time.Date(2018, 10, 12, 8, 0, 0, 0, time.UTC)
+	ts := func(t time.Time) *time.Time { return &t }
+	tests := []struct {
+		name    string
+		msg     string
+		want    *message
+		wantErr bool
+	}{
+		{
+			name: "rfc5424 with structured data",
+			msg:  `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][origin ip="192.0.2.1"] ` + byteOrderMark + "An application event log entry...\n",
+			want: &message{
+				Facility:  "local4",
+				Severity:  v1alpha1.SyslogSeverityNotice,
+				Version:   1,
+				Timestamp: ts(time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC)),
+				Hostname:  "mymachine.example.com",
+				AppName:   "evntslog",
+				MsgID:     "ID47",
+				StructuredData: map[string]map[string]string{
+					"exampleSDID@32473": {"iut": "3", "eventSource": "Application", "eventID": "1011"},
+					"origin":            {"ip": "192.0.2.1"},
+				},
+				Message:  "An application event log entry...",
+				severity: 5,
+			},
+		},
+		{
+			name: "rfc5424 with nil values and escaped parameter",
+			msg:  `<34>1 - - su 42 - [meta note="a \"quoted\" \] value"]`,
+			want: &message{
+				Facility:       "auth",
+				Severity:       v1alpha1.SyslogSeverityCritical,
+				Version:        1,
+				AppName:        "su",
+				ProcID:         "42",
+				StructuredData: map[string]map[string]string{"meta": {"note": `a "quoted" ] value`}},
+				severity:       2,
+			},
+		},
+		{
+			name:    "rfc5424 with unterminated structured data",
+			msg:     `<34>1 - - su 42 - [meta note="value" link down`,
+			wantErr: true,
+		},
+		{
+			name: "rfc3164",
+			msg:  "<34>Oct 11 22:14:15 mymachine su[1234]: 'su root' failed for lonvick on /dev/pts/8",
+			want: &message{
+				Facility:  "auth",
+				Severity:  v1alpha1.SyslogSeverityCritical,
+				Timestamp: ts(time.Date(2018, 10, 11, 22, 14, 15, 0, time.UTC)),
+				Hostname:  "mymachine",
+				AppName:   "su",
+				ProcID:    "1234",
+				Message:   "'su root' failed for lonvick on /dev/pts/8",
+				severity:  2,
+			},
+		},
+		{
+			name: "rfc3164 with invalid timestamp",
+			msg:  "<13>Dec  31 23:59:59 sshd: session closed",
+			want: &message{
+				Facility: "user",
+				Severity: v1alpha1.SyslogSeverityNotice,
+				Message:  "Dec  31 23:59:59 sshd: session closed",
+				severity: 5,
+			},
+		},
+		{
+			name: "rfc3164 with padded day in december",
+			msg:  "<13>Dec  1 23:59:59 sshd: session closed",
+			want: &message{
+				Facility:  "user",
+				Severity:  v1alpha1.SyslogSeverityNotice,
+				Timestamp: ts(time.Date(2017, 12, 1, 23, 59, 59, 0, time.UTC)),
+				AppName:   "sshd",
+				Message:   "session closed",
+				severity:  5,
+			},
+		},
+		{
+			name: "rfc3164 without header",
+			msg:  "<190>%LINK-3-UPDOWN: Interface GigabitEthernet0/1, changed state to down",
+			want: &message{
+				Facility: "local7",
+				Severity: v1alpha1.SyslogSeverityInfo,
+				AppName:  "%LINK-3-UPDOWN",
+				Message:  "Interface GigabitEthernet0/1, changed state to down",
+				severity: 6,
+			},
+		},
+		{
+			name:    "missing priority",
+			msg:     "Oct 11 22:14:15 mymachine su: failed",
+			wantErr: true,
+		},
+		{
+			name:    "invalid priority",
+			msg:     "<192>Oct 11 22:14:15 mymachine su: failed",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		m, err := parse([]byte(test.msg), now)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: parse() error = %v, wantErr %v", test.name, err, test.wantErr)
+			continue
+		}
+		if !test.wantErr && !reflect.DeepEqual(m, test.want) {
+			t.Errorf("%s: parse() = %+v, want %+v", test.name, m, test.want)
+		}
+	}
+}
+
+func TestReadFrame(t *testing.T) {
+	stream := "18 <13>1 - - - - - hi<13>Oct 11 22:14:15 host app: line\n<13>last"
+	r := bufio.NewReader(strings.NewReader(stream))
+	for _, want := range []string{"<13>1 - - - - - hi", "<13>Oct 11 22:14:15 host app: line\n", "<13>last"} {
+		b, err := readFrame(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("expected frame %q but found %q", want, b)
+		}
+	}
+	if _, err := readFrame(r); err == nil {
+		t.Error("expected an error at the end of the stream")
+	}
+
+	r = bufio.NewReader(strings.NewReader(fmt.Sprintf("%d <13>", maxMessageSize+1)))
+	if _, err := readFrame(r); err == nil {
+		t.Error("expected an error for a message exceeding the maximum size")
+	}
+}
+
+func TestListen(t *testing.T) {
+	s := &syslog{}
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udp.Close()
+	go s.serveUDP(udp)
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcp.Close()
+	go s.serveStream(tcp, v1alpha1.SyslogProtocolTCP)
+
+	done := make(chan struct{})
+	defer close(done)
+	links, err := s.Listen(&v1alpha1.Signal{
+		Name: "links",
+		Syslog: &v1alpha1.SyslogSignal{
+			Severity: v1alpha1.SyslogSeverityError,
+			Pattern:  "changed state to (up|down)",
+		},
+	}, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logins, err := s.Listen(&v1alpha1.Signal{
+		Name: "logins",
+		Syslog: &v1alpha1.SyslogSignal{
+			Protocols: []v1alpha1.SyslogProtocol{v1alpha1.SyslogProtocolTCP},
+			Contains:  "failed",
+		},
+	}, done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Listen(&v1alpha1.Signal{Name: "links", Syslog: &v1alpha1.SyslogSignal{}}, done); err == nil {
+		t.Error("expected an error for the signal which is already listening")
+	}
+
+	// the informational link message is filtered by the severity and the failure is filtered by the protocol
+	udpConn, err := net.Dial("udp", udp.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udpConn.Close()
+	for _, msg := range []string{
+		"<190>%LINK-3-UPDOWN: Interface Gi0/1, changed state to up",
+		"<34>Oct 11 22:14:15 router login: authentication failed",
+		"<187>%LINK-3-UPDOWN: Interface Gi0/2, changed state to down",
+	} {
+		if _, err := udpConn.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	event := receive(t, links)
+	if !strings.Contains(string(event.Data), "Gi0/2") {
+		t.Errorf("expected the event of the link down message but found %s", event.Data)
+	}
+	ext := event.Context.Extensions
+	if ext[ContextExtensionFacilityKey] != "local7" || ext[ContextExtensionSeverityKey] != "err" || ext[ContextExtensionProtocolKey] != "udp" {
+		t.Errorf("unexpected extensions %v", ext)
+	}
+	if event.Context.Source == nil || event.Context.Source.Host != "127.0.0.1" {
+		t.Errorf("expected the source of the sender but found %+v", event.Context.Source)
+	}
+
+	tcpConn, err := net.Dial("tcp", tcp.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcpConn.Close()
+	msg := `<38>1 2018-10-11T22:14:15Z router sshd 1234 LOGIN [origin ip="192.0.2.1"] authentication failed for root`
+	if _, err := fmt.Fprintf(tcpConn, "%d %s", len(msg), msg); err != nil {
+		t.Fatal(err)
+	}
+	event = receive(t, logins)
+	ext = event.Context.Extensions
+	expected := map[string]string{
+		ContextExtensionFacilityKey:                        "auth",
+		ContextExtensionSeverityKey:                        "info",
+		ContextExtensionProtocolKey:                        "tcp",
+		ContextExtensionHostnameKey:                        "router",
+		ContextExtensionAppNameKey:                         "sshd",
+		ContextExtensionProcIDKey:                          "1234",
+		ContextExtensionMsgIDKey:                           "LOGIN",
+		ContextExtensionStructuredDataPrefix + "origin.ip": "192.0.2.1",
+	}
+	if !reflect.DeepEqual(ext, expected) {
+		t.Errorf("expected extensions %v but found %v", expected, ext)
+	}
+	if !event.Context.EventTime.Time.Equal(time.Date(2018, 10, 11, 22, 14, 15, 0, time.UTC)) {
+		t.Errorf("expected the event time of the message but found %s", event.Context.EventTime)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data["message"] != "authentication failed for root" {
+		t.Errorf("unexpected data %s", event.Data)
+	}
+
+	select {
+	case event := <-links:
+		t.Errorf("unexpected event %s", event.Data)
+	case event := <-logins:
+		t.Errorf("unexpected event %s", event.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func receive(t *testing.T, events <-chan *v1alpha1.Event) *v1alpha1.Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"io/ioutil"
+	"os"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	log "github.com/sirupsen/logrus"
@@ -30,3 +31,15 @@ func (reader *FileReader) Read() ([]byte, error) {
 	log.Debugf("reading fileArtifact from %s", reader.fileArtifact.Path)
 	return content, nil
 }
+
+// Stat retrieves the modification time and size of the file
+func (reader *FileReader) Stat() (*ArtifactInfo, error) {
+	info, err := os.Stat(reader.fileArtifact.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &ArtifactInfo{
+		LastModified: info.ModTime(),
+		Size:         info.Size(),
+	}, nil
+}
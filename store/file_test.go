@@ -34,3 +34,24 @@ func TestFileReader(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, content, data)
 }
+
+func TestFileReaderStat(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte("content")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fileReader, err := NewFileReader(&v1alpha1.FileArtifact{Path: tmpfile.Name()})
+	assert.Nil(t, err)
+	info, err := fileReader.(ArtifactStatReader).Stat()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), info.Size)
+	assert.False(t, info.LastModified.IsZero())
+}
@@ -58,6 +58,21 @@ func (reader *S3Reader) Read() ([]byte, error) {
 	return b, nil
 }
 
+// Stat retrieves the metadata of the object
+func (reader *S3Reader) Stat() (*ArtifactInfo, error) {
+	log.Debugf("retrieving metadata of s3Artifact from %s/%s", reader.s3.Bucket, reader.s3.Key)
+	info, err := reader.client.StatObject(reader.s3.Bucket, reader.s3.Key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &ArtifactInfo{
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+	}, nil
+}
+
 // NewMinioClient instantiates a new minio client object to access s3 compatible APIs
 func NewMinioClient(s3 *v1alpha1.S3Artifact, creds Credentials) (*minio.Client, error) {
 	var minioClient *minio.Client
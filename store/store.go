@@ -18,6 +18,7 @@ package store
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,6 +41,21 @@ type ArtifactReader interface {
 	Read() ([]byte, error)
 }
 
+// ArtifactInfo contains the metadata of an artifact
+// The fields which are not supported by a store are empty.
+type ArtifactInfo struct {
+	ETag         string
+	LastModified time.Time
+	Size         int64
+	ContentType  string
+}
+
+// ArtifactStatReader is an ArtifactReader which can retrieve the metadata of artifacts without reading them
+type ArtifactStatReader interface {
+	ArtifactReader
+	Stat() (*ArtifactInfo, error)
+}
+
 // FetchArtifact from the location, decode it using explicit types, and unstructure it
 func FetchArtifact(reader ArtifactReader, gvk ss_v1alpha1.GroupVersionKind) (*unstructured.Unstructured, error) {
 	var err error
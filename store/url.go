@@ -3,6 +3,7 @@ package store
 import (
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -25,13 +26,7 @@ func NewURLReader(urlArtifact *v1alpha1.URLArtifact) (ArtifactReader, error) {
 
 func (reader *URLReader) Read() ([]byte, error) {
 	log.Debugf("reading urlArtifact from %s", reader.urlArtifact.Path)
-	insecureSkipVerify := !reader.urlArtifact.VerifyCert
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-		},
-	}
-	resp, err := client.Get(reader.urlArtifact.Path)
+	resp, err := reader.client().Get(reader.urlArtifact.Path)
 	if err != nil {
 		log.Warnf("failed to read url %s: %s", reader.urlArtifact.Path, err)
 		return nil, err
@@ -50,3 +45,39 @@ func (reader *URLReader) Read() ([]byte, error) {
 	}
 	return content, nil
 }
+
+// Stat retrieves the metadata of the artifact with a HEAD request
+func (reader *URLReader) Stat() (*ArtifactInfo, error) {
+	log.Debugf("retrieving metadata of urlArtifact from %s", reader.urlArtifact.Path)
+	resp, err := reader.client().Head(reader.urlArtifact.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to retrieve metadata of %s. status code: %d", reader.urlArtifact.Path, resp.StatusCode)
+	}
+	info := &ArtifactInfo{
+		ETag:        resp.Header.Get("ETag"),
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+		if t, err := http.ParseTime(lastModified); err == nil {
+			info.LastModified = t
+		}
+	}
+	return info, nil
+}
+
+// clients are the http clients shared by the url readers by whether they verify the server certificates,
+// so that the connections are reused across reads.
+var clients = map[bool]*http.Client{
+	true:  {Transport: &http.Transport{}},
+	false: {Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}},
+}
+
+func (reader *URLReader) client() *http.Client {
+	return clients[reader.urlArtifact.VerifyCert]
+}
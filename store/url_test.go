@@ -26,3 +26,28 @@ func TestURLReader(t *testing.T) {
 	assert.NotNil(t, data)
 	assert.Nil(t, err)
 }
+
+func TestURLReaderStat(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"1234"`)
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		fmt.Fprintln(w, "Hello, client")
+	}))
+	defer ts.Close()
+
+	urlReader, err := NewURLReader(&v1alpha1.URLArtifact{Path: ts.URL})
+	assert.Nil(t, err)
+	info, err := urlReader.(ArtifactStatReader).Stat()
+	assert.Nil(t, err)
+	assert.Equal(t, `"1234"`, info.ETag)
+	assert.Equal(t, 2015, info.LastModified.Year())
+}
+
+func TestURLReaderClient(t *testing.T) {
+	insecure := &URLReader{urlArtifact: &v1alpha1.URLArtifact{}}
+	verify := &URLReader{urlArtifact: &v1alpha1.URLArtifact{VerifyCert: true}}
+	assert.True(t, insecure.client() == (&URLReader{urlArtifact: &v1alpha1.URLArtifact{}}).client())
+	assert.True(t, insecure.client() != verify.client())
+	assert.True(t, insecure.client().Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify)
+	assert.Nil(t, verify.client().Transport.(*http.Transport).TLSClientConfig)
+}